@@ -18,8 +18,10 @@
 package hints
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strconv"
 
 	"github.com/elastic/beats/filebeat/fileset"
 	"github.com/elastic/beats/libbeat/autodiscover"
@@ -42,6 +44,9 @@ const (
 	processors   = "processors"
 )
 
+// validStreams are the container streams that can be used in hints
+var validStreams = []string{"all", "stdout", "stderr"}
+
 // validModuleNames to sanitize user input
 var validModuleNames = regexp.MustCompile("[^a-zA-Z0-9\\_\\-]+")
 
@@ -71,8 +76,6 @@ func NewLogHints(cfg *common.Config) (autodiscover.Builder, error) {
 
 // Create config based on input hints in the bus event
 func (l *logHints) CreateConfig(event bus.Event) []*common.Config {
-	// Clone original config
-	config, _ := common.NewConfigFrom(l.Config)
 	host, _ := event["host"].(string)
 	if host == "" {
 		return []*common.Config{}
@@ -85,9 +88,34 @@ func (l *logHints) CreateConfig(event bus.Event) []*common.Config {
 	}
 
 	if builder.IsNoOp(hints, l.Key) == true {
+		// Clone original config
+		config, _ := common.NewConfigFrom(l.Config)
 		return []*common.Config{config}
 	}
 
+	for _, err := range validateHints(hints, l.Key) {
+		logp.Warn("Invalid hint for container %v: %v", event["container"], err)
+	}
+
+	var configs []*common.Config
+	for _, groupHints := range builder.GetHintGroups(hints, l.Key) {
+		// Numbered groups can be disabled individually
+		if builder.IsNoOp(groupHints, l.Key) {
+			continue
+		}
+		configs = append(configs, l.createGroupConfigs(groupHints)...)
+	}
+	logp.Debug("hints.builder", "generated config %+v", configs)
+
+	// Apply information in event to the template to generate the final config
+	return template.ApplyConfigTemplate(event, configs)
+}
+
+// createGroupConfigs generates the configs for a single group of hints
+func (l *logHints) createGroupConfigs(hints common.MapStr) []*common.Config {
+	// Clone original config
+	config, _ := common.NewConfigFrom(l.Config)
+
 	inputConfig := l.getInputs(hints)
 	if inputConfig != nil {
 		configs := []*common.Config{}
@@ -96,9 +124,7 @@ func (l *logHints) CreateConfig(event bus.Event) []*common.Config {
 				configs = append(configs, config)
 			}
 		}
-		logp.Debug("hints.builder", "generated config %+v", configs)
-		// Apply information in event to the template to generate the final config
-		return template.ApplyConfigTemplate(event, configs)
+		return configs
 	}
 
 	tempCfg := common.MapStr{}
@@ -117,19 +143,27 @@ func (l *logHints) CreateConfig(event bus.Event) []*common.Config {
 		tempCfg.Put(processors, procs)
 	}
 
+	stream := l.getStream(hints)
+	module := l.getModule(hints)
+	if stream != "" && module == "" {
+		tempCfg.Put("containers.stream", stream)
+	}
+
 	// Merge config template with the configs from the annotations
 	if err := config.Merge(tempCfg); err != nil {
 		logp.Debug("hints.builder", "config merge failed with error: %v", err)
 		return []*common.Config{config}
 	}
 
-	module := l.getModule(hints)
 	if module != "" {
 		moduleConf := map[string]interface{}{
 			"module": module,
 		}
 
-		filesets := l.getFilesets(hints, module)
+		if stream == "" {
+			stream = "all"
+		}
+		filesets := l.getFilesets(hints, module, stream)
 		for fileset, conf := range filesets {
 			filesetConf, _ := common.NewConfigFrom(config)
 			filesetConf.SetString("containers.stream", -1, conf.Stream)
@@ -141,10 +175,8 @@ func (l *logHints) CreateConfig(event bus.Event) []*common.Config {
 		}
 		config, _ = common.NewConfigFrom(moduleConf)
 	}
-	logp.Debug("hints.builder", "generated config %+v", config)
 
-	// Apply information in event to the template to generate the final config
-	return template.ApplyConfigTemplate(event, []*common.Config{config})
+	return []*common.Config{config}
 }
 
 func (l *logHints) getMultiline(hints common.MapStr) common.MapStr {
@@ -165,6 +197,14 @@ func (l *logHints) getModule(hints common.MapStr) string {
 	return validModuleNames.ReplaceAllString(module, "")
 }
 
+func (l *logHints) getStream(hints common.MapStr) string {
+	stream := builder.GetHintString(hints, l.Key, "stream")
+	if !isValidStream(stream) {
+		return ""
+	}
+	return stream
+}
+
 func (l *logHints) getInputs(hints common.MapStr) []common.MapStr {
 	return builder.GetHintAsConfigs(hints, l.Key)
 }
@@ -179,18 +219,18 @@ type filesetConfig struct {
 }
 
 // Return a map containing filesets -> enabled & stream (stdout, stderr, all)
-func (l *logHints) getFilesets(hints common.MapStr, module string) map[string]*filesetConfig {
+func (l *logHints) getFilesets(hints common.MapStr, module, stream string) map[string]*filesetConfig {
 	var configured bool
 	filesets := make(map[string]*filesetConfig)
 
 	moduleFilesets, err := l.Registry.ModuleFilesets(module)
 	if err != nil {
-		logp.Err("Error retrieving module filesets", err)
+		logp.Err("Error retrieving module filesets: %+v", err)
 		return nil
 	}
 
 	for _, fileset := range moduleFilesets {
-		filesets[fileset] = &filesetConfig{Enabled: false, Stream: "all"}
+		filesets[fileset] = &filesetConfig{Enabled: false, Stream: stream}
 	}
 
 	// If a single fileset is given, pass all streams to it
@@ -203,7 +243,7 @@ func (l *logHints) getFilesets(hints common.MapStr, module string) map[string]*f
 	}
 
 	// If fileset is defined per stream, return all of them
-	for _, stream := range []string{"all", "stdout", "stderr"} {
+	for _, stream := range validStreams {
 		fileset := builder.GetHintString(hints, l.Key, "fileset."+stream)
 		if fileset != "" {
 			if conf, ok := filesets[fileset]; ok {
@@ -223,3 +263,127 @@ func (l *logHints) getFilesets(hints common.MapStr, module string) map[string]*f
 
 	return filesets
 }
+
+// supportedHints are the hints understood by the logs builder, nested hints like
+// multiline.pattern are listed by their first component.
+var supportedHints = map[string]bool{
+	"disable":    true,
+	"raw":        true,
+	"module":     true,
+	"fileset":    true,
+	"stream":     true,
+	multiline:    true,
+	includeLines: true,
+	excludeLines: true,
+	processors:   true,
+}
+
+// supportedMultiline are the multiline settings that can be set with hints
+var supportedMultiline = map[string]bool{
+	"pattern":       true,
+	"negate":        true,
+	"match":         true,
+	"max_lines":     true,
+	"timeout":       true,
+	"flush_pattern": true,
+}
+
+func isValidStream(stream string) bool {
+	for _, s := range validStreams {
+		if s == stream {
+			return true
+		}
+	}
+	return false
+}
+
+// validateHints checks the hints under the given key and its numbered groups, it returns an
+// error for every unsupported hint or invalid value. Invalid hints are ignored by the builder.
+func validateHints(hints common.MapStr, key string) []error {
+	iface, err := hints.GetValue(key)
+	if err != nil {
+		return nil
+	}
+	base, ok := iface.(common.MapStr)
+	if !ok {
+		return nil
+	}
+
+	var errs []error
+	for name, value := range base {
+		if builder.IsHintGroup(name) {
+			group, ok := value.(common.MapStr)
+			if !ok {
+				errs = append(errs, fmt.Errorf("hint group %s.%s must contain hints", key, name))
+				continue
+			}
+			errs = append(errs, validateHintGroup(group, key+"."+name)...)
+			continue
+		}
+		errs = append(errs, validateHint(name, value, key)...)
+	}
+	return errs
+}
+
+func validateHintGroup(group common.MapStr, prefix string) []error {
+	var errs []error
+	for name, value := range group {
+		errs = append(errs, validateHint(name, value, prefix)...)
+	}
+	return errs
+}
+
+func validateHint(name string, value interface{}, prefix string) []error {
+	if !supportedHints[name] {
+		return []error{fmt.Errorf("unsupported hint %s/%s", prefix, name)}
+	}
+
+	switch name {
+	case "disable":
+		if str, ok := value.(string); !ok || !isBool(str) {
+			return []error{fmt.Errorf("hint %s/disable must be true or false", prefix)}
+		}
+	case "stream":
+		if str, _ := value.(string); !isValidStream(str) {
+			return []error{fmt.Errorf("hint %s/stream must be one of %v, got %v", prefix, validStreams, value)}
+		}
+	case "fileset":
+		if streams, ok := value.(common.MapStr); ok {
+			var errs []error
+			for stream := range streams {
+				if !isValidStream(stream) {
+					errs = append(errs, fmt.Errorf("unsupported hint %s/fileset.%s, the stream must be one of %v", prefix, stream, validStreams))
+				}
+			}
+			return errs
+		}
+	case multiline:
+		settings, ok := value.(common.MapStr)
+		if !ok {
+			return []error{fmt.Errorf("hint %s/multiline must be used with a setting, like multiline.pattern", prefix)}
+		}
+		var errs []error
+		for setting := range settings {
+			if !supportedMultiline[setting] {
+				errs = append(errs, fmt.Errorf("unsupported hint %s/multiline.%s", prefix, setting))
+			}
+		}
+		return errs
+	case processors:
+		if _, ok := value.(common.MapStr); !ok {
+			return []error{fmt.Errorf("hint %s/processors must be used with a processor, like processors.1.dissect.tokenizer", prefix)}
+		}
+	case "raw":
+		str, _ := value.(string)
+		var raw interface{}
+		if err := json.Unmarshal([]byte(str), &raw); err != nil {
+			return []error{fmt.Errorf("hint %s/raw must be a JSON encoded configuration: %v", prefix, err)}
+		}
+	}
+	return nil
+}
+
+func isBool(value string) bool {
+	_, err := strconv.ParseBool(value)
+	return err == nil
+}
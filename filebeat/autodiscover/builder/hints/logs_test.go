@@ -30,10 +30,11 @@ import (
 
 func TestGenerateHints(t *testing.T) {
 	tests := []struct {
-		msg    string
-		event  bus.Event
-		len    int
-		result common.MapStr
+		msg     string
+		event   bus.Event
+		len     int
+		result  common.MapStr
+		results []common.MapStr // results of the configs after the first one
 	}{
 		{
 			msg: "Hints without host should return nothing",
@@ -361,6 +362,129 @@ func TestGenerateHints(t *testing.T) {
 				},
 			},
 		},
+		{
+			msg: "Hint with numbered groups must generate a config per group",
+			event: bus.Event{
+				"host": "1.2.3.4",
+				"kubernetes": common.MapStr{
+					"container": common.MapStr{
+						"name": "foobar",
+						"id":   "abc",
+					},
+				},
+				"container": common.MapStr{
+					"name": "foobar",
+					"id":   "abc",
+				},
+				"hints": common.MapStr{
+					"logs": common.MapStr{
+						"exclude_lines": "^DBG",
+						"1": common.MapStr{
+							"stream": "stdout",
+							"processors": common.MapStr{
+								"1": common.MapStr{
+									"dissect": common.MapStr{
+										"tokenizer": "%{key1} %{key2}",
+									},
+								},
+							},
+						},
+						"2": common.MapStr{
+							"stream":        "stderr",
+							"exclude_lines": "^WARN",
+							"multiline": common.MapStr{
+								"pattern": "^test",
+							},
+						},
+						"3": common.MapStr{
+							"disable": "true",
+						},
+					},
+				},
+			},
+			len: 2,
+			result: common.MapStr{
+				"type": "docker",
+				"containers": map[string]interface{}{
+					"ids":    []interface{}{"abc"},
+					"stream": "stdout",
+				},
+				"close_timeout": "true",
+				"exclude_lines": []interface{}{"^DBG"},
+				"processors": []interface{}{
+					map[string]interface{}{
+						"dissect": map[string]interface{}{
+							"tokenizer": "%{key1} %{key2}",
+						},
+					},
+				},
+			},
+			results: []common.MapStr{
+				{
+					"type": "docker",
+					"containers": map[string]interface{}{
+						"ids":    []interface{}{"abc"},
+						"stream": "stderr",
+					},
+					"close_timeout": "true",
+					"exclude_lines": []interface{}{"^WARN"},
+					"multiline": map[string]interface{}{
+						"pattern": "^test",
+					},
+				},
+			},
+		},
+		{
+			msg: "Hint with numbered groups and module must use the stream of the group",
+			event: bus.Event{
+				"host": "1.2.3.4",
+				"kubernetes": common.MapStr{
+					"container": common.MapStr{
+						"name": "foobar",
+						"id":   "abc",
+					},
+				},
+				"container": common.MapStr{
+					"name": "foobar",
+					"id":   "abc",
+				},
+				"hints": common.MapStr{
+					"logs": common.MapStr{
+						"1": common.MapStr{
+							"module":  "apache2",
+							"fileset": "access",
+							"stream":  "stdout",
+						},
+					},
+				},
+			},
+			len: 1,
+			result: common.MapStr{
+				"module": "apache2",
+				"access": map[string]interface{}{
+					"enabled": true,
+					"input": map[string]interface{}{
+						"type": "docker",
+						"containers": map[string]interface{}{
+							"stream": "stdout",
+							"ids":    []interface{}{"abc"},
+						},
+						"close_timeout": "true",
+					},
+				},
+				"error": map[string]interface{}{
+					"enabled": false,
+					"input": map[string]interface{}{
+						"type": "docker",
+						"containers": map[string]interface{}{
+							"stream": "stdout",
+							"ids":    []interface{}{"abc"},
+						},
+						"close_timeout": "true",
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -397,5 +521,78 @@ func TestGenerateHints(t *testing.T) {
 			assert.Equal(t, test.result, config, test.msg)
 		}
 
+		for i, result := range test.results {
+			config := common.MapStr{}
+			err := cfgs[i+1].Unpack(&config)
+			assert.Nil(t, err, test.msg)
+
+			assert.Equal(t, result, config, test.msg)
+		}
+
+	}
+}
+
+func TestValidateHints(t *testing.T) {
+	tests := []struct {
+		msg   string
+		hints common.MapStr
+		errs  int
+	}{
+		{
+			msg: "Supported hints must be valid",
+			hints: common.MapStr{
+				"logs": common.MapStr{
+					"include_lines": "^test",
+					"multiline": common.MapStr{
+						"pattern": "^test",
+						"negate":  "true",
+					},
+					"fileset": common.MapStr{
+						"stdout": "access",
+					},
+					"1": common.MapStr{
+						"stream":  "stdout",
+						"disable": "false",
+						"raw":     "{\"type\":\"docker\"}",
+					},
+				},
+			},
+			errs: 0,
+		},
+		{
+			msg: "Unsupported hints must be reported",
+			hints: common.MapStr{
+				"logs": common.MapStr{
+					"include_line": "^test",
+					"multiline": common.MapStr{
+						"patern": "^test",
+					},
+					"fileset": common.MapStr{
+						"stdin": "access",
+					},
+				},
+			},
+			errs: 3,
+		},
+		{
+			msg: "Invalid values in groups must be reported",
+			hints: common.MapStr{
+				"logs": common.MapStr{
+					"1": common.MapStr{
+						"stream":    "stdin",
+						"disable":   "maybe",
+						"raw":       "{type:docker}",
+						"multiline": "^test",
+					},
+					"2": "foo",
+				},
+			},
+			errs: 5,
+		},
+	}
+
+	for _, test := range tests {
+		errs := validateHints(test.hints, "logs")
+		assert.Equal(t, test.errs, len(errs), "%s: %v", test.msg, errs)
 	}
 }
@@ -31,5 +31,5 @@ func init() {
 
 // Asset returns asset data
 func Asset() string {
-	return "eJzsfW1zG7mR//v9FCi9if0veizLXl9WV3V1jh5sJn5QLDn55xwXBc6AJFYzwCyAEc29yne/QgPzjHkih9JuhXrhMsmZ7h8aQKPR6G48Q3dkc4pCvvwBIUVVSE7Re75ECxoS5HOmCFM/IBQQ6QsaK8rZKfqvHxBC6IwzhSmT+l3zeEgZkd4PCC0oCQN5Co89QwxH5BRJngifwFcIqU1MTjXnNReB/U6QXxIqSHCKlEjSBx189d/NihiWC8EjtF5Rf4XUyiBAayyRIDjw0M2KSgMGmgJo9WN4LnmYKIJirFZIcfhS0/MyDpdcIPIdR7EWyO3zeyyeh3z5XG6kIpEX8uWt90OpfXyxkESV2hdytqw1boFD2bd1hiagEyTmQpHANFEqLJREWFVARERKvCxLWZHvKSy6ZFyQGZ7ze3KKjrcUvB0ViC9ymWt5m86Ar+yIqKCTShAc9RoCPaSkR6mhiNYrwgACZcu0p4nQMOQE+ZihOUF/kCrgifoD4gL+T4T4QxleLLiMia+48DS4dunEgvhY6a9fey+7ZUZZnChoc3XIknstSz1ml4QRoWmWBi6VCMaAGaT3OEwI0jDpgpIg47HgAn6/1SxuEQcQiDL40jCXxIcvbbdd0pDMCVZaXgtq+ws9Ob+4+nxx9ubm4vwUSULQLbwMArl9WpZX/suWA+l3IpRyq/UwmykaEalwFLc3csqQjyWx/JZEKhTTmMCMibGQxKijjFp5Btl5JieIKiQVF0RmlPUzXNAlZThEt/+dUbhFT4Qem5IwpSdDSt5MkZRySU0+NRKhOXGQcaXZWhKSKC/iQRL26NtMkuYFpFZY5Z0J/EwvN/DRnwZwsa/1ZiM3MuRLb4F9GlK1GU9tW4KIfFcC+xpD1qexoFxQtXFDSX8dDUpKMB3bhk+bNCS5J/qNWYjnJBxLT2ssqyTCRkPjeUhQyqi9U/YOI2Xk1dYBn0jpxYIvxXjrlQagGaT9Yck3MafBeCOBBgWmQL7M1IyJtFdG45sSTJk7hx4R99QnxfnuknQDl2vzNtCqENYjKST3rQOo2bJYauUJrztF5Qui9UuJdIBVh0xK75YtUv0y4sy5BNoXvGw54YuMZDajpdF1VLZoejTfZBrTUONRjAWVnGUE86VE0yoMGa1N3euU5uGh6QLNuVohLAiigV5+fBxmZDkLN0XacsWTMNB2WSJJda1ZKRV7gsiYM0k8qbBK5MznAWkamQ3yfndzc4VSOqhAJzXzMwP/1fGrNggkxLEkZtkfiOHCvGoW4TlRawKm6i+JNgYwC3J8lKGIhiHVNglnQXWOlhFZ22AWErZUq4GYzqwBb15OZ2dZWnMeVPWiRQDQvYioFQ+Gz63Ptunmfe+HH+wGVI/JfAf6J/Opbdfp8yjiDNnVX+83Eb7HNATNThnCYWjnkEZX2paWWgWToZ+1UdTeGiGShAWplaVngt1+SZgN2VPwWsG80naKNUKNmZkIDEaoNmIm+ntm7Bhj11Jp5oimSZX+yLgqEoNX0IpLZTnZ5284SnePGY6J/s0YxfrjbT5BS8ZxHZdXF1rKsce6m2IDRaQSwQgoI7BiY22raSmavXVZCwLwguxEwhhlSwcaPcF+5awHmvTJfaK5J0LSTK22gLEPpsMKhnNfI/YoV6hHTUuRc0+24CLCqvRcpgrfJMtEKnTyWq3QyfGL1xP04uT05Y+nP770Xr486Sddo+OzhchMQz1BBPG5CCobu3KjFF7Kdi5vxJwqgcUGnjXSspt8Pd5jIkxHae2qPyiBmcSwz8v3T5u4ajAY7VCSI5//TPx0rpkPswG6LtNViSQin1NgegKzqm0hBBclAEvBk4495oV+KdWA1qbQ4xcHAdXP4hBRtuB6ZlvjwfCR6SJYdNahRl8ScvmTWmDl0Cwdr8agsKIj1+rVi3pxOS8MotwpgRrWp17UzTCxS5Qf8iTI16gz/VFbR/c0ILqZCgdYYfey9cH+aiwnv/Sq1H2VqyAcBDN4YJaSTE0wLhpXMf2oB295KdnqxCZ+x+z9WFjeygg9dMWlpHrgwpokwcoj/skELX0yQVyggC6pwiH3CWY1p2SGjTKpMPPJjHZMnal9EE3PU0h6EUER9lfa3Ozm0L0yZTyK63o/LvaBWWGcZXJWJ15EAppE7dw/GBLG/TWIuTVzzB65sORlCBL5jGCpnr3wOxRpgRCCFZHmqx2VBg6V+TLXMuRAN2a9mkGxvzz73n/o2Vc0lrecL0NiZlozd0GWnUvtZ3imq312ogfcv4P5Y2f6efrZQdz8BpsLrX7DkOROH/ObnrNyxYWamRUg3z5j5q+4SPk9y2Z5wwlKBgs514cmPZ75wz0a7KYTvzD6S0IKDnYauLR6xi5yLR+DOBbHBZBLrVMLQBsS84SGCnHWBqWgDLZEcpbxNL6GZl7gtZI1biVbArXbEx1YpiAJwycbtHow50P2nfnkIDLVxkBhoFofeVn15GNTf985Mi3vYeNy9z55Z7cV9d4YaaQbBeEY5Fj4K6qIrxIxQhtK5NAT4i099P2Pr2evX00QFtEExbE/QRGN5dM6FC69OMRKm/S7Ifl0jVJCFoNPmOJygpJ5wlQyQWvKAr5uAFHe8WyPwdJx8ljgiIabnVkYMraRggQrrCYoIHOK2QQtBCFzGbS1lsY1CKWvWri/pxIOTqdXz3AQCCIlkXUGEfZ3a2TKZoVFsMaC5MwmKJEJDsMN+vDmrIgh1SN3yZwIRhQcNllt8pfidw62+e+ZGVy2aXOiqKhL2pfF/KVOBVQCjQapoZgHIywPBQnEPDC6zckq2VU1FThd8QB9mZ7XGel/ZYz98RqVU6wz0zuwUSWoKTaIsO/i2o+RoYYiHNc5Yca4Av/XaOwKJN08xzRYCnz9ku3SxnYEk83J19C1GgbH2F+Rk1y9HL0x3xy5tYv9FX1Ij57LasP6tVxqIeeEhrhUUoapk8Z826RAsK9VU01oRT4dIsv8RNaLk9pkKY53NzdX55YPBLV4hdersFApViHiisxKi1Nbt3bgBKwhJUyh6RWya4fn5JxIImaVQbwj55sVMY402K4nkgTGwzjHkvoIJ2plDp2MH9s6wZ3gSmcXfZBl29m3FzfDQaenPXDAkp57OIUmwnHFVeL85fN7N9uVUvGsbr6NwB/41gw6VBqh5rxpVnEGoiaH4BDO2WFW2UlY5D/nwWYmCVPefKOI7IsgdaC7XuqBjiXRnAhtoAGBLEKDiHsiqmdwbrEtiBCZM6CMd7fuSkm7GeOliQetc624hXuwPCsegCfsGUREBWaOAx8klaBs6aFPLNwgG9WEqBGWfqxG0rx2EWKpqC+J3lehOEyWlNlzs8IZIRfwRbOaAB3W3OCqgh/aYtvcL3lzTdzVWK3NW4pZ4Gime+koCiAg99SvzkrUMc56iAG5omFWG0l9HFqmVajFvdHPvC6Klrk6ABDQrh7J5eOxBRRl+wOlaW8DKsbKX+2v94D8NrgcZkEfWNkifLYS3Elhi2HXBy+vavg+aLfAUj17bkM0e/BpMAzdQ8+HQei2HICjd2kKaUl4g4m+yxrzlvDpFZz9altFy2qJ1YoIEmiTmQSIM5tZYDcJadxvlaJrPTLEey09NXrbLEV6K0kZYeoBOy/j2TyYfJ4wJTYzKrnLgh0J2JnhgqbXnxymLCoFPZr9TyOOJeGzmNOaSTNARHr2UpUExq4IsYIPzZjM8dye+80wqZzNVJH4VG32jEOz6EBh5bHfIWPPO+sjxhVrgrZxWlxaX0UaCWqcFYbuICdFMUi3jwR6bGSywGOgnc7nWiBKEYUPfo1xYeROkjysArwntQAvK7cmd0U1EAftsOsyU3i5JEG7QGLq9pBstx+3Dnw0PXdzU6NyUysId25iVsphKfPbuq9tmksseJD4hZjQkpxTB2gSUBUU/Z/wRYP707g9wSmot4wQ2wXPZ7Osvz80ZYyGuEOrM73CHbX4Rk1aaVnEO+iY95Ql3w1/iO9HH/WOPgyzAGBBUMD9JCJMzytt7KA58XEiy72tVmRjHt4wHFEfVrJ7LDZovrHk89Dh/s5Wn4tgVgk96zl82pgWbNcwmOGkNlU66F8ahUxZNVMALNQwsMyn58apmnqfYWsESURI8RpRoAFU3VAZWY8NlZF1BtUrSG16noZ/An4XWIF9ghYJnK+nlHneSv2VtWypsNkLaoP8FWZLItGTkN7V1+k58XmkZ6PgXD1t7jA51EPY2V+SSNj7jN9j42LVHZZj9dBUVToKKUoQdm0QdAsqHTbfFIk5myDJLwlhNZfVLktJcWKm5K3/tsFD6vtbrMjGD+HDfgLZUHnJfQr2wZqqVTGBysW2vlz3MVDOa3lhTtr7JE4ViXZyoQMByPhgbQLSjw1no99Kc45ZQH2siLQhhPATT7I8eMUVDqu46tsAyGK2T1GJfiWCP4P9+H8ibDM++AIdo4hgJm3ChylPIKQCog3j7nh46wxNLJawYqYq0WY++DgMGw9thvMSRCahKiSjpjzQE5mYo00u0ALTMBGkQZ0+rqPk1hg+nrY8tF1/WyPZ4sA/OEweagteQgTZvU1gHsQzUYRjGB7cSUX5bOlOemD3id25keL8LWzgSt837ONKz+TBLK59WpUN6r9da4y2dURplQgcFWOt9dNHhSezs5ej+79//LP8n5dHtW1dVd55lZCAfG/nPNWPwONungubs/xMEameQeGOofxpY/SS5U4DN2/86e3yfD3/8nlx9rcf/+PNtf/L/Gy57s9errAIWtlnufnwqBvFcX+GsEhtv+lu9dThTe0UutwYmND6qXJBlzR/MS1ZAnVzBJFqYpLzYi70b4jGswUNFRFHFS65JPRb1V+bJ3wpa79zaw7w03wcuxdfYYW47ycCcigx42wT8UTOTDTWLCCMkmBSCT+aaTMGvq48ZT4uBWZKf/Y5Y6YAjfO79DWFo1ibIzMbzzNBImEzXCBkP5sXmoVX5j9cjKb7uuX4d/C8qEIEUrXj0ZP6L2bMYPT54voGvbmapi8/LY6S7D1T1MAn9D630PLH9NadkfDpBNawcAYhpU+MT87XZrr+TKVMrPs1ZdUsu5zO1nKzzuDOIVjwG1fqItWF1gz4xU8n3ovXf/ReeK9O3JArtnRegoQyn8a46pSvA82eRE/0Bla//tRMGTMBKtOiGessm1jDhVvJ7G3CWrTDzCsGqR5H5Dvxk1Zh+mEiFRGnEWdUcfE8wrTWnG6oiaCdOGH0ExaAWYW+fJ42gno++x5j/+65JH4iqNo8nxXE3d+9nRtWMLZ6K8h0LA6Q4llIsLj2BQ9DWwdiuAwt29mcB5tOrPqh3Pi2ypMuEGF6s9WCVL/oxlY6cckjpUw9OFcCzNZLb7brrSeDDPChvz3LimOV45ldLIts4xWW7lG0xWbbevJtjTYfKa6BAYuhO9v9bdeKFvDbszRJTmsKJ9BC99tKGTNJ/EZoi5DjLfdJZxUkGUNwGQpTg8Q4b/6M7zG6p0IlOCzm87mBS18k85ncRHMezpSeE1DjZl/tQFcYyo/QCFKNbaEb5IcEQ82CJEYGCwIsDu9ZBTjEhz4A8B64AUon7jXBdzNBFnJmnaKAf4/IbzRmGWtbNucIMEykL2E+kYVGtYUTChyGJJwJIn3MHgp1Qd4RFndQl4veE5uDA87YkCAcx2Eh9l8qHsd1p1nxuB9LOUtYyG01xwdoieEG44XBAQiA6Cl9P06K5afqGF1KuSfGK3s4f3b1xYxxO16IWHARmZqqqQJyQGxW2agaTe0WMuoUdM+G6L9KI3iiJA3MZuSOCEZCVwMKimUjHwElZVWQqBWlIDh8CJg3cKZhy59VQSsOBeRCotK0/GyVgm0LFCmGczzKqFy5Xfo/30czkbCGKdjckD5RIBoqIPnz3z5YNElcmG0ThCXChrwe5cbkbjvcM4ElcgZnPTOtZZqUx9bI32Ixx8uSNC1Xe8KkudpucCmNbCBrFQirS4p5bBFrCIrzO93FBpTF2YqrUN+pDGGr0Ju3ZxBkY5beZQPLFcGjnRq9IzhGOEw94+C0tv1Cfx1sy+p3ZnfzRqVOmSJLR+ZHv6UHYOnGAx898O9oyCHlqHmh0SvT3iB9kRCWg+MWMMXYiSVxZ6Rt0XGfwiANuYO4cd9PYsz8zW+/B6Hz+AJCPwot+A10Z6NMu3t3wxO2HLN//6EJ/s57eFNtw2+gj1vk6kaXB+OI+xLTsnvm2iQ7plcF1A84qmOg3k/5sWkUc1YN3y2zew8F1e1zZc9O7vXhHvF8L/I+EIXPscJnUHkXDohspeHym00Ll9NzU0Vkli4Xwfrob/PTwKBpmytHpgvfnjW7u9yuLtcsdM+WTGez+galjKXKqQ1FS+RWZk2s64FuozPMu3PG74lYERy09GvT4HL1dIlRNnFCvi4HzlZmjvk9jYsDC/eiegBd5//15PjFH58dv3528tPNi+PT49enL15Nfnr58tvX6cfLT+jbV3NSakh4FoT3S0LE5hv6ej/7259XP//tG/oaESWoD+exr72X3vEzTdc7fu2dvP729fgbmIRfX3k/RvLbBD7MoKyx/PoKPmvDeUWV/Prip1cvf9RfbWIiv36bmBpq8B+AAMdMX//65eLzP2Y37y4+zi4vbs7eZTTgtFR+faGfh6tlvv7vP48A7T+PTv/3n0cRVv5qhsPQfJxzLtU/j05feMf/+te/vk120TcQ1i3alc3SFipoGg1OYS+IKvdet4rRAm5BAkY6VZmdbn30sF8DYTXhe3l8HEkXlErGQYZD92IbEP37kKnR3GQYJy2srhVWFGbDEH4N7SqMxTaWJqhDP9XEszqQB7YZhvgMuqwNR8jX7f06YJIMkBLcdjErXfHkgnehH7NtKQbcjdBPBUXTNR1gLqTV1u1etQHBq5OBkzHVbm0YzLaMqlGZGnXYyVb3PSWBiTVpAnAyDIDgiaKVFbrM+7N5oqmb5fGLd/9z8tc/3f308/rVUi3xpWLDpgdtWZCnwShap0MD3LRM/YD7bbxsbBn1KVviQlDZFL5oiCYzP7aHkWUU0e7xYwGZJzvl8jQVObINAfqD8gYrd/d09WMHPP13bW+QkmgNNcXT7QIU7DMwbUZXZzph5S6VEcC5KjAfgdSOJuiIcaV3JxNtWORqdYKO1lgwPfWQIx//yBcUruk4euzEw7y4B93hAL1zkGnyhzH2bz7G4CAgqZfwHG+YWQ6HkfZvNtLShZzK4io+ve6f2DudXmcescabVCltrnLaI4W3xgM9eHFDDWGLcobGozhqOcObvOxaV0nDQ9XAElvYRdoInP3wBw42sAai9jBrKIcXc+Eu3bBdzmcKACKJ246Df9NVLvdQ/PMmL2HRNVserULhY1eUlBCXhlXSu5hkX+YymRvCLdzXlL08GZ//300tdNTJP43OhWCDWl7vGJMyDcIrem8aeoIqsofZqcnaFG0WIJpdItOiJ+zCNT6WYlC7XcZKF5ZlV7MTyPir3LvlgvqYxWB9zu/oyBKqXFZuWCBpkpGyBJL25aVy0d1IyOByiRXBgVX27Rh+p4VqAXYq5d8UdKNNmpEfKt2OklxfqHSbHCrdHirdHirddsM6VLotIDpUuj1Uuh2pgMuh0q39O1S63Wdpkv3226HSbflv/5VumzzYw0vdPrZLDriP7Cy1zDt9pY/rvLfcR267Zd7Z9sd0qhyOLUpsH9s9LAiWnM3ilWjKrt/VOa7pI0O/8eQm2YdjFE71Cnm4MeehY4U42ILZ38EWPNiCB1twPCxNZfvu8OKuGFn5F/25ISoDfstLxDtvm7Xk0O5hlTsWSDdgQ75EIWWktx2qaESkwtFAJZsmVcOreVX2lL1bzbuud8hDY//+5vPHahZOv8gbQ/ixg8pQZxraTsvqWRa0lQqaSmTLjmv5N91lgWtVxrZtPNSTAYKDIEDF9bEWd4RuoIA7ZS3jrcdq6hALGkfxVKRk6s23yQl1jlbU1mk9YSH0wdBGMRZ5oWqNrhnOIgmr83UcLFBxOgnDVDzV3kyVNZ1jVtTW5osGdW1+bI+Dzyii363CHrVowl+MzLoLJ0g1pJBctzZLhDCHnViBvWmANO5bqzcDGNa1y7f1n/ly5kysCPlSKiyL9XrTrxoGVfpz+7Aq0EWjDywL9H0BaFkMu1yjkjZviO9q3DW14URcTwwXozZjYsdda2ZKVK6Jmpi6tT4XZlcP91W858tXP5vHm6JE93e3EBd2iVln5VUrVXXbitaM1HHTws4az3liLBORMGYuPYKLl3KAWrod8EK+nEE7+s/2Dox3ZGNv9AkTYjKLQNEVvAI5lNpErKdfD55wdRKHmXWYWQ8+s5pn1XB0n/EaBUkUZ+e9hnXoYJJFZYBnbGRHY7FGp2HQxruWtLnbiLGVb3Pep2jK4kTJCbqEuuVygj4lSn+jx9QZD4jfVAaL87sZZa6U5e0d0ReQ3Q8lw6D2mU1LSl2UfYJmU1wMs1o0yN5gAbM2VLY7YyxwQ1Dx8BF9bSp2ZlfuFCCZq/RsjdVuQDPnIrXb+vXsv8rISpBMXsB8U8DsWNDa/mNN44izJQ/mBcvYftM/ZemDfuH8T91pSzkvNCR1qWy+Frjt+/JBx8FvEwIXio7sua7BWbvB1LV4Z360aenrrlT2HvWS6oguEwbVCHGIfKzIkgv6qy0I1QHu7NOHD28+ng+EyGozuofhQ76rTjiUUYVZEFKpKtXHukC5yPYxMqwPptV9VdBi6dzcyF/Cwsz8sLn+6/v+81KzglfKM7P3XaEpe7RtnmEDANQyY8cP1SgDGR6x8ZCecmPizUa7CfcNBL2blv/o/Yd3MindzmgtShp4cIujec6GEsjsGsnimzUO5mI8vxTrbWsJ04Zj/47jAJvf29LQ9q3GY58HjLiJ7BjLmsOgoewIrO/RUMPM3MgJaeRQEi/IC0g1p8wMZwYpMfbKk3Sf08I67YWmG01r4QV9ghhqd6+MAMTkymqF4I1d4xiq+oBZnaPRNvxkpzrHIffv9oIXR3DDqTZqy5jXmKrCVbYagNY+c5KHVcCdvzWqxkqmcqf2Cr6WkF01kuotJyBp6kgQlQiWm+0tkwfQaKVIGRnzWvQKIulj1g9Q0yq4C5iE0e+FNVLhO8JyHXd7fXGT/3rbBq5eE61f7F5WKq1BeYwp+fxSLzQ9zwa55W7tPbak7HvB3vuoPw+z9+CVLe29lD3axd5zAEAPXl0iB7JFjYksLmymNwjOIYCFwAMH3Btm3jL3JmkOhYWGSLgam2qdpZmmV9bby399HkWcaWVImR8mAZmgOZE0IMbgMvG3NY45+UmJlekrk3YqUUjvCLr9/88uuVhjEZBA/+/WQ9eEIBxKc6XMbSaTW1ew3B6Dm89qgc2Fa4vjZB5Sv7ZglxFDL94a4XtoukCM5y/W+OVSwoKkwX/WanbYuhaHoPdY1S0HF5A6RwDWaK/9ZotLHKKKS2wfM8D7sSOaf6eZ6Y9WoOSQWD52YvmXQ2L5IbH8kFh+SCw/JJYfEsurYA7JRIdkokMy0SGZaDCWx0ksz51Xww8rR47huzAAIADhCfGWnoE0QWmh3acNwTqjuU6vssNEwhRdUCLQk6vpeQNfNaLL1h6NpmybEn5Sr+54h7Znuae4i/34p5pmyKV0rV+ay9TDnnqmP8nsihEHUesTJt9jLlR+vHBr6dy259bl3NDuMfWCyCRUu01RcL4u3G0y9JG5EUkS1Xeiju/VKy669hBwhVVe7NH4MCFWs8Er4TsWvR1AXXKBKPMFiQhTek+KFZ7ADc4QZautKBNnmxWmxEFQO+1CpkhjxO9JAE5yHzM0J4gzaO0RvHM0QUf2maOJfuFIMhzLFVcNlcBXXKpZPrvG7YmCrkr1ORxrl+py2lFu3Q9UpmG+9SXvozY9w3CTEaqvjJmzhdHvrjuit1VFX8ondHZ0wRgqni4jSZlvg6Zj7q88c8unbrzPoxhufjEa4L8LB3o+D5OoqQ4oDgkLsHA2Jtm6d2zApyDWEM+i1yqXF2uucIRszH47322XZcd1MZdqKUg5RuvKfDk4UCt/b8vTuxIatH18ZRnIvkMsq8eHTWJI/34zkVo0Ir/W7zrsyepXq70ytg8TDlY0p9z6o+4gzSOzcBBRNiguK43Ur5HNfKNY4Xm9CkrOM9qYQOTBLJ2U+0WgXb65efN+7PizwBVK3hZJU7yHzzseBOc8jRHnC4SHxk3kfK8v3l+c3aD/hy4/f/oAfSj/cxCOv9rq/ViBCfBYgXlWWwsSlG7l+Kw/N+ho+K099TMlhx49odiAzbRlT2U53hbtphDzOT1PV1ODyhzGNcU4jZ3LpSmW+ae12T10VjIbbyMsFRG3E3QrQ3xP9H/8FQ2DW/REr8yfzy+fv/l0idZ6n8uWCH57OnHZprfakKCMhLf9w13HSqurNQsyHXVj7omYcwntMlfp3IJdfGuvz2nAupfJWKM6YoTsdRoCC+EaQu/CyL02PfUqbobAPcUII0bUmou7woa9r1XhR0OCHHpFgkURZkF6EWvDuWm6YHij3eLwDkTFlk2Xwaa4IEnMF+3pWKNqj1xrtCxWd2TEy6c01zuyKW/JUgHorWh752AxZjEGiIoVyySCy5zXVK0aQPk4DDUku6KZ05DCknYNX/TfdxgCW+43Mu5ol3BBFwTUFi+YqNWY+433lCXfgWqezfTg2SFYIvA2Zqg0nvZKQw0XUvSMsAdf0RZcY8GXAkfb2wdbMx5V31zlCicFBr4ymZZZ6gY0/krZK0dst0wOcOfkSQy5Q9DEK0mkuCOJtMhXymosxNZHrHYmSnNHoa9Xo+vrd7rdlNmL7fudb7bluvfYEmvBVBhXzaqjN75PYmX8jJeYhpmbccrucUiDI6/wjINHRDCTCCOZQDjyIgkNOy+nYJ+xHWNjKmy4VZr5mx03O1jYo/EMX5Ve3kSsFIlihVZYogU8XJVza4jnAJFWwklt1GZVuDGWUi+aRyBRE5p7RzZHTahqp/zpIHT80AtqXjy5ku9TlpdegSNcP6TNLDbB45gE9fDnkfFpyeZmrO1ibf7ymDBzI1UUkYBiRcJNiqoJtKMccmuAyRDAUBR5J5FKumRYJaI+4HvhyF7PXLwWmAn/viObJsauYJI2XdcD0OCQkls7pfUs8hoi783f2LEl7uiS5viSAREm3efyvU7mB8WZ9Itd2B8yqmrjDPUO7dgbLMO2VVrdcTmjoeuOzukVn9MnQmeAvPpG6QyJSxlNZI3RKUU8Mgn4Hi02Y6dl6bDpQb/meptuXQdacZWQGvOXeaXBLPr46QZOH5OAE1GPK+21NpQCHTQ1H0uzRGmy2ba73UBStQuxe3K/uflHYVEscaRNzofCor3e0ijzbfnFgAriKy42O4BwBtNn/SQ439IWV1gsibLbFF7whFQByjVV/spxZF4ochK5lrd+oqp46cCPqCF07JA0bhy4d6t7nXOW8ZbTzrn69BJUnk02J5QtTRBH46Cp7eN7W5tt7KfnjYbc6AyhE1s4rlxh9T3o6vfQgodBIWyEkTU0sNE+XhFHpd4ezAKywEmoDIEWds4hDhJ4lDGecn7wQV40nLSUAMgexlwjgNxj5WBfcMnuq+KIIV1w1z6yh9TieXAfaR++e/KS9mJdG3pjuEP7cH5Ah6g9/lACkwW9K5x/3JhvhgVe2Ze6q9fl/NAuJx5OfuhRSiSkUHYpkuDs8JFS/RsNrENS/CEp/pAU70J3SIpHh6T4Q1I8OyTFH5Lie8M6JMUfkuIPSfGHpPhDUvwhKb4G6pAUX/7rdexot4czGMUjbr4K9UsNB+lkvxCcKcKCZj/Bdi6p4hxOeYDSce8AsX+nQTRtvjswuN0UIrtLx5K3R3jphpyCe8eUcvzh/wIAAP//q6smcA=="
+	return "eJzsvW1z47ixL/7enwLlN9mtkrn2zGb+if9Vt65jz4OTnRnH9mzOOZMtGSIhCWsK4AKgPdpz891vNR5IkASfZMqzuWcTVzKSyO4fGo1Go9FoHKF7sj1FKV8dIKSoSskp+oGv0JKmBMWcKcLUAUIJkbGgmaKcnaL/dYAQQuecKUyZhHfN4yllREYHCC0pSRN5qh87QgxvyCmSPBcx0V8hpLYZOQXOj1wk9jtBfsmpIMkpUiJ3Dwb4wt/tmhiWS8E36HFN4zVSa4MAPWKJBMFJhG7XVBowuikaLTyGF5KnuSIow2qNFNfvAr2o4PCGC0S+4E0GArn77gGL71K++k5upSKbKOWru+ig0j6+XEqiKu1LOVs1GrfEqRzaOkNToxMk40KRxDRRKiyURFjVQGyIlHjlyBsUinxxsOiKcUHmeMEfyCk6bmAbJnirFYgvS5mDvE1n6K+sRtTQSSUI3gxSgQFSAi01FNHjmjDd5ZStXE8TAYopZyjGDC0I+oNUCc/VHxAX+t9EiD9U4WWCy4zEiosIJNfAVJFOJkiMFXToq+hlN1CQGWVZrnSb6ypLHkCWoLMrwogAmhXFpRJpHTBK+oDTnCCASZeUOLkhtORC/34HLO4Q19JClOkvDXNJYv2l7bY3NCULghXIa0ltf6FvLl5fXb8+P7t9fXGKJCHoTr+sBXL3bVVe5S/dovp3F0q11aBmc0U3RCq8ybobeclQjCWx/FZEKpTRjOghnGEhidQ/FdSqI8iOMzlDVCGpuCCyoAzPcEFXlOEU3f3vgsId+kaQTBBJmILB4MibIeIoV8zkt0YitCSuLWat2aAekqhow5M8HdC3hSTNC0itsSo7U/MzvdzCB4Q9got9bTAbuZUpX0VLHNOUqu10ZtsSROSLEjhWxLOKmaBcULUNQ3G/TgbFEXS6bZrcJQ1JHgi8MU/xgqRT2Wnop3W+wcZC40VKkGPU3Sl7h+EY1WBkgsdEyigTfCWmm68AAGi16w9Lvo05TabTBJp4TDX5KlOjE65XJuPrCDrmQdUj4oHGxB/vIUm3cLkxb2vZ1QiDJqXkgaTjqf7AVyswnvr1GlnThlgQMGP2DQM4wapHJpV3qx4pvIw4C06B9oWomE74siBZjGggQyVMjO2WHi22hcU01Pgmw4JKzgqC5VQCtDyVAaMdnqdgNonQ5RItuFojLAiiCUw/MXayR4izdOvTlmuepwn4ZbkkSU3Ga6WySBCZcSZJJBVWuZzHPCFtmtki73e3t1fI0UEeHefmFw7+98ffd0EgKc4kMdP+SAyvzatadmhB1CPRruovOTgDmCUlPsrQhqYpRZLEnCUy6kJkfYN5SthKrUdiOrcOvHnZjc6qtBY82YYRaOjRhqg1T8aPrWvzPjLvRwcHdgEKs3i5Av2L+dS16oz5ZsPBs9ezP6w3EX7ANNWWnTKE09SOIUBXWZZWWgUEfOsz0HoDQiQJS5yXBSPBLr+kHg3FU0Acee4V+CnWCTVuZi4wmCDt+8zge/gRK+vXUmnGCNCkCkYl425Bh2BtoF9Bay6V5WSfv+XIrR4LHDP4TX91Bw/fFXSqznETV9QUmuPYL7gCm3Y5VS4Y0cYIWPEMXEKQollbV62gBu7JTuSMUbYKoIEB9itnA9C4J/eJ5oEISTnrB2MfdGoFLw92Yg9Lg3rYNhUF12RLLjZYVZ4rTOFZvsqlQi9eqTV6cXzyaoZOXpy+/OPpH19GL1++6G9QaeOLicgMQxgggsRcJLWFXbVRCq9kN5czsaBKYLHVzxpp2UU+6HtGhOkosK7wQQnMJNbrvIIG2ISaNPWqx7GG308RX/xMYjfWzIf5CFtX2KpcElGOKTBQhlkNARGCC/u2YbMSPO9ZY76Glyw951PAaMJJQqHJOEWULTmihfNg+Eg3CfrBuvZYUjCe1AGrhGbpRA0G3owenL0GUfen85K0F5Rom58GUYcXIzdFxSnPk3KOOoeP4K8/0IRAMxVOsMLhaeu9/dV4TnHlVYlwkpQmCCfJXD8wdySdC8ZF6ywGj0b6rciRrQ9sEveM3g/e9FZFGKErLiUFxdVzktReHolfzNAqJjMIqSV0RRVOeUwwi1qxUSYVZjGZ06Qby6V9EF1eOEgwiaANjtfgbvZz6J+ZCh7+vD6Mi31g7ulZIWf1ItqQhOabbu7vDQltsccxt26OjjPMvSmvQJDLI4KlOjqJuyGceYQQEEK0nO2o1C4FuBPFNNeGKBNc20aa1KHYX46+dCPxVc++Aljecr5KiRlp7dwFWfVOtdf6mb722YGe8PieiHKkX7jPAeLmN724AJ80TUkZ9DG/wZiVay7U3MwA5fIZs3jNheN3VIxyb5D7TS5ghecH/xX/NTsnEBHR5Gk28ROjv+SkJIhoEnWx2+DVE62wrxeanPNOLQBwJBY5TRXirAuKZwx2RGLnciJsrKGdl45ayQa3ii/R40/0YLnUkjB8CqWFwVqq7DvzKUDkEpwBT1G5CJieUjeBbK9mWt7j9PLpffLOLiuavTGRpkO7gkqORbymisQqFxO0oUIOfUOiVYS+/OnV/NX3M4TFZoayLJ6hDc3kt00oXEZZihW49E9D8vEGOUIWQ0yY4nKG8kXOVD5Dj5Ql/LEFRHXFszsGSyfIY4k3NN0+mYUhYxspSLLGaoYSsqCYzdBSELKQSVdradaAQLNh3H+gUoFBu7w6wkkiIK4mmww2OG5wGNVIx2aNRfKIBSmZQQAgx2m6Re/Pzn0Mzo7c5wsiGFFEltbkb/53Abbl74UbXPVpS6KlL9s7LZYv9Rqg8tHRZijjyQTTgyeBjCea9EGQVU6TyThd8QR9urxoMoL/lRmOyWSsSopNZrACm1SCjCekRYRDJ9dhjAw1tMFZkxNmjCsd/5qMnUcyzHNKh8XjW5BtEWrJdgKXLcjX0LUWBmc4XpMXpXk5PDPfHIati/0VvXdbz1WzYeNaIbNQcgrbhJZmOIYuSNNtQHAMpqkhNJ/PMN/SJjfIwidzOCAyf2H5wK6ON2M0YfnQBNlwReaVyamrW3twwt95SiGYd3mF7NwRBTlDxMtfgk/AGYKLQFYrLYSxExNhXGBJY4RziJvDphMMhiIIHgRX2bsYgqxYzr59fTsetNvtgW4s9j1CuHKRjgA1lvOn6x/CbGFfZ9503ybgr1vccOh83m6/yd/e6wwIjuFcbGZVg4Q+f9jmmkOyTLTYlt5DLwIXQA+9NAAdyzcLIsBB0wTcClcS8UBECRvAtYltSYQoggFTdpcjHWaMVyYfFKGesPAAloXZg7bn7EhnRCUwsoXhg6QSEHdCH2H32GY1IWqEBY81SJrXXqdYKhpLAusqlKX5ijK7b+btEXKhv2g3E8Bh3t7guoEf22Lb3E9lc7Upn6y1ZUthI6TZzPDU4QsgIZBc0fi5W88GiKEYBn7Ub72VNMapZRq1gtrgn4tNkkFjdQQgTbu+JVfqYwcoyvYHirLdQGVYxeuDyk9T9p4mvwuugFswBFYxCZ+vBd+Q3YH74f4heLncAe0OWOp7z12I5s8+DMahe+7xMArdjgo4eZc6SCvCaTb5HPOW8MsrvfcLvgr05AqrNREQlcHgPXNmTxbYRYKdfxoUQ/ORIT5o6mnQ22UqgiUqZZDx9HydV/CMOmDlTIntnEoe8mAnAnZuuKDLm48BV9bHk3Kz/gmQsQpF+DzjlKndkICIwLZQlSe6c1GKlf7Qjslsz+253wyT2t5MHUkMG6b7xQEselBYeexXZex+Z1NjQrkm7famg9MbG6twmaAmWGFyS0YFKfwk3SES6Gl9JfFY03bjuZGI4qOIdVxjWhhlkKQwKZpLM8HLyi0MzeKeaNUFwFK+WpGkWyBlAnrv5D2Aow3go8uLMDc1KTe11unObcwqZ1gm6mtDE3Y3kjz2ckIrcnYB0DyhysskOjzTX7SEP03YUwcFYckItLF+vhhlw+OhjnF4xLc0sz7Sa9xD49sxNMdKEWrjONLG/EBZ/sW0AthH6ANXOtHXBk4hKynhcb4hDMYVODtoQWKcF6cVLJA12eoUpmTL8AaihyxBD5BRuNha8mXqsK9D9Xb6bTW5jX5K0IAWOvXpYlqy4Gkyx9UdpAH04ShryiEqUDspAJ3J08Qyv7yA0EuZEaCXRvoQEVK8QVTT0FTDUBl5nBoqI48F1MiT2uWFS//U+ENgBY4JWuZ6f91R5mUr4Svr2VJhTy+oLYrXmK2IRN+k9L7epwgUi29gNArO1bdhKUCHSSInFAL0lyRSr32m77FpsUKHlVgjdKlqHYUUJQg3iOp2KF7rsMXWJxZsgoR4N4vJhFOJPzAdeRu/DWPAcazGs9FNxrFeTyCbKi95TCFBGj1S5Z0JGjpdD+BaJlfa+bmF9j6JU0U2TwqhawKQ2YetErbzGc8G3nJnjlkCu0tE2hRC/RPPlWul4gqndVxVLPBffYrZPkUl+pUIfrTAkiT/P8L2xAdfomO0IZhJe+ADemhJBSQjtQYRsDvOP6J1hiYWKz1jOpNoIigoxmkaZuUfRB7MSxCZp4WwPB7oG5mbrU3IY8c0zQX59rcYKLnTtiCB4g8RbH7eHdQodgXwfw+YmIDJ/pfgFUT6uLX7tQ7mWSITPhzD8Pdw0gThpGcOn9iVW0xlzL2F2zl87l+3rTFLUnAV9fOIEfXIxb3d6ioWUv6Qdq11DMOWbsCCzXAcslbDEh80+rdmVges1biwrTy7OdPrqje3F2hJBXkEW+8DaTNjDtADYUljF6GrczvQwd+VXpsHjtcVoaEZuju7ObuD8yx3b24v7qIgrtq5xwmQXRhFMAuPJQXPgMVpDoeCKPOLN6wJToiYwaSC2TYaGU8b7wHfGLad8bQZOnY+ygaSmfXDJIzNvlIe/xkivGLj7/Dl8Yvjk5eH49pwWUq1CrxjoW2fmCrkRr6oOm+8VERUvil7P4ypdoKxX249qM40PaTwPWGg8UWFKZuza6zTTMcDbBegO33Y4m6G7hTBIuGP7G7WIHyXEEZJAk9lRGyoUuaDXb0d6eJVJDHDzH2ZgM1gTB9KaBl3CRVkYhlcOJKueywGytkMSh8teM4sUJ4r8ykMLhNc8ZhPGMi+shSb0MIIyt/bhtf48d8cOyWXmbc4keDkuDCE1hFt+p2SOB2XYeSmNNycMkXEspq1/EQZXjqSDr5hFem4hcFZNsg2RT/hGkYZVRQrLrqRZ0HINBuJtrFfYOF28YZBO1FvX3GhRnDe4CwjyVSNvxWYyVTPyDvJwaKZUBweomycZCCaM62HYLLEAiEhw1F7BPeMP7bYhoRIRZneGn6WYebxGwBoGgVqas0oFHsaR6MwPOOI2gXX/sfWKFTPOMo8tr1DzVZNmUhQF64Ii5WQP/8qK0T9s0A/Xn0oIuSUlWWEQihHZTv3QPxQhDw1VdhtYVInERfLlxJ1Bxydh70nTEDaHUazy24LzZNaFzZBYkIfipJfU+Nz5HfEKAj2i3g9eSBca3pO55wbB4ck2Ra84dJbb4eUp5UiDBOg8k+Du9MwcNBRL+g3kI5KkjCYNYX98XwP6qXL3TQQER1gg9Ppa1oUe9IT7wNuCaqrOJsv07L4zQTyuj2/QpqkE5pZmKEMx/ekZR+JxpsstIe9q6Quz99fVWpcDMUQCO49CQPQG4GBYdUuht16wxOCm4w9Cz5DdzYbwS6M4aRg3LLafMjYVNsgNm7Hl76Vqc0a4TidDyjEuE9eA8DB31sgjTKe0njr5Bc0hj6e4HmJSeBoH2EoDPtASJMmguNrleVWC9nc3PxgNOqMbc/NPIwyLAhTd+3AsZR0xUK+aIc/OhCy54w6Nm7r0WThleF2LkjCvIPoh+dckIsPNyNC7hBxtmlZZnq1JFoj7gXP8LBqaWA9Vcly+RpZUh5ru8Xo93MdxLDQcVhHO0C5Cu2ViDFUCp2VlQEc0JPohQ4XMfJIxOj0zLqkBgA799IwtWcIYvslJ6KxWRiSlo9rv8ODLyujwj1RBxFYl3VOlwOB+MvYEIRCBsnBQMY9TMuMkUBfOG76p6kU4e9AzA6JsV2/o023dvkUnQV+HGnwtSxmCNLT0J3dtzo7OzvrMOxxiiuH1UfDvvywG+5zYBwEfvmhA29gBTEKrv1HxIUt0zkauL/qsLixRBgtc6ii8kuOU9hcSlDCN5j2HUFo2SYY0qQBUHXEB6xBwacG/C5PMqMmKs46pC7pr2QPFuWG/loVJSyN9AI4OgjBSJiUJJ7z+xo1g2PBeUowOxgF4x9rAoegNISLDzc3r8/Rx7+hBTVXcHileTtM0CJfBgS0q9V7ffHh5hgt8uUS6siBhHDyQISikiQDwLjT4lOZxGt3+nw3q7hjTkYxXj98fH19/fE68EgP7gp2QOHMi6Vo1P7Df1x8fH/WaXFCi/AhTRiAz5VlAuXTbHyFcx35VUelA9E3MLuCnAnPF43jFT04bqE8ehm0hK1Fxfk9rAu0J0/8YWBXB8RPpvPWCJXvW1YKlWfsuqFlQVB59KBtdAXbVx8sXSWTKgQO/UkHnj48CIyVw4d/fPir/K9KQkRYSx1jyhLypZvzJTyiHw/zXNoLBI4UkepI36Izlj9NerjTJMwbf3y7unhcfLpenv/4x//v7Cb+ZXG+ehzOXkI9tE72xUUZ+tEwiuPhDHXG6EGfVQ7qTpuxdaRTvG2EOKqN0dl18FT1diVXTNzdH6QvsRJEKruhoP0HLhDN5kuaKiL85lYlAW/Vfw0LxEeuk7RDIZEmfBc4tAdjYL3G4ziHrYVThBln2w3P5dwEg+cmrDer1QKaLzFN9de1p8zHlcBwWGjmJQIEv3OvQbV1OEQ9t8V1ZkjkbI49QvazeaFdeBa0fW28GE339cvxH5DKbI27RtzoePRN8xejMxhdv765RWdXl+7lb30tKd57xN4Whk2XLh+DczSMpN/OdEJpOgeDhr4BRPqzriCHqJS5zcZzrNplV9LZWW52jd0putohztolZU2htQM++fOL6OTVn6KT6PsXhwcDwgUObSYoi2mG016gxZPoG7cz+q0JcZgBUBsW7VjnxcAaL9xgkloTq58UjW0eGiAFPSJfSJx3CjNOc6mION1wRhUX38G6azzUXNBenFr7CUv0kXn06fqyFdR38y+wrfKdJHEOqZLfzT1xk9HgrG71AnQG0uniCCmepwSLm1jwNLWXshzuCnMOlap6scJDrtPti3oznTCox9SBFF487M/FdKDc5YxVRXzi1OuIr+LdaSL09txdcGcZRB0sfbbZGk+3xLMBY3thYgxO9ttzw6Lq7ocx+bhqrmS/5uwUdnl77ipWQ6pDEGhzbTKXxO8r9x8DbZlyrHYDdl5DUjCEHWAuzIVAZjvjr/gBowcqVI5Tv7h2GLiMRb6Yy+1mwdO5gjGhL5zaVzvQFZyLRqq28oohpgJtyDNksCCNRfYC18XangH4ANwaSi/uR4Lv54Is5dyeUNT494hcr3JlBr5syVHDMGX34HCp9BrVDj3DAqcpSeeCyBiz50LtyXuDxT0IOaUPxBbE1ScjU4JwlqXWy4A8Wqk4pLW1N0bHpOc5SzlOnqslhhs0IGdwvs6AGCj9OMv9u+CGGeWBGK9spYzzq09IefpCBBSdBMClKQxAbDfZfgPAQWwRcr+gBzYE/mqN4LmSNDGxnHsowlzLhanDlFv5FVBSVgeJOlEKgtPngHmrDxjbuwjroBVc6gD+knJ3ZBSzlF626EMXMC8tKaNyHR2EWvLzw2YuctYyBNsb0tMAdy2YWVP+9cf3cF2KUGCpy9HmdlVATqDlxuXuOmlvqrzIuT54PQcrM58a+VssFnhVkablijRXuJY5s90QMhoOKjyW6dnFYZ5axADBRUyBm5NONy41ab7Z23NIZdBXpwLhMMs1wdnBUJvZw/AdwRnUf7HHVHUSge0X+utoXxbC6/P7ReN3B5AyRVbBtKIemOXghcabPR7K0D1NeSDG7kOCmWlvkD6BGdGI2sE4IFDIZEXYVB33MU3cCUzoN4jpZZjF299+D0q7WcKrLfgNdGerTPt7d8tztpqyf/8TCP6b9/C23obfQB93yDWMrpCbLi1+0MLsEO7RJrrqkI5PHB706UCznxwn8EI4qydrVdlBYljx3OFBOOrDIxLF0SZ6TxS+wAqf62uw9faUvfb78GDIxBWM3NQRmanr8GCI9od01DHRSlP5pc7JdOHb8/ZwV/2XNhxhJCWW8mbANix1Tl0oOvaZHUP1yPfP0DFbxXP+QASciD8YyrCNWYCRYyNT/ljNz6wyuDG/uyJV2sOtVHk5PAjx//zi+ORPR8evjl78+fbk+PT41enJ97M/v3z50+fLD28+op8+m51Ss7cdWRCR3v/+CX1+mP/41/XPP/6EPm+IEjTW+7GvopfR8RHQjY5fRS9e/fT5+CftEn7+PvrjRv400x/m+o5x+fl7/Rkc5zVV8vPJn79/+Uf4Cu7K/vzTDDx0Zf6hIehtps9///T6+j/nt+9ef5i/eX17/q6goXdL5ecTeF4fSPz83/881Gj/eXj63/881Icz5jhNzccF51L98/D0JDr+17/+9dPs8KBP25ua7joIPE4iOlQAbvO3t4a0aUNQ2Eui4nVIT9pNDAi4A4kO/3iZDTZGX6Y2tOF7eXy8kYcHPfFvDwf0YhcQ+L2N2bgmaz3pYHUDxxh0msYYfi3t8nSxi6V+SqtyG8+6Io9ss1bxeTMTtYYj5Y/d/TpikIyQEvmiBJ6bg8Ed8F7DY7YtfvWrNrAjEHiGpgNAuWalDBmzZNaqLQi+fzGql0rr1oUBHkLw0JRMjTnsZQu6AZmj5vEWAC/GARA8h3rzHbyvzRMt7A7l8cm7/3rx97/c//nnx+9XaoXfKHY4CgJN2rlfJi1sx7HosQC3HUM/4XEXL5dbxh74NhP8y9ZLLHtdfPmU8yeaCiToftm2nUGpcA9PigHuobMgHrOvcRTFZ+/OR/KVy3hMyBJDTUAIbOPK4YI6vM6+r3eh+7YXbT2dvsz2maE7uBWrLVV654vEesB4N4TVNsrD/DOs1tNxv8JqXWM7076JTXBJNT/YsbfZ0oI8Cqjuw1rQhXPdn4KwltVeoDSZviC+706iE9Nt+tOLlp5zea5THjMFhtpvzmXltKnjBYWywDTAsdxS1WQPwInPBJ8lCQW2+qYlhWkqEV5AFdNK9i8XlcFgxPvp3Z0+Wcg4WhOcqvUW5ZlUguCNvj76GY/P/4Un2/rJ+cLowFF1C6vr2NLkNQc8UNIrpzoQjssimAiMiVYWqQk1D6s2gKr9HcbnunoOt9TRmIQ2QHcGa/fyKxBl5tVsqCiav3nutSKM+8t8ycUjFglJ4F/TjaQfXa1egPAfR28cF/gXDJCEiJqYd7pmbidsn4prrQZBsD+OK8s3Tjw2xezo8sLKZlYUAY+5EARqwTiQLSYREuo45NZNB/LMkUTfwGXyFtq3g4RWDAjv9vspIFXPflYVX6f3adeSJF0D2Hqxa1x3Yd+dXQ3zX91VE/aFwnn0PTMniZJN2Gkc6Kt6rHQUulCDuje4J2e1wb9aG6KOwkdiPNjKT09SgjeantMBAGSOTnOmtyaMcwpVGGP9f9aRhn/qe2SMAxTnUvHNsxUxfWcpOtSVkqVhENaO77FMjOUwBhNNJprVXCbP5cUI9rZSYo1YMNoyAALUOLeTgFMineGEkVGOwNJrz2furSTavI3wMPNR0ax9ay300/+LJ+69cmRTdc9FSbLZRzN3k9Fi27Nw//o9WMrONQCmSh61wtlnXw4C44AshR6tyR7NoWNhTlsUS6euEenQLaBY0l7BWQ5IktQJyj/82QcQ1iVE7BGfvZ67isUeG9fLvRbB0b1KDeqNEZ34XI6A9r4tRrIubaidhRZve8OTCRG/50lDCcdYFYdKI553TZI7wXsNZItK3BbWktc7O4ypdSG8gxmGPREha+sP8OZsbaexttZSaPz+dPsWXLMr7hRP44cSGQ7CDB2deLFDxksdjVrh/5KTnDwX+CLF3e7H5kQ60FU10A3AC1stnSy5IO1NsK89VyNgW1nCwQcq13XYNiBl7FigP4oXu7rExQifrUGe5XX5rTBLVOKVZt3ud9cWPcKx12Fqpnft9tEgoItUqFkDR3g9ilpLdumA2APvL404ZZfDqaCOvy27CxHuCU3vOWcmIm17WgtBqyRl6HFNqt8TlpAkjLFUdDmVNbbl8oCxrh5KhGxCAqhmxhhrnOHk68NeBlLtKrKYM31ovnRDKWfS3Wtl18hRK043Ye8B6YeBGB2EdpDWe/yKGC2CdojG8H5FhAZAO0AB2WRE7huhheXYRQchLNadD3kArWB6gJQgrNmVTveLedzUx4AwG2X+1KNhRAcdivfVkVocXVAhbDiVbdS7nfbeXTuZAfkdYwK2mY3fuwAOAFkAtfQtjjq6LoQVlPjRlf2YQywt+HD3bDgCOPyduzNdlVakwXPEvZkJe8A3NGOhjq9ZfGEP4D5dX44C9UCEbIbU9gBMS81yG4UwxhncYZzMY87v6XPon2Xo4CHDeIbuju5g9cA4IwMhWx/9K2AuVgeSwLlpe8fV3f+pbY2MWuU83SDZpcvTLBI447kMZbH0TkEjEA/Jbok6cX5NxbWC/vfSXAu6Q3XtHi+NKVthb4v3Un/RssNrfrQbvS3buQXFsLYH21LXVUcrIYt8ddA3eIIka7f0WlfDBeVsQzT9SmZj28hxiJY4pumkSQQ3po6Kvhgaq/JgE4wQC9NGFL3r/qIgOHfR4nTgrriUFC4W1ldLS4QFQYdaaoczdMi4gnNUM3ToJ4DP0OEjFhBTPkSNjBmEDmNBoaxRehhuhG3hRFuYt+siINsQXcERU7ZHJYP6Ur/r2P9wHdMlC/Jsj2pmOfyuaf/DNM1N5NS/6OLy8mZ4ktbl5U01a8pXGdcQWhzGaSrugAytBo+QajpeJtf/oC6vJ44VgFCeIhg8RmwkiWbT6eFtGRkqUw+iIPfRZwSKoypvX9+OR+XWPN7CPIwrFxMm5jc4f7r+IcwWzjxtba2w/fDXHGwJsO6bsgOZH7sG7HwAQLazcE0uJ89TAP5AVnMwmbV6o2KBJY1rlT+hJhc8EQYnyIar1ittdwZns2D6RosugyaImJa5XXEb0j3nLSY8EOKxLtagSdtcqSvowWJ+auYyX3jL9jD3R8pevpie/z8oS/ijRL387dDRZwrmG7mHQRnahA1jkVRNfXEhAAGyurW6xg1lUmEWk047YSeu6bFU0nQ1E9hnKysbu3neu8fQ1hxpsxoQ+p+H46dPxOrHSsO8gyGlJ3KNy8RZEIhhYbbL9VX5JqOge3qZPsUa+m5dnlPQatKNAUrn6ntVo1E3vhrv+RSFXhqAsdx51gRqqlZYQwDXAbvI2/gtQTfWpB15+0Ge8YuFwgcG4eXsCM5kkATl5eEe4+VE6CNL4ZgtgZ52+UrBKxDNa5UCJfaOOPAKIFsGP2CaQuFUyEmBL9pdhZ6TS3Vvf2yLm83Va4HJWlu2FCxys5nhVYUvAHNLb+Pn7jE+QAwhO52ttxKWqZZp1Apqg3/mohVTYMSMAKRpO2vskJUd1AGKsv2Bomw3UBlUpdlf72nyu+AKTBpDYBULx/O14BuyO3Bf7Ybg5XIHtDtg4ZkuJ8dWSG6lIpsuRPNnHwbj0D33eBiFbkcFnLxLHaQV4TSbfI55S/jllV/5Bq0w3A0He2xYlheZFCtiO/80KIbmI0N80NTToLfLVAQOK4Wye8/YeQXPqANWzpTYzqnkbZvUEwA7h+RMsUWXNx8Di0wfjysVGyBjFYrwuS49vBsSsA5gW6jKE925KMVKf2jHJMgKLh3ab78ZJj23U8ZUbfeMA1j0oLDy2K/KXGsmAY1xKPRJk4OGetTsTQentgi2JjwqgP21Q3Ka+8TBUsu8N1b6dYP3lvvEbbfMe9v+NYMqv29bVLYtXMwiZJGeIzwsCJaczbO1aLsHaGcBWAhAHxn6YQg6rzg0OzyRP9hE/8aQjPM0MEP87gv+7gv+7gv+7gs+hy9oMzLu8fLez6z8G3xuycrQv6FNV16lIxe2WEGc9ZE+UeUbA7aoCOKLv87R5wp7aBLuP6382snJdXXxallux7GPgrxS8kDSNhfj8B9n1x8Ox6PQLIFwmKfNyJkoeh9K9QlxLTKsDoYrdg/r8yJpywmaQtL2BsI/IP8WIHAD1kSN1zff6Su1RkFQAjfi6WHtHoABoVsg585HhfUtrPF9Yunrn0HoGlLSre+UU7+2dnbaQFgIvbeVEDJcFqTR6NrhwFn/vWC5dYUEVLg3nbGmC8x8a22+aDHX5sfuPPiCYlgLg+DryvSVDfakpUP/puUx4IqnqU+I22PBmizEsw2QMO8NUa7L3H8Ma3NFYe0n8+W8Cs4pVL6A29/M1nPhAhRf9mdntpeALqm0JWtWmIdVZkDOJpi9klcl5lRXm4lurO/kD9eI29iHZmMAwcy8RUueQrl8eOP1Q30ylPGabLAzRHf65ej+TzKi/E5fTq7x+QpRb16liRndIapSOB8+9+8eTmqPdUgG/n6s7spoWvZ2e9PIKAwZnpu0POcnRn/JiVebzsZBZroavVFTDEZ3TdzdJkUKEORYIKrCUEOO2yC5vicKJ1jhce04A8kYpiXWasYSwqqsnOi4QK1EW5P0rkGUi+LHaxubaSmjKFWbw9jTWkfXHfAd1+obYFvrudLeFCUqAsLoaMa8cNJr/EyDEjwWpS4R5CMUBMdre3JA+k3QQMPQ7Lvz5onhLjn3IGueDDa5XYttbymUByIWI4AUHb4iahxGz3ACz5aa5yuiQJWhChj8/yPkHMA/Yn1bVli1EwIa16bR+kKQOc3CngPNxrXiRpNDl1eyIu0Yu+tAIS7yCLJXa8Hz1dqUnF9SAVVxoA6DfrFBt9i8CLcikA4UnskGNOHMu8jfpiNV+6IKITz51MHtL7LiF4cDTlE7DprsB0JjcunGoftE7gfKW027AAHqhNGG2CS/dkz6Ep0AxQ5nEv4Ig0huAsdMU0l2g+yV6PcD0pngDzQpy+V5Sf28JlzXCLrJiJCcwSw4n3JI6PriPvUAqH+3sVJpzm9l4IwA9QyjaDyo394wGtYGh98uEAVZTjV0PmqK/pBw5QTz0YNDkMAtX8M6vfBLMp7I3QR8bblrWTi5GoFFrZDhf2WGd8I8ANMHR34Mnv1BGYri+QxKHxKc0XlIq0fpFM4yuRveYjHv8DoVnyGyydS2ODMdQ7GuxrJ/6EJ/VGseTnZvi2Vfb007ZvfEE4CPGbo1fH3aIfOFA7gDsEKiwYNmA6HflBDCRtStjAwTWAvJGKfE3ImQ8lXLusclYXSdgtvB3MP9l3kxczomruamBT/W7nduhQYSUdpx/l/2vrW5cdxK+7t+Bcufkrc8Smbe9GxVtmprHV+2vet2O5Z6sskXCSYhCWMKVADQl/71WwcESJAEeBOo7tllxVXpsSw8D+7AwTnPqQsTGbF6VdZzJyFnh3YeEjeIxCnDw4iXG1n5FIPY9XqRynjxrPMVxtpdj8zN5qh6JOyJRBGmw2ryCYU7QqHJUQQ3Ce2Yk2wCFGwQiXFU1O9bvA19TPeIFvSMb+jmz8bCfGbjpVL4fLX5XQyeYiqJT1ZoEOGQlJfcQVNMF+Nswg5jAYFJ2/IHLfWBn6tKLXTDgW7zWpabDemNHG7DR/TwgfCYD0yTX7kP5jMbJURpIpBbqNd6K2i8EbTQ/QyeXCYsOH2CGTIxrKTqAmu8UWTu+o6RrIbVykp2pHrIBHPlYQ2G7fhd+xkgsKLhwrANHmV1S3atXGk95y3b4rerqM6e2FDTvG5N7h3+wxXradaU5VjFfzGIIlHu3yBVk9SdA2tlFgFXIwYu6oyetT9oX91amgR+vlBnpKqT0RRKOYVSTqGUUyjlFErpL5RSObKAl4VAfGe4sehfOZxY9MfN/lH6r2auXdRaierWZJSW/64geqcwzmZt23UPlxRdaK8gLPuVynmK7hp4UJZ2AB9FG9BQ54puLqrKfUKNI4UP77DSFZFlJzB5XL5Ltn/6tfG8mHtAe6SYlQn3HemLCSk4GDaO7uo0I7Cdktg586b077hb4ynBSJydUhAYVFAGQejQFnpxsl3hmstT49m6heMzfpcnQyn5GkixV+mxZzyDFFRmVT48Tl6PdFesFzHNrGlmnXxmuWdVf3aP6DWI0v0h323VDcoCouEz+4HtBHBEr5mvShlAEzYA+cNeGu98Gfafg1t6SAU/D25ILKRQ+edUwG9gtb5MIhw6RrNIkucVoatMj23W8ejWQvD6DYcpsM9ybum3In1z7qL+pnlRRJOT0ZJgTaxUd0L2gj33NKIXUjsqU9It9yoE823INmV1M62VUG/bUAsz2L9++LcysxIluErlLicZlaLdOv1DHY33Cd0m0ZNxMla/6a69+wm+cPWXsjauud3pJiuw7Huqs1HM46uBlg+V6t6qAY/cxI2tdNPCwL7DN8pANwIHwUJ9p9hAbZt3fk2/nXVZ4jQhe8RVC6OblIbKnQScm7aFtbuN3OXnT58u7q96UqS1Gd1CEHoLv4lWOoQSIT2FIW9sL1K2YltILYtjT3MclrGK6bn5zv8ZGzPz0/vir3fd5yVAya+UZybfJUysstXkz4Fgqet2q+Htc8dR7epN00Kgacb61xwpE+kvPeJyCe/Q7flXCxb2rs+x5BHPFeXQf9u9kM/uWc0/zP9l/pM6eBMVbSfhAhLNg5uEqRZSph4I1yFwekjMb9YQZMsFoXnjUGnaoFhrJW33jHxqnv1NCdU3VLT5qmEHtU3c4QeHpsBWj5fIlrEMCL2GssX/tUNFs2EB381ypmd+9VkIg1Qan1vBQKW1Pxh8K5stxT2nAVr3AqF2En199YFCoYjjkYj8IxleMuc4rOBmA2MTJ2hAi4kiw27BBs7w57DdqJP+eQC+QRkEbA1S36tReSdOwudR+KI9SJXBulTh/IqIjqyCigABWH2ecPHaN4cSaqVmp2TCj6ovS165lAn2tPSWlXShdEj2mTJaHNsbJg/8/QoWRUJxNB4jHiLajZBrFzyGTErJW1FwINAzpsUat15cL4tP103k5Ef98eX62VCst21YyWnrpK+3V/kgV+jqvEe3hL4Z5717+O9+5z35lYHnPQ1v36s6nvcsBGzbksbMkpnMqm1sAvfdJFXTZkQGJEvJBY5WEFJW+hNNDzGGype5Rorwc0Gzb8m5JxGMjQbzeXArssgcAA2ecIhSjgMilBjCHkwnSUBoGKcRPg+eMCcR5obHRQ2xKP68BJVNMZVWT3mK/vcPNwl7RSzCEfxrPQ8WGAco5pl/0Dpvk7VN9anWchUurltVh2a7rCn0Bcs8OO6QPsUkND40Vo+ci+zFddb48+AWEnYWX6zhqYJUYiXlqKJOzZazruLByAsSuBOROqIkZm3P7zpLyiSPV5LH+5ZKhbmHmsUr+pg9q6s03280xYJOhzNCd+mi7cBuD7z+V1HHY1/d7+x/dYaEL1OGhClDwpQhYcqQMGVImDIkTBkSpgwJU4aEKUPCbzRDQmG86v9Y6dmH7zojAIUGv8Pz7Tyr8XmgM0b/fm6lcfBmOn3IHxNBU2VDMAt+93B75cAVHk226mlUw9oBC6uuv0fby8JS3AavHh89XSVh6sn+zctVdumEawu7tkx/zn7jsE0rmzB+g/wOxfPCWpWzLhw5zbGsK1Wg2aeUtRbVSaELY5insThuikrj68Zep6x8SDPN4PZY2uWqnExelgX0iHla3XTVIyC8VBYagNKG2SAzh0LLpncEKXApIDRkeI8pPFGC5uB5sEfsWXrZyohUsTMzrMqY1Fo5MtvoPnnBkTSSh4gGT1irGZ7J70DCe/U3Z+fgd3nGKTrwXSIcKe3hmXlVzC5/lYaeKMrN13PAKyeYVaNcmR8I126+Zb7wv3s4esbxe15QfWfU1YIXNflo62kp+lJ+oVOjS44h83U54IQqLQp8SMLdPPjC1UsuOJqlQr9Orf/deNALkzjdOyyaIFdBI8SslUkH945y+GRYHcRLekhhEsdq3QVU+WSeRQuo+Z7w8nPdIeFiy3DZR+sh+2VvR63iewNf70ps7Cuds3XMR7wykfzkUV3MPLlYmshNzRAEdhomlXxslT7tNGXzr3b21CJ7/DWheBjUV7V65bCncQczj1NWQIuBNDcYnaFoT+hZA6LTU79WrMaDLeGpns6nwNy/R0+DIK0lN52SC8ybi+XFnW//s8jmSt7kSVPw+f9/nP+xF50r7SMuJVPUmmUjZZ6z6riL67vry2Xw/4Kbx8+fpAGX/2svHn+F4mXme3kEsHPQR01buww7wipPLFVwvlozHBFTx/wR/tuxRsvPgk9Np1RdnH3Vs9Ksrl2eltCMbL5aGp9VAce5oi0Nn89CZS1jlemI2HueJb5juaDEMj6hXCAa4nlwWTo2rveIC8ykNFaMXjD8I9yROFoHv4Njy+PVzR8uPt8Er3DPpdtAfvb78xoqCNCARY9QHK/t1fR8JS+vNdVqyUhHqAzoJSdc1osmgoTwLyD7mrnXrk84GWulevSQXWgXWOmuweAWhl/g6Am7eDYEXggKUECxeE3Ys3Fhn3ecKOE+8tt7YbLfQ2wqljFRVStudcOYp9zTPP0omwqCYIX0DwV/IsVBnX8zXjJILGTN4VheV49i1WjYrJ7xu99+gBir0pVMNwBcRZs7BzGfWUVgSiO2TWGT5MErETsHqRDFMY7yHY2njIRZugC9qS3Ur9rvHrlUPjT79S/XwX8uPt/X83ToAl0hXQYH+wR21L167tc4korey+Yz+/TU4PgFH7N43NhNOqXGMPu/SqNChYoRg03VnRt6Tp3QgjWKMZPS9xsQQzsP1hHl8J/gigT/L2KuJNJIjCHy3bHqw9f9XTJuC+uH4g7lnytXRuhrjlQIaCW7hjyuwh/baRK6IhsUemzge7UpECowg6INX/8DCp+xUNPuIFLmWgs4C10ej+TQj0+eKUC7EboRPebjVahQoh0vApU3T1W8wlxAvDpcT9rqKXE9VtTEdtf2wBKR+BtjS4YoB7Ss4DBxXBDR4bDyDH1hppRV4EGEBQ5htVOxiO75Jt7GXBQEtAtSzxxtVEi4P7gX1wF0Lj89yE5tgLM8Kx4FV39z1nAvMaKekH65u7g37M+OiXVA73GCIn8j7SErUPetWjtltJhgZLuVWYThE7llnYO3Mf75TwGm0ChRI8nVgREqwAPPI11dJFhh9wVrWQkXG6iSRwo+mogLhtHe09BZQI8lwY9F7iTZWXIHLBiCMolUVOV4DyqeioOdnyygAmQ/pXXgp05rcEiAYtWhoQxsP6O1PnK19WUHdvBzoR4PII5Ja9TiKDuCPUE4G47WcyezLYmctCy92ZGTTFthvkipwc7S4nhd58LJliI486xGIXXbkw7DLyOweMQvZZnkbk0yztjR6UY7cVHqC+/jULkEHSSy0SeHbq1jE7jw0025DoZB5Dz4MXjC+va6I9tdTd9aM9vUBag9LEFQ6sAV6PAs+EokmWV0hPa6z8Misj0LjLBUaKVnm0HWws4RUjY2O1vWOZOdDPQ4TeNJqF5Np8mdoO3q5NpajgvE3JwsiQ07cpIJDpONGQwnR51eNupnepMVptHInGLUk1Ld/O2j68wnOc3BcKBw07ElEva0zi90MuH2RhmcFKK7in7eKkXGzuwhr2L0gifzJBUNByl56sSRk+1TksQY0WFs/6YclxB9t56P22/V4cHXdrS8fIDrPCLxsXuSCA+rTVxPle2pi4GoLD7gGJeu+9KLaIffEORL2KN43k5xJU7GUm9RHTcAg2L4jSi2bgPvdJx5sfj7vWQmibnxN2Qk/JvbTviMi3HwHxfLLviHXCvaM/7D4mMXfBQ+j4N/cflfXfBTth0H/8vjf3TBP9V2Cisex9wdsh1R7msbKG4lEeVDN4D3w0jNYj5iXd0vAobDhEXnwVq5goNxBFH+ilnDln4iKwTwM+zSbj6MjRcAA15TBEd5Gry2QBhmsYuPRaVuMi9RGTMSxylCUGJgyZ7vj0HWBgChB0w2cN18hIidbIYPXHnnEkkQkxfcmYnlMWecSdTrYQeeqn2tg1J4wUTPFr1z7RAoHSsAUIbc6TfxgQum9sN3NulRg+2jDBdozN7WJHjiicWXx7vOJKBdV06tB1+E8jhzzavtvCtpQQgqBL2Ot1BCGB+wMtMAawGSFnJWGR1PtD7Jsvv1oVQuGYfOoxJF6UxIP1qPQ0euF/m7uAqFH5zgc/gSmu9tRt5RN40Y063YjUBjQb7Wxq5FPMfkAj7QrK5t7ql/7lQYNpBCOZZjXmlKIh7hXC3ioedqnj79OloDLbLCK8JHIWawIYe1nBAmL8J5illExyF2K0sfwotjRsbKybeQZRuyUX3JbQjdYib9EUZi+PHiRxNlUAtSMhI5af2S0ywgNILnwcJnsm0fVqvrOMyWdwsN4GZAE/GENwnDIzw4LOBxRXfWC4pJZLxX9uk8yAq7EZiNwPGaRj4YKqPGCuKe92Mb2aE5FaB0Q1Gg85mNmT7Q+1/+j7wqwNfHuyrogDdoK9Nnus7jZE9JJMaVR5vLu8+L66vME2b5+OX+8mJ5fdVg7+EiYacYXNBgcmRlgGBFjwh/biBGvuKRz16SE6GZKuJv5kJvUtuiAz9R3+0Ql3BuMnu0JSO9xtwAAyincKp9eg9i8iQxGyhFH8Yh9OnqQ7BDfGcOJTcNvkM/jsNDnmN6Efnpw8+jUfnpw889yADVU80swMrcLbP1p4jskTpnZlyP/EV3RYFMv22gkkCObt8/HVWtRvTYKNi2S40K+fNn1dau7tsObBv+HaHpWyUrf5+Injw2v/Rpp2Cu/KsgIQEh/AUr4KMDIudWXIdlsQOs/uYQ1ANLtgzt+4PqyN/BwLW55pxnHdg8FKGEmph0sc5cpToRUh96jIFVJerNs1lYwiLB0AFCCrUU6UkKqZ9A2vPAhaGAs+Ly2su0fQZ2IKRmIj/gEO4TcKpaLD7CSkBorzN0UxbLVhbZ8KwAVwOmzy7CEB8Ejs7Og7MbROJcQOiWyovc2dz4GwvGHiMKqv08lYkGNmmc1XNelKD+RnWMUktVQso6p18uJGmBUKKXOb9qeUUVkRB4fxCw2wUbWZm5o0UdVufOTVoRild67NXGPSDOYes+ky2aie4/4/czF6taMJkzyqwj1TDPHlDJ5FNuL7gB7FGEXbwilhwOOFqNzQ96sghQV10Mge3JAVP5akX2exwRJHD8rlm5SFsC5xrW1n6EoezjmtTtTt+JR/51vdprYnKMwZHRBWyTiW1a6zoQ6i0Wu1ZTGmbR3JFTYxzVWLturGv97aUd235c79CUNjG7BgXZbqqk4zEj4r2JVLNo62i0MtjG1mpX3PXGrl13t5Pybhft3R7t1VV/t9aZp2gyp+6syYenUTLiiU3F5OtEd8rOvwbUtRalmM+6rCIusdyK3pQ8Ft1/XsIxGVAw47PerVeTMIXSQsSzLQqKzQU1mg9IQrwPQ18u/25siiVE4pIVKWAPr9Ew2DBlDHbm7EE2Ye9HkLBcQYx+YkkihnEUiG2xUFkUE0PjpEqQvxIR7iximJqh+tthNDSQbgapEAQUCrSZDRR4oyg6/ZxTwAOnnXX36dRQRZ6oLNZOSq3OHTApiYaeNpvgb6/ms+7xsscByk5sQNzZEmZ0KBe+F2ySODIEYSl+lRV0YfEdjuMhYBHeoDQWWQENcDMbqmyBbzLGNfLJB7l5cIJOkUTmDpgjxpyTwO1VA7wG5u/8SKW0mtK8NtFlRRvm2m9sIVV81P49tyKPYSPtgjuSlbQTNIn6w7aaQ7sgqw9PYRBVzx+CIbwhz8b7xzL7TfcHEChXfan8BGEOaF3DAs8+tRxVUqtb3o1WPNsk0rhZctJZtVGPmdUJK1E5Jv1p6dPmu03vJJ5W5Cnd5ZTuckp3OaW7nNJdTukup3SXU7rLKd3llO5ySnc5pbuc0l1O6S6ndJffMN1lmYm8Hq5CGF+zjit1r+uNQuBW+A2TobaRrUuOMUmZc1hjwCkusrJ4AtUdGq1cl+8WDnYzBYs1AVW8esJT7QFr5CZhr4hBkjxlKfuKsWkm+wfGHWxkufR/Te0fvv+Hv7DEZShTcPa9poOVTJevUnZAqFNAYauJlYfufGZf2jUB8I6aVVu7uuE5iDRI/gMvWTYQM8d9lUfzi1fTHGwgBT9fKPlnWkodqMaCPWFJwcJBotomHShc00iu8rwN2t4sJqmEke3KfY2oHVw6sIOfwoBYpygxQd09YfNmXocRzpgPCRMDOYHl5tRtBZgJjXAbrRM2lYOSpjO6Kn+dkp0IhHPWDRxjq/S3sWpMLxclaV0+vYVVVbCyIOCUrdRcYNCvellGW7gUuqdKot0UZ1UruGumaVIwvE5OqmVMQ5uubFGZR4ymUiRm0WmVeMzFzVr+AqndN4B33KeY8B3kIJJndIHZXiXLsLMHX714Be0+6x7n18LejO8zRhw4E+sOxpG8sMSxw8otP1xBy5+Elu7iFlZ7wjmORhqAslQFAbNT6d1YAiM1nR2BgLN338NOFVsffnYW0KUrEJz23iJaFbPPCgGfr8hYi8Ttg0qjP3DpOlkztaxZ8PE3aaUWXiKlFMerAwIvSO5vYBdBm/k5CtMQHXgKPsN0Cy8ywLIY68aUs4s9er20gOTjdGeZ7izTneX/8J2lIWumgC/V49mdDdJ5OeykmapZMCG83UwekxSOp4wcpANiqQ3kyZUI3iISZst7fUTHaJnUKNkjYrMn57ghpFbx1BWXUFap9k2gbnvpoCqbqjNhVyIW/cWhlTdVhdsgR6y66EaDWWz2Q2ueqwU2mOjhoxFrzdopXFz4u31dZBIOAgnyooVupda3HXp56Q96yVKqTDNuwMcrf4CPOEwzR5IIcwLPrg24F2PgFs+wbuR/+BvL4L0WZUdWnSI25SmK4/fgK2aJHT8bA9zf6C7EnYu/49r2ZpNW1kyWyzvubWer6jpjFO5UXc9brW4MgxYkjvyNCdPqIRc3ZfD4NZc6KvwP57MqH+kAPWs74g+89cjC+9x7Nqe5+NSlhTQB8baC0AM+63RebsEHeeiqJ78bmo0EzXCIyQuOWuDhvLxKScT9tb/lUl6cyrkR+Zjru8ljsPRRjewss/nvj+JDfkRnAaIofv+KWZmSyuBipxPhg9h5WmWvoCxzhOqFLb9GNObwVOQ9dp/UTdONwgMkBAp3MI6SnKKdy57ssU3F+wgun8i+fIxzozt0HD0dqqD0c/CUeqbJ68lfeTQDOVlU10Tfxu5vTJDMRooyyAAWMTslwk/Jp2yzrS9Ado4w3UeymspSW3pNJALFXvGXUGLAyddeQxheKQjdfjcvIckLZpB2biRCSSpNbBxceGiIFUG5fb6CcA4EE7R0HNnjKEnFOINbYnPCpSklCpLUMcMyK/fK7zGqbkyC/kKEYtawDO+jD/4otCtmNqhlHoHbQSGzUR3zOOh2RUwNjt8EQ85rxSB8kPWPS/52OUgXIqswFclmM850KDNRJ0dpAsBRGy+LNLCvBa2gVRModiTS8XrbgrKnR6bpkWl6ZPoOH5nkzXbl8774QA44JhTiYiLz6pj7IXdzSesdMN1Cq0MyIw0NNwV/wL+gOM33KrB/BDuMnP2RMuIP+svjbacK+w/tLVVaJm7CrLHeKrbKHwMzDrxTI7SE3h7fDBB1+8MFADS2hCK5kmHiMaaepqWZI0BBNAZ7qxj5UVm0xpyDd2PKbTErgwkUSbKqPBop7PnW37AAL8uU5zomnXhABhGfDXEHme2NSD24jBdt08DBa0u4WajGsRMR9VzjR5C4zdIRJUye0F4QI0kq7YqMPKWGVoKaNnZKsHz4Ne99yYVHko1VccRORKsm+yPyoEp08ZB2xxAdROq0jx9Y8kZ8XgM/yhWU61RScNrIFa318gb3LwVsZwVmOGmU8DiYpHW6uG647H5NhJSh2DcpgBhAJ7ea8xHM5vncggtzP3LyfH/Kzms5ScPHJ+y7Lmy+VddZuGleNBHl8Bevpoas9MnYMBkbJmPDd2hsGNv+DytRwHCMlK0TfpctCQ4+kKwpXyT9McvXyKOIQTf443RVfDyU1XcTREkTnzFvpjNoU/W9Xnw+pnsE8wVFUi6rchVsosHTJ380LqKIqHuXcQvrRIOFs05LXQuDhfScyZe5HsMx4sILgyvMhQpVHELjMOu4tLbQkLtPD1xfJhmZYEEq02XPzn2qjjFbyWr5G5GWdUod7SKsHMcQ4ThqJYfkAuLx5Huh0+qgZ0wLaewGCjw9HGA4rTYJ8+Z0I908C3dOgwR4v2pI15VXZf0Z5/2Tl+cy3L+tWYYqWrEOBaHBZ2Az83uhNmVxAGo/A2uxJYvhrW009RRaKlsknYTNxtvW28yvlE8fOqBCNA6ZSyPXdCcqWh/KUlrDtOvI5k4V3o+R1q8ah1KujtXESXPhPJ61TbKB12/O4+nuPd29p7v3d3j39v7AuljcwalxebfIn1lTXiwI1cAOk0tIDjufr82XsryAp0QAcNwYYVIikrIX7I/HdRwDyTArtxeTrLk8R90tZKESQT+UFGyylId2NgzzdD/aKRFzbuifAJCdBYhMr1CMmfDXJPLBUZYpXarBJ17neyjmkp0OxW9ipU0V/hjloR4UbxMByTmj4JWIXXD/cA8T7OLu4d5OKFfdOUVPGWB2NiFmYhXuEKGjv8qUtesBGNIDIYG5g5oc6qsxGP4Pb2fQozoIxPG7n8JPYN7lHd7RZ3ow2b3Y7F4bVLYlS6kpNHG//WawWGqBYnfYqzHDz39lgCkzEybs24rOE8ruCHlxeJrlN4N+qdwcTMoO0zPvtb1nKW6yFalFmT6dIE5abWJhdFNoHazStb86xHl2oLLjgzoDiTXoZvUIdP37599qbve88EABtp85UbBzsjntkGXjZLC+gHWw6CWaI5g/Vrg3gRib0vfxTbsA40AjacsI98K4n1okT65tW2WB46mcXgcL68EDxQA5/Q4Sz4MPisERjSq0PyiO9KNpqRfsJyGNXJHhkKNHs4JA1mO0E9vXkkGOFL00pyoGX9cPTkKfiXMS9k/6VRBepvkrbHnZtExVtUH1N0MfiCQrf5Ho3rY9LJHjZS4Skf2qMEYeoOFUlKry8ix3uS/a8BQHpsSRKenHgrVWTC86I4mU9dYN2SHfhlVyncKRWHZg2oBkuymHYZBEYK3WxscTruBKqC47A4NgLODjUoCIQkEdLhjiXrZEen4FpNJRMe2uFk5iQKJ8O+xRAGlNWKKNRwamTeQQR052SRjcXI5o8PSV4OLUCKlawnzlMxdMpP9geG0ZHkgQptKJeFV1B04ipbUDKBYevJwlow9IX5gq4BkvRFWO7AuM1eOVXFnd1dBSq1rz0VLigfweAKC/rSg="
 }
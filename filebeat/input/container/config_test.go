@@ -0,0 +1,60 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]interface{}
+		err      bool
+	}{
+		{name: "defaults", settings: map[string]interface{}{}},
+		{name: "stdout", settings: map[string]interface{}{"stream": "stdout"}},
+		{name: "cri format", settings: map[string]interface{}{"format": "cri"}},
+		{name: "docker format", settings: map[string]interface{}{"format": "docker"}},
+		{name: "invalid stream", settings: map[string]interface{}{"stream": "stdin"}, err: true},
+		{name: "invalid format", settings: map[string]interface{}{"format": "syslog"}, err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := common.NewConfigFrom(test.settings)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			config := defaultConfig
+			err = cfg.Unpack(&config)
+			if test.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
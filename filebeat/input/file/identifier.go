@@ -0,0 +1,190 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package file
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/file"
+)
+
+// Names of the supported file identity strategies
+const (
+	NativeIdentifierName      = "native"
+	PathIdentifierName        = "path"
+	FingerprintIdentifierName = "fingerprint"
+)
+
+// ErrFileTooSmall is returned when a file is too small to be fingerprinted yet
+var ErrFileTooSmall = errors.New("file is too small to be fingerprinted")
+
+// StateIdentifier sets the identity of the states of the files collected by an input
+type StateIdentifier interface {
+	// Name returns the name of the identity strategy
+	Name() string
+	// Identify sets the identity of a new state of a file
+	Identify(state *State) error
+}
+
+type fingerprintConfig struct {
+	Offset int64 `config:"offset" validate:"min=0"`
+	Length int64 `config:"length" validate:"min=64"`
+}
+
+var defaultFingerprintConfig = fingerprintConfig{
+	Offset: 0,
+	Length: 1024,
+}
+
+// NewStateIdentifier creates the identifier configured in the file_identity namespace.
+// Native identity (inode and device) is used if no strategy is configured.
+func NewStateIdentifier(ns *common.ConfigNamespace) (StateIdentifier, error) {
+	if ns == nil || !ns.IsSet() {
+		return &nativeIdentifier{}, nil
+	}
+
+	switch ns.Name() {
+	case NativeIdentifierName:
+		return &nativeIdentifier{}, nil
+	case PathIdentifierName:
+		return &pathIdentifier{}, nil
+	case FingerprintIdentifierName:
+		config := defaultFingerprintConfig
+		if err := ns.Config().Unpack(&config); err != nil {
+			return nil, fmt.Errorf("error reading fingerprint file_identity config: %v", err)
+		}
+		return &fingerprintIdentifier{offset: config.Offset, length: config.Length}, nil
+	default:
+		return nil, fmt.Errorf("unknown file_identity: %s", ns.Name())
+	}
+}
+
+// nativeIdentifier identifies files by their inode and device, or their volume and index on Windows
+type nativeIdentifier struct{}
+
+func (*nativeIdentifier) Name() string { return NativeIdentifierName }
+
+func (*nativeIdentifier) Identify(state *State) error {
+	state.IdentifierName = ""
+	state.Fingerprint = ""
+	state.Id = ""
+	return nil
+}
+
+// pathIdentifier identifies files by their path
+type pathIdentifier struct{}
+
+func (*pathIdentifier) Name() string { return PathIdentifierName }
+
+func (*pathIdentifier) Identify(state *State) error {
+	state.IdentifierName = PathIdentifierName
+	state.Fingerprint = ""
+	state.Id = ""
+	return nil
+}
+
+// fingerprintIdentifier identifies files by a hash of a range of their content
+type fingerprintIdentifier struct {
+	offset int64
+	length int64
+}
+
+func (*fingerprintIdentifier) Name() string { return FingerprintIdentifierName }
+
+func (i *fingerprintIdentifier) Identify(state *State) error {
+	fingerprint, err := fingerprintFile(state.Source, i.offset, i.length)
+	if err != nil {
+		return err
+	}
+	state.IdentifierName = FingerprintIdentifierName
+	state.Fingerprint = fingerprint
+	state.Id = ""
+	return nil
+}
+
+// fingerprintFile hashes length bytes of the file starting at offset.
+// The returned fingerprint has the form <offset>-<length>-<sha256 hex> so it can be verified later on.
+func fingerprintFile(path string, offset, length int64) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	n, err := io.CopyN(h, f, length)
+	if err == io.EOF || n < length {
+		return "", ErrFileTooSmall
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatInt(offset, 10) + "-" + strconv.FormatInt(length, 10) + "-" +
+		hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// parseFingerprint returns the offset and length a fingerprint was computed with
+func parseFingerprint(fingerprint string) (offset, length int64, ok bool) {
+	var sum string
+	n, err := fmt.Sscanf(fingerprint, "%d-%d-%s", &offset, &length, &sum)
+	return offset, length, err == nil && n == 3
+}
+
+// MigrateState converts a state stored with another file identity strategy to the given identifier.
+// The state is only migrated if the file found under its source is still the same file according
+// to the strategy the state was stored with, otherwise false is returned.
+func MigrateState(state State, identifier StateIdentifier, info os.FileInfo) (State, bool) {
+	switch state.identifierName() {
+	case NativeIdentifierName:
+		if !file.GetOSState(info).IsSame(state.FileStateOS) {
+			return state, false
+		}
+	case PathIdentifierName:
+	case FingerprintIdentifierName:
+		offset, length, ok := parseFingerprint(state.Fingerprint)
+		if !ok {
+			return state, false
+		}
+		fingerprint, err := fingerprintFile(state.Source, offset, length)
+		if err != nil || fingerprint != state.Fingerprint {
+			return state, false
+		}
+	default:
+		return state, false
+	}
+
+	migrated := state
+	migrated.Fileinfo = info
+	migrated.FileStateOS = file.GetOSState(info)
+	if err := identifier.Identify(&migrated); err != nil {
+		return state, false
+	}
+	return migrated, true
+}
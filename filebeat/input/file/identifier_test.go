@@ -0,0 +1,100 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package file
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func newIdentifier(t *testing.T, config map[string]interface{}) StateIdentifier {
+	var ns *common.ConfigNamespace
+	if config != nil {
+		ns = &common.ConfigNamespace{}
+		require.NoError(t, common.MustNewConfigFrom(config).Unpack(ns))
+	}
+	identifier, err := NewStateIdentifier(ns)
+	require.NoError(t, err)
+	return identifier
+}
+
+func TestNewStateIdentifier(t *testing.T) {
+	assert.Equal(t, NativeIdentifierName, newIdentifier(t, nil).Name())
+	assert.Equal(t, NativeIdentifierName, newIdentifier(t, map[string]interface{}{"native": nil}).Name())
+	assert.Equal(t, PathIdentifierName, newIdentifier(t, map[string]interface{}{"path": nil}).Name())
+	assert.Equal(t, FingerprintIdentifierName, newIdentifier(t, map[string]interface{}{"fingerprint": nil}).Name())
+
+	ns := &common.ConfigNamespace{}
+	require.NoError(t, common.MustNewConfigFrom(map[string]interface{}{"inode_marker": nil}).Unpack(ns))
+	_, err := NewStateIdentifier(ns)
+	assert.Error(t, err)
+
+	ns = &common.ConfigNamespace{}
+	require.NoError(t, common.MustNewConfigFrom(map[string]interface{}{"fingerprint.length": 8}).Unpack(ns))
+	_, err = NewStateIdentifier(ns)
+	assert.Error(t, err)
+}
+
+func TestIdentify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filebeat-identity")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "test.log")
+	require.NoError(t, ioutil.WriteFile(path, []byte(strings.Repeat("a", 100)), 0644))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	native := NewState(info, path, "log", nil)
+	require.NoError(t, newIdentifier(t, nil).Identify(&native))
+	assert.Equal(t, "", native.IdentifierName)
+	assert.Equal(t, native.FileStateOS.String(), native.ID())
+
+	byPath := NewState(info, path, "log", nil)
+	require.NoError(t, newIdentifier(t, map[string]interface{}{"path": nil}).Identify(&byPath))
+	assert.Equal(t, PathIdentifierName, byPath.IdentifierName)
+	assert.Equal(t, "path::"+path, byPath.ID())
+
+	fingerprinter := newIdentifier(t, map[string]interface{}{"fingerprint.length": 64})
+	byFingerprint := NewState(info, path, "log", nil)
+	require.NoError(t, fingerprinter.Identify(&byFingerprint))
+	assert.Equal(t, FingerprintIdentifierName, byFingerprint.IdentifierName)
+	assert.True(t, strings.HasPrefix(byFingerprint.ID(), "fingerprint::0-64-"))
+
+	// Files with the same content range share the same identity
+	other := filepath.Join(dir, "other.log")
+	require.NoError(t, ioutil.WriteFile(other, []byte(strings.Repeat("a", 80)), 0644))
+	otherState := NewState(info, other, "log", nil)
+	require.NoError(t, fingerprinter.Identify(&otherState))
+	assert.True(t, byFingerprint.IsEqual(&otherState))
+
+	// Files smaller than the fingerprint are not identified yet
+	tooSmall := NewState(info, path, "log", nil)
+	err = newIdentifier(t, map[string]interface{}{"fingerprint": nil}).Identify(&tooSmall)
+	assert.Equal(t, ErrFileTooSmall, err)
+}
@@ -39,7 +39,12 @@ type State struct {
 	TTL         time.Duration     `json:"ttl"`
 	Type        string            `json:"type"`
 	Meta        map[string]string `json:"meta"`
+	Compressed  bool              `json:"compressed,omitempty"` // offset counts decompressed bytes
+	Completed   bool              `json:"completed,omitempty"`  // compressed file was read until the end
 	FileStateOS file.StateOS
+	// IdentifierName is the file identity strategy of the state, empty for native
+	IdentifierName string `json:"identifier_name,omitempty"`
+	Fingerprint    string `json:"fingerprint,omitempty"`
 }
 
 // NewState creates a new file state
@@ -64,14 +69,14 @@ func (s *State) ID() string {
 	// Generate id on first request. This is needed as id is not set when converting back from json
 	if s.Id == "" {
 		if len(s.Meta) == 0 {
-			s.Id = s.FileStateOS.String()
+			s.Id = s.fileID()
 		} else {
 			hashValue, _ := hashstructure.Hash(s.Meta, nil)
 			var hashBuf [17]byte
 			hash := strconv.AppendUint(hashBuf[:0], hashValue, 16)
 			hash = append(hash, '-')
 
-			fileID := s.FileStateOS.String()
+			fileID := s.fileID()
 
 			var b strings.Builder
 			b.Grow(len(hash) + len(fileID))
@@ -85,6 +90,26 @@ func (s *State) ID() string {
 	return s.Id
 }
 
+// fileID returns the identifier of the file according to the identity strategy of the state
+func (s *State) fileID() string {
+	switch s.IdentifierName {
+	case PathIdentifierName:
+		return PathIdentifierName + "::" + s.Source
+	case FingerprintIdentifierName:
+		return FingerprintIdentifierName + "::" + s.Fingerprint
+	default:
+		return s.FileStateOS.String()
+	}
+}
+
+// identifierName returns the name of the identity strategy of the state
+func (s *State) identifierName() string {
+	if s.IdentifierName == "" {
+		return NativeIdentifierName
+	}
+	return s.IdentifierName
+}
+
 // IsEqual compares the state to an other state supporting stringer based on the unique string
 func (s *State) IsEqual(c *State) bool {
 	return s.ID() == c.ID()
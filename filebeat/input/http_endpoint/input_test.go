@@ -0,0 +1,61 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http_endpoint
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/filebeat/inputsource"
+)
+
+func TestCreateEvent(t *testing.T) {
+	ip := "127.0.0.1"
+	addr := &net.TCPAddr{IP: net.ParseIP(ip), Port: 8080}
+
+	message := []byte(`{"message": "hello world", "count": 3, "nested": {"ok": true}}`)
+	mt := inputsource.NetworkMetadata{RemoteAddr: addr}
+
+	data, err := createEvent(message, mt, "json")
+	if !assert.NoError(t, err) {
+		return
+	}
+	event := data.GetEvent()
+
+	m, err := event.GetValue("json.message")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", m)
+
+	count, err := event.GetValue("json.count")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	ok, err := event.GetValue("json.nested.ok")
+	assert.NoError(t, err)
+	assert.Equal(t, true, ok)
+
+	from, _ := event.GetValue("source")
+	assert.Equal(t, "127.0.0.1:8080", from)
+}
+
+func TestCreateEventInvalidJSON(t *testing.T) {
+	_, err := createEvent([]byte(`{"message"`), inputsource.NetworkMetadata{}, "json")
+	assert.Error(t, err)
+}
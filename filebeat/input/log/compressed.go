@@ -0,0 +1,174 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package log
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+// Compression formats detected based on the magic bytes at the beginning of a file
+const (
+	compressionNone  = ""
+	compressionGzip  = "gzip"
+	compressionBzip2 = "bzip2"
+	compressionZstd  = "zstd"
+)
+
+// compressionHeaderSize is the number of bytes read to detect the compression format
+const compressionHeaderSize = 10
+
+var errUnsupportedCompression = errors.New("compression format is not supported")
+
+var (
+	gzipMagic  = []byte{0x1f, 0x8b, 0x08}
+	zstdMagic  = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	bzip2Magic = []byte("BZh")
+
+	// A bzip2 stream header is followed by either a block or the end of stream marker
+	bzip2BlockMagic = []byte{0x31, 0x41, 0x59, 0x26, 0x53, 0x59}
+	bzip2EOSMagic   = []byte{0x17, 0x72, 0x45, 0x38, 0x50, 0x90}
+)
+
+// detectCompression reads the header of the file to detect its compression format.
+// The file offset is reset to the beginning of the file afterwards.
+func detectCompression(f *os.File) (string, error) {
+	header := make([]byte, compressionHeaderSize)
+	n, err := io.ReadFull(f, header)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return compressionNone, err
+	}
+	header = header[:n]
+
+	if _, err := f.Seek(0, os.SEEK_SET); err != nil {
+		return compressionNone, err
+	}
+
+	switch {
+	case bytes.HasPrefix(header, gzipMagic):
+		return compressionGzip, nil
+	case bytes.HasPrefix(header, zstdMagic):
+		return compressionZstd, nil
+	case isBzip2Header(header):
+		return compressionBzip2, nil
+	}
+	return compressionNone, nil
+}
+
+func isBzip2Header(header []byte) bool {
+	if len(header) < compressionHeaderSize || !bytes.HasPrefix(header, bzip2Magic) {
+		return false
+	}
+
+	// block size from 1 to 9 (100k - 900k)
+	if level := header[3]; level < '1' || level > '9' {
+		return false
+	}
+
+	marker := header[4:]
+	return bytes.Equal(marker, bzip2BlockMagic) || bytes.Equal(marker, bzip2EOSMagic)
+}
+
+// isSupportedCompression returns true if the content of files compressed with
+// the given format can be read.
+func isSupportedCompression(format string) bool {
+	return format == compressionGzip || format == compressionBzip2
+}
+
+// CompressedFile is a harvester source returning the decompressed content of a file.
+// It is not continuable, the harvester is closed as soon as the end of the
+// compressed stream is reached.
+type CompressedFile struct {
+	file   *os.File
+	reader io.Reader
+	format string
+}
+
+func newCompressedFile(f *os.File, format string) (*CompressedFile, error) {
+	var r io.Reader
+
+	switch format {
+	case compressionGzip:
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		r = gz
+	case compressionBzip2:
+		r = bzip2.NewReader(f)
+	default:
+		return nil, errUnsupportedCompression
+	}
+
+	return &CompressedFile{
+		file:   f,
+		reader: r,
+		format: format,
+	}, nil
+}
+
+// Read reads decompressed content. The end of the stream is only reported
+// once no more data is returned, as the readers consuming the source drop
+// the data read together with an error.
+func (c *CompressedFile) Read(p []byte) (int, error) {
+	n, err := c.reader.Read(p)
+	if n > 0 && err == io.EOF {
+		err = nil
+	}
+	return n, err
+}
+
+// Close closes the decompressor and the underlying file.
+func (c *CompressedFile) Close() error {
+	if closer, ok := c.reader.(io.Closer); ok {
+		closer.Close()
+	}
+	return c.file.Close()
+}
+
+// Name returns the name of the compressed file.
+func (c *CompressedFile) Name() string {
+	return c.file.Name()
+}
+
+// Stat returns the file info of the compressed file.
+func (c *CompressedFile) Stat() (os.FileInfo, error) {
+	return c.file.Stat()
+}
+
+// Continuable returns false, a compressed file is read once until the end of the stream.
+func (*CompressedFile) Continuable() bool { return false }
+
+// HasState returns true, the offset in the decompressed content is stored in the registry.
+func (*CompressedFile) HasState() bool { return true }
+
+// Skip discards the first n bytes of the decompressed content. As compressed
+// content can't be seeked, it is used to resume reading from a registry offset.
+func (c *CompressedFile) Skip(n int64) error {
+	skipped, err := io.CopyN(ioutil.Discard, c.reader, n)
+	if err == io.EOF {
+		return fmt.Errorf("decompressed content has %d bytes, less than the offset %d", skipped, n)
+	}
+	return err
+}
@@ -0,0 +1,243 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package log
+
+import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/input/file"
+	"github.com/elastic/beats/filebeat/reader/encode/encoding"
+)
+
+const compressedContent = "first line\nsecond line\n"
+
+// compressedContent compressed with bzip2
+var bzip2Content = []byte{
+	0x42, 0x5a, 0x68, 0x39, 0x31, 0x41, 0x59, 0x26, 0x53, 0x59, 0x8b, 0x13,
+	0xe1, 0x84, 0x00, 0x00, 0x04, 0xd1, 0x80, 0x00, 0x10, 0x40, 0x00, 0x0f,
+	0x25, 0x9c, 0x00, 0x20, 0x00, 0x21, 0xa1, 0x32, 0x31, 0x94, 0x20, 0x1a,
+	0x00, 0x91, 0x2a, 0x31, 0x95, 0x68, 0xcb, 0x04, 0x82, 0xfd, 0x57, 0xf1,
+	0x77, 0x24, 0x53, 0x85, 0x09, 0x08, 0xb1, 0x3e, 0x18, 0x40,
+}
+
+func writeGzipFile(t *testing.T, path string, content string) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	w := gzip.NewWriter(f)
+	_, err = w.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+}
+
+func TestDetectCompression(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compressed")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeGzipFile(t, filepath.Join(dir, "gzip"), compressedContent)
+
+	files := map[string][]byte{
+		"bzip2":     bzip2Content,
+		"zstd":      {0x28, 0xb5, 0x2f, 0xfd, 0x00},
+		"plain":     []byte(compressedContent),
+		"plain_bzh": []byte("BZh9 looks like bzip2\n"),
+		"empty":     nil,
+	}
+	for name, content := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), content, 0644))
+	}
+
+	tests := map[string]string{
+		"gzip":      compressionGzip,
+		"bzip2":     compressionBzip2,
+		"zstd":      compressionZstd,
+		"plain":     compressionNone,
+		"plain_bzh": compressionNone,
+		"empty":     compressionNone,
+	}
+
+	for name, expected := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := os.Open(filepath.Join(dir, name))
+			require.NoError(t, err)
+			defer f.Close()
+
+			format, err := detectCompression(f)
+			require.NoError(t, err)
+			assert.Equal(t, expected, format)
+
+			offset, err := f.Seek(0, os.SEEK_CUR)
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), offset)
+		})
+	}
+}
+
+func TestCompressedFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compressed")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeGzipFile(t, filepath.Join(dir, "test.log.gz"), compressedContent)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "test.log.bz2"), bzip2Content, 0644))
+
+	tests := map[string]string{
+		"test.log.gz":  compressionGzip,
+		"test.log.bz2": compressionBzip2,
+	}
+
+	for name, format := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := os.Open(filepath.Join(dir, name))
+			require.NoError(t, err)
+
+			source, err := newCompressedFile(f, format)
+			require.NoError(t, err)
+			defer source.Close()
+
+			assert.False(t, source.Continuable())
+			assert.True(t, source.HasState())
+
+			require.NoError(t, source.Skip(int64(len("first line\n"))))
+
+			content, err := ioutil.ReadAll(source)
+			require.NoError(t, err)
+			assert.Equal(t, "second line\n", string(content))
+		})
+	}
+}
+
+func TestCompressedFileUnsupported(t *testing.T) {
+	f, err := ioutil.TempFile("", "compressed")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	_, err = newCompressedFile(f, compressionZstd)
+	assert.Equal(t, errUnsupportedCompression, err)
+	assert.False(t, isSupportedCompression(compressionZstd))
+}
+
+func TestCompressedFileSkipBeyondEnd(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compressed")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "test.log.gz")
+	writeGzipFile(t, path, compressedContent)
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+
+	source, err := newCompressedFile(f, compressionGzip)
+	require.NoError(t, err)
+	defer source.Close()
+
+	assert.Error(t, source.Skip(int64(len(compressedContent)+1)))
+}
+
+func TestHarvesterResumeCompressedFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compressed")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "test.log.gz")
+	writeGzipFile(t, path, compressedContent)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	h := Harvester{
+		config: config{
+			LogConfig: LogConfig{
+				CloseInactive: 500 * time.Millisecond,
+				Backoff:       100 * time.Millisecond,
+				MaxBackoff:    1 * time.Second,
+				BackoffFactor: 2,
+			},
+			BufferSize: 100,
+			MaxBytes:   1000,
+			Decompress: true,
+		},
+		state: file.NewState(info, path, "log", nil),
+	}
+	h.state.Offset = int64(len("first line\n"))
+
+	var ok bool
+	h.encodingFactory, ok = encoding.FindEncoding(h.config.Encoding)
+	require.True(t, ok)
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+
+	h.source, err = h.validateFile(f)
+	require.NoError(t, err)
+	defer h.source.Close()
+
+	assert.True(t, h.state.Compressed)
+	assert.Equal(t, int64(len("first line\n")), h.state.Offset)
+
+	r, err := h.newLogFileReader()
+	require.NoError(t, err)
+
+	_, text, bytesread, _, err := readLine(r)
+	require.NoError(t, err)
+	assert.Equal(t, "second line", text)
+	assert.Equal(t, len("second line\n"), bytesread)
+
+	// The end of a compressed file closes the harvester without waiting for close_inactive
+	_, _, _, _, err = readLine(r)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestHarvesterSkipsUnsupportedCompression(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compressed")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "test.log.zst")
+	require.NoError(t, ioutil.WriteFile(path, append(zstdMagic, 0, 0, 0, 0, 0, 0), 0644))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	h := Harvester{
+		config: config{Decompress: true},
+		state:  file.NewState(info, path, "log", nil),
+	}
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = h.validateFile(f)
+	assert.Equal(t, errUnsupportedCompression, err)
+}
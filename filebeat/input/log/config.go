@@ -29,6 +29,8 @@ import (
 	"github.com/elastic/beats/filebeat/input/file"
 	"github.com/elastic/beats/filebeat/reader/json"
 	"github.com/elastic/beats/filebeat/reader/multiline"
+	"github.com/elastic/beats/filebeat/reader/parser"
+	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/cfgwarn"
 	"github.com/elastic/beats/libbeat/common/match"
 	"github.com/elastic/beats/libbeat/logp"
@@ -57,6 +59,7 @@ var (
 		// Harvester
 		BufferSize: 16 * humanize.KiByte,
 		MaxBytes:   10 * humanize.MiByte,
+		Decompress: false,
 		LogConfig: LogConfig{
 			Backoff:       1 * time.Second,
 			BackoffFactor: 2,
@@ -79,36 +82,43 @@ type config struct {
 	CleanInactive time.Duration `config:"clean_inactive" validate:"min=0"`
 
 	// Input
-	Enabled        bool            `config:"enabled"`
-	ExcludeFiles   []match.Matcher `config:"exclude_files"`
-	IgnoreOlder    time.Duration   `config:"ignore_older"`
-	Paths          []string        `config:"paths"`
-	ScanFrequency  time.Duration   `config:"scan_frequency" validate:"min=0,nonzero"`
-	CleanRemoved   bool            `config:"clean_removed"`
-	HarvesterLimit uint32          `config:"harvester_limit" validate:"min=0"`
-	Symlinks       bool            `config:"symlinks"`
-	TailFiles      bool            `config:"tail_files"`
-	RecursiveGlob  bool            `config:"recursive_glob.enabled"`
+	Enabled        bool                    `config:"enabled"`
+	ExcludeFiles   []match.Matcher         `config:"exclude_files"`
+	IgnoreOlder    time.Duration           `config:"ignore_older"`
+	Paths          []string                `config:"paths"`
+	ScanFrequency  time.Duration           `config:"scan_frequency" validate:"min=0,nonzero"`
+	CleanRemoved   bool                    `config:"clean_removed"`
+	HarvesterLimit uint32                  `config:"harvester_limit" validate:"min=0"`
+	Symlinks       bool                    `config:"symlinks"`
+	TailFiles      bool                    `config:"tail_files"`
+	RecursiveGlob  bool                    `config:"recursive_glob.enabled"`
+	FileIdentity   *common.ConfigNamespace `config:"file_identity"`
 
 	// Harvester
 	BufferSize int    `config:"harvester_buffer_size"`
 	Encoding   string `config:"encoding"`
 	ScanOrder  string `config:"scan.order"`
 	ScanSort   string `config:"scan.sort"`
+	Decompress bool   `config:"decompress"`
 
-	ExcludeLines []match.Matcher   `config:"exclude_lines"`
-	IncludeLines []match.Matcher   `config:"include_lines"`
-	MaxBytes     int               `config:"max_bytes" validate:"min=0,nonzero"`
-	Multiline    *multiline.Config `config:"multiline"`
-	JSON         *json.Config      `config:"json"`
-
-	// Hidden on purpose, used by the docker input:
-	DockerJSON *struct {
-		Stream string `config:"stream"`
+	ExcludeLines []match.Matcher          `config:"exclude_lines"`
+	IncludeLines []match.Matcher          `config:"include_lines"`
+	MaxBytes     int                      `config:"max_bytes" validate:"min=0,nonzero"`
+	Multiline    *multiline.Config        `config:"multiline"`
+	JSON         *json.Config             `config:"json"`
+	Parsers      []common.ConfigNamespace `config:"parsers"`
+}
 
-		// TODO move this to true by default
-		Partial bool `config:"partial"`
-	} `config:"docker-json"`
+// legacyParsers lists the settings which configure a parser, in the order
+// they are applied. `docker-json` is hidden on purpose, it is set by the
+// docker and container inputs.
+var legacyParsers = []struct {
+	setting string
+	parser  string
+}{
+	{"docker-json", "docker_json"},
+	{"json", "json"},
+	{"multiline", "multiline"},
 }
 
 type LogConfig struct {
@@ -174,6 +184,10 @@ func (c *config) Validate() error {
 		return fmt.Errorf("When using the JSON decoder and line filtering together, you need to specify a message_key value")
 	}
 
+	if len(c.Parsers) > 0 && (c.JSON != nil || c.Multiline != nil) {
+		return fmt.Errorf("json and multiline cannot be used together with parsers, add them to the parsers instead")
+	}
+
 	if c.ScanSort != "" {
 		cfgwarn.Experimental("scan_sort is used.")
 
@@ -191,6 +205,46 @@ func (c *config) Validate() error {
 	return nil
 }
 
+// readerParsers returns the parsers reading the lines of a file. The parsers
+// derived from the legacy settings run before the parsers listed under `parsers`.
+func readerParsers(cfg *common.Config, parsers []common.ConfigNamespace) ([]common.ConfigNamespace, error) {
+	var result []common.ConfigNamespace
+	for _, legacy := range legacyParsers {
+		if !cfg.HasField(legacy.setting) {
+			continue
+		}
+
+		sub, err := cfg.Child(legacy.setting, -1)
+		if err != nil {
+			return nil, err
+		}
+
+		p, err := parser.NewConfig(legacy.parser, sub)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	return append(result, parsers...), nil
+}
+
+// lastJSONConfig returns the settings of the last json parser.
+func lastJSONConfig(parsers []common.ConfigNamespace) *json.Config {
+	var config *json.Config
+	for _, p := range parsers {
+		if p.Name() != "json" || !p.IsSet() {
+			continue
+		}
+
+		c := json.Config{}
+		if err := p.Config().Unpack(&c); err != nil {
+			continue
+		}
+		config = &c
+	}
+	return config
+}
+
 // resolveRecursiveGlobs expands `**` from the globs in multiple patterns
 func (c *config) resolveRecursiveGlobs() error {
 	if !c.RecursiveGlob {
@@ -26,6 +26,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/elastic/beats/filebeat/harvester"
+	"github.com/elastic/beats/filebeat/reader/json"
+	"github.com/elastic/beats/libbeat/common"
 )
 
 func TestCleanOlderError(t *testing.T) {
@@ -70,3 +72,68 @@ func TestCleanOlderIgnoreOlder(t *testing.T) {
 	err := config.Validate()
 	assert.NoError(t, err)
 }
+
+func TestParsersWithLegacySettingsError(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"paths":   []string{"hello"},
+		"json":    map[string]interface{}{"keys_under_root": true},
+		"parsers": []map[string]interface{}{{"multiline": map[string]interface{}{"type": "count", "count_lines": 2}}},
+	})
+
+	config := defaultConfig
+	assert.Error(t, cfg.Unpack(&config))
+}
+
+func TestReaderParsers(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"paths":       []string{"hello"},
+		"docker-json": map[string]interface{}{"stream": "stdout"},
+		"parsers": []map[string]interface{}{
+			{"json": map[string]interface{}{"message_key": "log"}},
+			{"multiline": map[string]interface{}{"type": "count", "count_lines": 2}},
+			{"json": map[string]interface{}{"keys_under_root": true}},
+		},
+	})
+
+	config := defaultConfig
+	if !assert.NoError(t, cfg.Unpack(&config)) {
+		return
+	}
+
+	parsers, err := readerParsers(cfg, config.Parsers)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var names []string
+	for _, p := range parsers {
+		names = append(names, p.Name())
+	}
+	assert.Equal(t, []string{"docker_json", "json", "multiline", "json"}, names)
+
+	assert.Equal(t, &json.Config{KeysUnderRoot: true}, lastJSONConfig(parsers))
+}
+
+func TestReaderParsersFromLegacySettings(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"paths":     []string{"hello"},
+		"multiline": map[string]interface{}{"pattern": "^ ", "match": "after"},
+		"json":      map[string]interface{}{"message_key": "log"},
+	})
+
+	config := defaultConfig
+	if !assert.NoError(t, cfg.Unpack(&config)) {
+		return
+	}
+
+	parsers, err := readerParsers(cfg, config.Parsers)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var names []string
+	for _, p := range parsers {
+		names = append(names, p.Name())
+	}
+	assert.Equal(t, []string{"json", "multiline"}, names)
+}
@@ -50,12 +50,13 @@ import (
 	"github.com/elastic/beats/filebeat/harvester"
 	"github.com/elastic/beats/filebeat/input/file"
 	"github.com/elastic/beats/filebeat/reader"
-	"github.com/elastic/beats/filebeat/reader/docker_json"
+	_ "github.com/elastic/beats/filebeat/reader/docker_json"
 	"github.com/elastic/beats/filebeat/reader/encode"
 	"github.com/elastic/beats/filebeat/reader/encode/encoding"
 	"github.com/elastic/beats/filebeat/reader/json"
 	"github.com/elastic/beats/filebeat/reader/limit"
-	"github.com/elastic/beats/filebeat/reader/multiline"
+	_ "github.com/elastic/beats/filebeat/reader/multiline"
+	"github.com/elastic/beats/filebeat/reader/parser"
 	"github.com/elastic/beats/filebeat/reader/strip_newline"
 	"github.com/elastic/beats/filebeat/util"
 )
@@ -99,6 +100,7 @@ type Harvester struct {
 	reader          reader.Reader
 	encodingFactory encoding.EncodingFactory
 	encoding        encoding.Encoding
+	parsers         []common.ConfigNamespace
 
 	// event/state publishing
 	outletFactory OutletFactory
@@ -127,7 +129,8 @@ func NewHarvester(
 		outletFactory: outletFactory,
 	}
 
-	if err := config.Unpack(&h.config); err != nil {
+	err := config.Unpack(&h.config)
+	if err != nil {
 		return nil, err
 	}
 
@@ -137,6 +140,16 @@ func NewHarvester(
 	}
 	h.encodingFactory = encodingFactory
 
+	h.parsers, err = readerParsers(config, h.config.Parsers)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fields decoded by a json parser are merged into the event like the ones of the json settings
+	if jsonConfig := lastJSONConfig(h.parsers); jsonConfig != nil {
+		h.config.JSON = jsonConfig
+	}
+
 	// Add ttl if clean_inactive is set
 	if h.config.CleanInactive > 0 {
 		h.state.TTL = h.config.CleanInactive
@@ -168,6 +181,9 @@ func (h *Harvester) ID() uuid.UUID {
 // Setup opens the file handler and creates the reader for the harvester
 func (h *Harvester) Setup() error {
 	err := h.open()
+	if err == errUnsupportedCompression {
+		return err
+	}
 	if err != nil {
 		return fmt.Errorf("Harvester setup failed. Unexpected file opening error: %s", err)
 	}
@@ -271,7 +287,12 @@ func (h *Harvester) Run() error {
 			case ErrClosed:
 				logp.Info("Reader was closed: %s. Closing.", h.state.Source)
 			case io.EOF:
-				logp.Info("End of file reached: %s. Closing because close_eof is enabled.", h.state.Source)
+				if h.state.Compressed {
+					logp.Info("End of compressed file reached: %s. Closing as the file is completely harvested.", h.state.Source)
+					h.state.Completed = true
+				} else {
+					logp.Info("End of file reached: %s. Closing because close_eof is enabled.", h.state.Source)
+				}
 			case ErrInactive:
 				logp.Info("File is inactive: %s. Closing because close_inactive of %v reached.", h.state.Source, h.config.CloseInactive)
 			default:
@@ -426,53 +447,100 @@ func (h *Harvester) openFile() error {
 	harvesterOpenFiles.Add(1)
 
 	// Makes sure file handler is also closed on errors
-	err = h.validateFile(f)
+	source, err := h.validateFile(f)
 	if err != nil {
 		f.Close()
 		harvesterOpenFiles.Add(-1)
 		return err
 	}
 
-	h.source = File{File: f}
+	h.source = source
 	return nil
 }
 
-func (h *Harvester) validateFile(f *os.File) error {
+func (h *Harvester) validateFile(f *os.File) (harvester.Source, error) {
 	info, err := f.Stat()
 	if err != nil {
-		return fmt.Errorf("Failed getting stats for file %s: %s", h.state.Source, err)
+		return nil, fmt.Errorf("Failed getting stats for file %s: %s", h.state.Source, err)
 	}
 
 	if !info.Mode().IsRegular() {
-		return fmt.Errorf("Tried to open non regular file: %q %s", info.Mode(), info.Name())
+		return nil, fmt.Errorf("Tried to open non regular file: %q %s", info.Mode(), info.Name())
 	}
 
 	// Compares the stat of the opened file to the state given by the input. Abort if not match.
 	if !os.SameFile(h.state.Fileinfo, info) {
-		return errors.New("file info is not identical with opened file. Aborting harvesting and retrying file later again")
+		return nil, errors.New("file info is not identical with opened file. Aborting harvesting and retrying file later again")
 	}
 
-	h.encoding, err = h.encodingFactory(f)
-	if err != nil {
-
-		if err == transform.ErrShortSrc {
-			logp.Info("Initialising encoding for '%v' failed due to file being too short", f)
-		} else {
-			logp.Err("Initialising encoding for '%v' failed: %v", f, err)
+	if h.config.Decompress {
+		format, err := detectCompression(f)
+		if err != nil {
+			return nil, fmt.Errorf("Failed detecting compression of file %s: %s", h.state.Source, err)
 		}
-		return err
+		if format != compressionNone {
+			if !isSupportedCompression(format) {
+				logp.Warn("Skipping file %s, %s compressed files are not supported", h.state.Source, format)
+				return nil, errUnsupportedCompression
+			}
+			return h.validateCompressedFile(f, format)
+		}
+	}
+
+	err = h.initEncoding(f)
+	if err != nil {
+		return nil, err
 	}
 
 	// get file offset. Only update offset if no error
 	offset, err := h.initFileOffset(f)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	logp.Debug("harvester", "Setting offset for file: %s. Offset: %d ", h.state.Source, offset)
 	h.state.Offset = offset
 
-	return nil
+	return File{File: f}, nil
+}
+
+// validateCompressedFile sets up reading the decompressed content of a compressed file.
+// The offset of compressed files is counted in decompressed bytes. As the content can't
+// be seeked, the already harvested content is decompressed and discarded on resume.
+func (h *Harvester) validateCompressedFile(f *os.File, format string) (harvester.Source, error) {
+	source, err := newCompressedFile(f, format)
+	if err != nil {
+		return nil, fmt.Errorf("Failed opening %s compressed file %s: %s", format, h.state.Source, err)
+	}
+
+	err = h.initEncoding(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.state.Offset > 0 {
+		logp.Debug("harvester", "Set previous offset for compressed file: %s. Offset: %d ", h.state.Source, h.state.Offset)
+		err = source.Skip(h.state.Offset)
+		if err != nil {
+			return nil, fmt.Errorf("Failed resuming compressed file %s: %s", h.state.Source, err)
+		}
+	}
+
+	h.state.Compressed = true
+	return source, nil
+}
+
+func (h *Harvester) initEncoding(r io.Reader) error {
+	var err error
+	h.encoding, err = h.encodingFactory(r)
+	if err != nil {
+		if err == transform.ErrShortSrc {
+			logp.Info("Initialising encoding for '%v' failed due to file being too short", h.state.Source)
+		} else {
+			logp.Err("Initialising encoding for '%v' failed: %v", h.state.Source, err)
+		}
+	}
+	return err
 }
 
 func (h *Harvester) initFileOffset(file *os.File) (int64, error) {
@@ -531,12 +599,14 @@ func (h *Harvester) cleanup() {
 //
 // It creates a chain of readers which looks as following:
 //
-//   limit -> (multiline -> timeout) -> strip_newline -> json -> encode -> line -> log_file
+//   limit -> parsers... -> strip_newline -> encode -> line -> log_file
 //
 // Each reader on the left, contains the reader on the right and calls `Next()` to fetch more data.
 // At the base of all readers the the log_file reader. That means in the data is flowing in the opposite direction:
 //
-//   log_file -> line -> encode -> json -> strip_newline -> (timeout -> multiline) -> limit
+//   log_file -> line -> encode -> strip_newline -> parsers... -> limit
+//
+// The parsers, like docker_json, json or multiline, are chained in the configured order.
 //
 // log_file implements io.Reader interface and encode reader is an adapter for io.Reader to
 // reader.Reader also handling file encodings. All other readers implement reader.Reader
@@ -557,22 +627,14 @@ func (h *Harvester) newLogFileReader() (reader.Reader, error) {
 		return nil, err
 	}
 
-	if h.config.DockerJSON != nil {
-		// Docker json-file format, add custom parsing to the pipeline
-		r = docker_json.New(r, h.config.DockerJSON.Stream, h.config.DockerJSON.Partial)
-	}
-
-	if h.config.JSON != nil {
-		r = json.New(r, h.config.JSON)
-	}
-
 	r = strip_newline.New(r)
 
-	if h.config.Multiline != nil {
-		r, err = multiline.New(r, "\n", h.config.MaxBytes, h.config.Multiline)
-		if err != nil {
-			return nil, err
-		}
+	r, err = parser.New(r, h.parsers, parser.Settings{
+		MaxBytes:      h.config.MaxBytes,
+		LineSeparator: "\n",
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return limit.New(r, h.config.MaxBytes), nil
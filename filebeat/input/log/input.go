@@ -68,6 +68,7 @@ type Input struct {
 	done          chan struct{}
 	numHarvesters atomic.Uint32
 	meta          map[string]string
+	identifier    file.StateIdentifier
 }
 
 // NewInput instantiates a new Log
@@ -118,6 +119,10 @@ func NewInput(
 	if err := p.config.normalizeGlobPatterns(); err != nil {
 		return nil, fmt.Errorf("Failed to normalize globs patterns: %v", err)
 	}
+	p.identifier, err = file.NewStateIdentifier(p.config.FileIdentity)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create empty harvester to check if configs are fine
 	// TODO: Do config validation instead
@@ -156,6 +161,10 @@ func (p *Input) loadStates(states []file.State) error {
 				return fmt.Errorf("Can only start an input when all related states are finished: %+v", state)
 			}
 
+			if state.IdentifierName != p.identifierName() {
+				state = p.migrateState(state)
+			}
+
 			// Update input states and send new states to registry
 			err := p.updateState(state)
 			if err != nil {
@@ -169,6 +178,40 @@ func (p *Input) loadStates(states []file.State) error {
 	return nil
 }
 
+// identifierName returns the name stored in the states for the configured file identity
+func (p *Input) identifierName() string {
+	if name := p.identifier.Name(); name != file.NativeIdentifierName {
+		return name
+	}
+	return ""
+}
+
+// migrateState converts a state stored with another file identity to the configured one.
+// The previous state is removed from the registry. If the file can not be verified to be the
+// same as the one of the state, the state is returned unchanged.
+func (p *Input) migrateState(state file.State) file.State {
+	info, err := os.Stat(state.Source)
+	if err != nil {
+		logp.Debug("input", "State for %s not migrated to file_identity %s: %s", state.Source, p.identifier.Name(), err)
+		return state
+	}
+
+	migrated, ok := file.MigrateState(state, p.identifier, info)
+	if !ok {
+		logp.Debug("input", "State for %s not migrated to file_identity %s: file changed", state.Source, p.identifier.Name())
+		return state
+	}
+
+	// Remove the state stored under the previous identity
+	state.TTL = 0
+	if err := p.updateState(state); err != nil {
+		logp.Err("Problem removing state migrated to file_identity %s: %+v", p.identifier.Name(), err)
+	}
+
+	logp.Info("State for %s migrated to file_identity %s", state.Source, p.identifier.Name())
+	return migrated
+}
+
 // Run runs the input
 func (p *Input) Run() {
 	logp.Debug("input", "Start next scan")
@@ -211,7 +254,8 @@ func (p *Input) Run() {
 			} else {
 				// Check if existing source on disk and state are the same. Remove if not the case.
 				newState := file.NewState(stat, state.Source, p.config.Type, p.meta)
-				if !newState.FileStateOS.IsSame(state.FileStateOS) {
+				err := p.identifier.Identify(&newState)
+				if err != nil || !newState.IsEqual(&state) {
 					p.removeState(state)
 					logp.Debug("input", "Remove state for file as file removed or renamed: %s", state.Source)
 				}
@@ -404,6 +448,9 @@ func getFileState(path string, info os.FileInfo, p *Input) (file.State, error) {
 	logp.Debug("input", "Check file for harvesting: %s", absolutePath)
 	// Create new state for comparison
 	newState := file.NewState(info, absolutePath, p.config.Type, p.meta)
+	if err := p.identifier.Identify(&newState); err != nil {
+		return file.State{}, err
+	}
 	return newState, nil
 }
 
@@ -456,8 +503,13 @@ func (p *Input) scan() {
 		}
 
 		newState, err := getFileState(path, info, p)
+		if err == file.ErrFileTooSmall {
+			logp.Debug("input", "Skipping file %s until it can be fingerprinted: %s", path, err)
+			continue
+		}
 		if err != nil {
 			logp.Err("Skipping file %s due to error %s", path, err)
+			continue
 		}
 
 		// Load last state
@@ -493,10 +545,21 @@ func (p *Input) scan() {
 func (p *Input) harvestExistingFile(newState file.State, oldState file.State) {
 	logp.Debug("input", "Update existing file for harvesting: %s, offset: %v", newState.Source, oldState.Offset)
 
+	// The offset of compressed files counts decompressed bytes and can't be compared
+	// to the file size. Harvesting is resumed until the end of the file was reached.
+	if oldState.Compressed && oldState.Finished && !oldState.Completed {
+		logp.Debug("input", "Resuming harvesting of compressed file: %s, offset: %d", newState.Source, oldState.Offset)
+		err := p.startHarvester(newState, oldState.Offset)
+		if err != nil {
+			logp.Err("Harvester could not be started on existing compressed file: %s, Err: %s", newState.Source, err)
+		}
+		return
+	}
+
 	// No harvester is running for the file, start a new harvester
 	// It is important here that only the size is checked and not modification time, as modification time could be incorrect on windows
 	// https://blogs.technet.microsoft.com/asiasupp/2010/12/14/file-date-modified-property-are-not-updating-while-modifying-a-file-without-closing-it/
-	if oldState.Finished && newState.Fileinfo.Size() > oldState.Offset {
+	if !oldState.Compressed && oldState.Finished && newState.Fileinfo.Size() > oldState.Offset {
 		// Resume harvesting of an old file we've stopped harvesting from
 		// This could also be an issue with force_close_older that a new harvester is started after each scan but not needed?
 		// One problem with comparing modTime is that it is in seconds, and scans can happen more then once a second
@@ -509,7 +572,7 @@ func (p *Input) harvestExistingFile(newState file.State, oldState file.State) {
 	}
 
 	// File size was reduced -> truncated file
-	if oldState.Finished && newState.Fileinfo.Size() < oldState.Offset {
+	if !oldState.Compressed && oldState.Finished && newState.Fileinfo.Size() < oldState.Offset {
 		logp.Debug("input", "Old file was truncated. Starting from the beginning: %s, offset: %d, new size: %d ", newState.Source, newState.Fileinfo.Size())
 		err := p.startHarvester(newState, 0)
 		if err != nil {
@@ -668,6 +731,14 @@ func (p *Input) startHarvester(state file.State, offset int64) error {
 	}
 
 	err = h.Setup()
+	if err == errUnsupportedCompression {
+		// The file is marked as completed, it is not opened again on the next scans
+		p.numHarvesters.Dec()
+		state.Finished = true
+		state.Compressed = true
+		state.Completed = true
+		return p.updateState(state)
+	}
 	if err != nil {
 		p.numHarvesters.Dec()
 		return fmt.Errorf("error setting up harvester: %s", err)
@@ -20,10 +20,15 @@
 package log
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/elastic/beats/filebeat/input/file"
 	"github.com/elastic/beats/filebeat/util"
+	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/match"
 
 	"github.com/stretchr/testify/assert"
@@ -148,8 +153,9 @@ func TestInit(t *testing.T) {
 			config: config{
 				Paths: test.paths,
 			},
-			states: file.NewStates(),
-			outlet: TestOutlet{},
+			states:     file.NewStates(),
+			outlet:     TestOutlet{},
+			identifier: newTestIdentifier(t, nil),
 		}
 
 		// Set states to finished
@@ -164,6 +170,101 @@ func TestInit(t *testing.T) {
 	}
 }
 
+// TestMigrateStates checks that states stored with another file identity are migrated
+// to the configured one if the file is still the same
+func TestMigrateStates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filebeat-identity")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	large := filepath.Join(dir, "large.log")
+	small := filepath.Join(dir, "small.log")
+	assert.NoError(t, ioutil.WriteFile(large, []byte(strings.Repeat("line\n", 500)), 0644))
+	assert.NoError(t, ioutil.WriteFile(small, []byte("line\n"), 0644))
+
+	nativeState := func(path string) file.State {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		state := file.NewState(info, path, "log", nil)
+		state.Finished = true
+		state.Offset = 42
+		return state
+	}
+
+	tests := map[string]struct {
+		identity   map[string]interface{}
+		state      file.State
+		identifier string
+	}{
+		"native to path": {
+			identity:   map[string]interface{}{"path": nil},
+			state:      nativeState(large),
+			identifier: file.PathIdentifierName,
+		},
+		"native to fingerprint": {
+			identity:   map[string]interface{}{"fingerprint": nil},
+			state:      nativeState(large),
+			identifier: file.FingerprintIdentifierName,
+		},
+		"file too small to be fingerprinted": {
+			identity:   map[string]interface{}{"fingerprint": nil},
+			state:      nativeState(small),
+			identifier: "",
+		},
+		"file replaced": {
+			identity: map[string]interface{}{"path": nil},
+			state: func() file.State {
+				state := nativeState(large)
+				state.FileStateOS.Inode++
+				return state
+			}(),
+			identifier: "",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ns := &common.ConfigNamespace{}
+			assert.NoError(t, common.MustNewConfigFrom(test.identity).Unpack(ns))
+
+			p := Input{
+				config: config{
+					Paths: []string{filepath.Join(dir, "*.log")},
+				},
+				states:     file.NewStates(),
+				outlet:     TestOutlet{},
+				identifier: newTestIdentifier(t, ns),
+			}
+
+			assert.NoError(t, p.loadStates([]file.State{test.state}))
+
+			var found bool
+			for _, state := range p.states.GetStates() {
+				if state.TTL == 0 {
+					continue
+				}
+				found = true
+				assert.Equal(t, test.identifier, state.IdentifierName)
+				assert.Equal(t, test.state.Source, state.Source)
+				assert.Equal(t, int64(42), state.Offset)
+			}
+			assert.True(t, found)
+		})
+	}
+}
+
+func newTestIdentifier(t *testing.T, ns *common.ConfigNamespace) file.StateIdentifier {
+	identifier, err := file.NewStateIdentifier(ns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return identifier
+}
+
 // TestOutlet is an empty outlet for testing
 type TestOutlet struct{}
 
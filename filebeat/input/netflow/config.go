@@ -0,0 +1,69 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netflow
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/elastic/beats/filebeat/harvester"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/ipfix"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/protocol"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/v5"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/v9"
+	"github.com/elastic/beats/filebeat/inputsource/udp"
+)
+
+var defaultConfig = config{
+	ForwarderConfig: harvester.ForwarderConfig{
+		Type: "netflow",
+	},
+	Config: udp.Config{
+		MaxMessageSize: 10 * humanize.KiByte,
+		Host:           "localhost:2055",
+		Timeout:        time.Minute * 5,
+	},
+	Protocols:           []string{v5.ProtocolName, v9.ProtocolName, ipfix.ProtocolName},
+	ExpirationTimeout:   30 * time.Minute,
+	QueueSize:           8192,
+	DetectSequenceReset: true,
+}
+
+type config struct {
+	udp.Config                `config:",inline"`
+	harvester.ForwarderConfig `config:",inline"`
+
+	Protocols           []string      `config:"protocols"`
+	ExpirationTimeout   time.Duration `config:"expiration_timeout" validate:"min=0"`
+	QueueSize           int           `config:"queue_size" validate:"min=1"`
+	DetectSequenceReset bool          `config:"detect_sequence_reset"`
+}
+
+func (c *config) Validate() error {
+	if len(c.Protocols) == 0 {
+		return fmt.Errorf("at least one protocol must be enabled")
+	}
+	for _, name := range c.Protocols {
+		if _, err := protocol.Get(name); err != nil {
+			return fmt.Errorf("invalid protocol '%v', supported protocols are %v", name, protocol.Names())
+		}
+	}
+	return nil
+}
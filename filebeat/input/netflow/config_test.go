@@ -0,0 +1,54 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package netflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestConfigValidation(t *testing.T) {
+	tests := map[string]struct {
+		settings map[string]interface{}
+		valid    bool
+	}{
+		"defaults":          {map[string]interface{}{}, true},
+		"ipfix only":        {map[string]interface{}{"protocols": []string{"ipfix"}}, true},
+		"unknown protocol":  {map[string]interface{}{"protocols": []string{"v7"}}, false},
+		"invalid queue":     {map[string]interface{}{"queue_size": 0}, false},
+		"negative timeout":  {map[string]interface{}{"expiration_timeout": "-1m"}, false},
+		"disabled timeouts": {map[string]interface{}{"expiration_timeout": 0}, true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := defaultConfig
+			err := common.MustNewConfigFrom(test.settings).Unpack(&cfg)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
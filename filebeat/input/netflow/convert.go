@@ -0,0 +1,223 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netflow
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"hash/fnv"
+	"net"
+	"time"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/record"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// flowEndReasonActiveTimeout is the flow_end_reason of long-lasting flows,
+// exported while they are still active.
+const flowEndReasonActiveTimeout = 2
+
+var transports = map[uint64]string{
+	1:   "icmp",
+	6:   "tcp",
+	17:  "udp",
+	47:  "gre",
+	50:  "esp",
+	58:  "ipv6-icmp",
+	132: "sctp",
+}
+
+// toEvent converts a decoded record into an event. All the decoded fields
+// are under `netflow`, flow records also have a normalized `flow` object
+// similar to the flows published by Packetbeat.
+func toEvent(r record.Record) beat.Event {
+	netflow := toMapStr(r.Fields)
+	netflow["type"] = "netflow_" + r.Type.String()
+	netflow["exporter"] = toMapStr(r.Exporter)
+	if len(r.Options) > 0 {
+		netflow["scope"] = toMapStr(r.Options)
+	}
+
+	fields := common.MapStr{
+		"netflow": netflow,
+	}
+	if address, ok := r.Exporter["address"]; ok {
+		fields["source"] = address
+	}
+	if r.Type == record.Flow {
+		fields["flow"] = flowFields(r)
+	}
+
+	return beat.Event{
+		Timestamp: r.Timestamp,
+		Fields:    fields,
+	}
+}
+
+func toMapStr(m record.Map) common.MapStr {
+	result := make(common.MapStr, len(m))
+	for key, value := range m {
+		result[key] = fieldValue(value)
+	}
+	return result
+}
+
+// fieldValue converts the decoded values which are not serialized in a
+// readable format.
+func fieldValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case net.IP:
+		return v.String()
+	case net.HardwareAddr:
+		return v.String()
+	case []byte:
+		return hex.EncodeToString(v)
+	}
+	return value
+}
+
+func flowFields(r record.Record) common.MapStr {
+	source, dest := common.MapStr{}, common.MapStr{}
+	id := fnv.New128a()
+	if address, ok := r.Exporter["address"].(string); ok {
+		id.Write([]byte(address))
+	}
+
+	addEndpoint := func(endpoint common.MapStr, ipv4, ipv6, port, mac string) {
+		if ip, ok := r.Fields[ipv4].(net.IP); ok {
+			endpoint["ip"] = ip.String()
+			id.Write(ip)
+		}
+		if ip, ok := r.Fields[ipv6].(net.IP); ok {
+			endpoint["ipv6"] = ip.String()
+			id.Write(ip)
+		}
+		if p, ok := r.Fields[port].(uint64); ok {
+			endpoint["port"] = p
+			var buf [2]byte
+			binary.BigEndian.PutUint16(buf[:], uint16(p))
+			id.Write(buf[:])
+		}
+		if m, ok := r.Fields[mac].(net.HardwareAddr); ok {
+			endpoint["mac"] = m.String()
+		}
+	}
+	addEndpoint(source, "source_ipv4_address", "source_ipv6_address", "source_transport_port", "source_mac_address")
+	addEndpoint(dest, "destination_ipv4_address", "destination_ipv6_address", "destination_transport_port", "destination_mac_address")
+
+	if stats := stats(r.Fields,
+		[]string{"octet_delta_count", "octet_total_count", "initiator_octets"},
+		[]string{"packet_delta_count", "packet_total_count", "initiator_packets"},
+	); len(stats) > 0 {
+		source["stats"] = stats
+	}
+	if stats := stats(r.Fields,
+		[]string{"responder_octets"},
+		[]string{"responder_packets"},
+	); len(stats) > 0 {
+		dest["stats"] = stats
+	}
+
+	flow := common.MapStr{
+		"source": source,
+		"dest":   dest,
+		"final":  true,
+	}
+
+	if proto, ok := r.Fields["protocol_identifier"].(uint64); ok {
+		id.Write([]byte{byte(proto)})
+		if transport, found := transports[proto]; found {
+			flow["transport"] = transport
+		}
+	}
+	if reason, ok := r.Fields["flow_end_reason"].(uint64); ok && reason == flowEndReasonActiveTimeout {
+		flow["final"] = false
+	}
+	for _, name := range []string{"vlan_id", "dot1q_vlan_id"} {
+		if vlan, ok := r.Fields[name].(uint64); ok {
+			flow["vlan"] = vlan
+			break
+		}
+	}
+
+	start, end := flowTimes(r)
+	flow["start_time"] = common.Time(start)
+	flow["last_time"] = common.Time(end)
+	flow["id"] = base64.RawURLEncoding.EncodeToString(id.Sum(nil))
+
+	return flow
+}
+
+// stats returns the bytes and packets counters, using the first field found.
+func stats(fields record.Map, bytesFields, packetsFields []string) common.MapStr {
+	stats := common.MapStr{}
+	for _, name := range bytesFields {
+		if value, ok := fields[name].(uint64); ok {
+			stats["net_bytes_total"] = value
+			break
+		}
+	}
+	for _, name := range packetsFields {
+		if value, ok := fields[name].(uint64); ok {
+			stats["net_packets_total"] = value
+			break
+		}
+	}
+	return stats
+}
+
+// flowTimes returns the start and end time of a flow. Depending on the
+// exporter they are encoded as absolute timestamps, relative to the system
+// uptime of the exporter or relative to the export time. The export time is
+// used if the record doesn't contain them.
+func flowTimes(r record.Record) (start, end time.Time) {
+	start, end = r.Timestamp, r.Timestamp
+	if t, ok := flowTime(r, "start"); ok {
+		start = t
+	}
+	if t, ok := flowTime(r, "end"); ok {
+		end = t
+	}
+	return start, end
+}
+
+func flowTime(r record.Record, which string) (time.Time, bool) {
+	for _, suffix := range []string{"milliseconds", "seconds", "microseconds", "nanoseconds"} {
+		if t, ok := r.Fields["flow_"+which+"_"+suffix].(time.Time); ok {
+			return t, true
+		}
+	}
+
+	if delta, ok := r.Fields["flow_"+which+"_delta_microseconds"].(uint64); ok {
+		return r.Timestamp.Add(-time.Duration(delta) * time.Microsecond), true
+	}
+
+	sysUptime, ok := r.Fields["flow_"+which+"_sys_up_time"].(uint64)
+	if !ok {
+		return time.Time{}, false
+	}
+	uptime, ok := r.Exporter["uptime_millis"].(uint64)
+	if !ok {
+		return time.Time{}, false
+	}
+	// The uptime values are 32 bit milliseconds counters which can wrap around
+	elapsed := int64(int32(uint32(uptime) - uint32(sysUptime)))
+	return r.Timestamp.Add(-time.Duration(elapsed) * time.Millisecond), true
+}
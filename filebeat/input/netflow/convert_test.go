@@ -0,0 +1,181 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package netflow
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/record"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+var exportTime = time.Unix(1540000000, 0).UTC()
+
+func TestToEventFlow(t *testing.T) {
+	r := record.Record{
+		Type:      record.Flow,
+		Timestamp: exportTime,
+		Fields: record.Map{
+			"source_ipv4_address":        net.IP{192, 168, 0, 1},
+			"destination_ipv4_address":   net.IP{10, 0, 0, 2},
+			"source_transport_port":      uint64(51234),
+			"destination_transport_port": uint64(443),
+			"source_mac_address":         net.HardwareAddr{0, 1, 2, 3, 4, 5},
+			"protocol_identifier":        uint64(6),
+			"octet_delta_count":          uint64(1500),
+			"packet_delta_count":         uint64(10),
+			"flow_start_sys_up_time":     uint64(50000),
+			"flow_end_sys_up_time":       uint64(59000),
+			"vlan_id":                    uint64(12),
+			"flow_end_reason":            uint64(flowEndReasonActiveTimeout),
+			"enterprise_9_field_1":       []byte{0xca, 0xfe},
+		},
+		Exporter: record.Map{
+			"version":       uint64(9),
+			"timestamp":     exportTime,
+			"uptime_millis": uint64(60000),
+			"address":       "10.0.0.1:4444",
+			"source_id":     uint64(0),
+		},
+	}
+
+	event := toEvent(r)
+	assert.Equal(t, exportTime, event.Timestamp)
+
+	source, _ := event.GetValue("source")
+	assert.Equal(t, "10.0.0.1:4444", source)
+
+	netflow, err := event.GetValue("netflow")
+	if assert.NoError(t, err) {
+		m := netflow.(common.MapStr)
+		assert.Equal(t, "netflow_flow", m["type"])
+		assert.Equal(t, "192.168.0.1", m["source_ipv4_address"])
+		assert.Equal(t, "00:01:02:03:04:05", m["source_mac_address"])
+		assert.Equal(t, "cafe", m["enterprise_9_field_1"])
+		assert.Equal(t, uint64(1500), m["octet_delta_count"])
+	}
+
+	flow, err := event.GetValue("flow")
+	if assert.NoError(t, err) {
+		m := flow.(common.MapStr)
+		assert.Equal(t, common.MapStr{
+			"ip":    "192.168.0.1",
+			"port":  uint64(51234),
+			"mac":   "00:01:02:03:04:05",
+			"stats": common.MapStr{"net_bytes_total": uint64(1500), "net_packets_total": uint64(10)},
+		}, m["source"])
+		assert.Equal(t, common.MapStr{"ip": "10.0.0.2", "port": uint64(443)}, m["dest"])
+		assert.Equal(t, "tcp", m["transport"])
+		assert.Equal(t, uint64(12), m["vlan"])
+		assert.Equal(t, false, m["final"])
+		assert.Equal(t, common.Time(exportTime.Add(-10*time.Second)), m["start_time"])
+		assert.Equal(t, common.Time(exportTime.Add(-1*time.Second)), m["last_time"])
+		assert.NotEmpty(t, m["id"])
+	}
+}
+
+func TestToEventFlowID(t *testing.T) {
+	flow := func(port uint64) interface{} {
+		event := toEvent(record.Record{
+			Type: record.Flow,
+			Fields: record.Map{
+				"source_ipv4_address":   net.IP{192, 168, 0, 1},
+				"source_transport_port": port,
+			},
+			Exporter: record.Map{"address": "10.0.0.1:4444"},
+		})
+		id, _ := event.GetValue("flow.id")
+		return id
+	}
+
+	assert.Equal(t, flow(80), flow(80))
+	assert.NotEqual(t, flow(80), flow(81))
+}
+
+func TestToEventOptions(t *testing.T) {
+	event := toEvent(record.Record{
+		Type:      record.Options,
+		Timestamp: exportTime,
+		Fields:    record.Map{"sampling_interval": uint64(100)},
+		Options:   record.Map{"scope_interface": uint64(3)},
+		Exporter:  record.Map{"address": "10.0.0.1:4444"},
+	})
+
+	typ, _ := event.GetValue("netflow.type")
+	assert.Equal(t, "netflow_options", typ)
+
+	scope, _ := event.GetValue("netflow.scope.scope_interface")
+	assert.Equal(t, uint64(3), scope)
+
+	_, err := event.GetValue("flow")
+	assert.Error(t, err)
+}
+
+func TestFlowTimes(t *testing.T) {
+	tests := map[string]struct {
+		fields        record.Map
+		start, finish time.Time
+	}{
+		"absolute": {
+			fields: record.Map{
+				"flow_start_milliseconds": exportTime.Add(-time.Minute),
+				"flow_end_seconds":        exportTime.Add(-time.Second),
+			},
+			start:  exportTime.Add(-time.Minute),
+			finish: exportTime.Add(-time.Second),
+		},
+		"delta": {
+			fields: record.Map{
+				"flow_start_delta_microseconds": uint64(2000000),
+				"flow_end_delta_microseconds":   uint64(500),
+			},
+			start:  exportTime.Add(-2 * time.Second),
+			finish: exportTime.Add(-500 * time.Microsecond),
+		},
+		"uptime wrap around": {
+			fields: record.Map{
+				"flow_start_sys_up_time": uint64(0xffffffff - 999),
+			},
+			start:  exportTime.Add(-2 * time.Second),
+			finish: exportTime,
+		},
+		"missing": {
+			fields: record.Map{},
+			start:  exportTime,
+			finish: exportTime,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			start, end := flowTimes(record.Record{
+				Timestamp: exportTime,
+				Fields:    test.fields,
+				Exporter:  record.Map{"uptime_millis": uint64(1000)},
+			})
+			assert.Equal(t, test.start, start)
+			assert.Equal(t, test.finish, end)
+		})
+	}
+}
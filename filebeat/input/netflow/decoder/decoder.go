@@ -0,0 +1,112 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package decoder decodes NetFlow v5, v9 and IPFIX packets into flow records.
+package decoder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/protocol"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/record"
+
+	// Register the supported protocols
+	_ "github.com/elastic/beats/filebeat/input/netflow/decoder/ipfix"
+	_ "github.com/elastic/beats/filebeat/input/netflow/decoder/v5"
+	_ "github.com/elastic/beats/filebeat/input/netflow/decoder/v9"
+)
+
+// Decoder dispatches packets to the decoder of their protocol version.
+type Decoder struct {
+	mutex   sync.Mutex
+	protos  map[uint16]protocol.Protocol
+	started bool
+}
+
+// NewDecoder returns a decoder for the given protocols.
+func NewDecoder(protocols []string, config protocol.Config) (*Decoder, error) {
+	decoder := &Decoder{
+		protos: make(map[uint16]protocol.Protocol, len(protocols)),
+	}
+	for _, name := range protocols {
+		factory, err := protocol.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		proto := factory(config)
+		if _, found := decoder.protos[proto.Version()]; found {
+			return nil, fmt.Errorf("protocol '%v' is configured more than once", name)
+		}
+		decoder.protos[proto.Version()] = proto
+	}
+	return decoder, nil
+}
+
+// Start starts the protocol decoders.
+func (d *Decoder) Start() error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.started {
+		return fmt.Errorf("decoder already started")
+	}
+	for _, proto := range d.protos {
+		if err := proto.Start(); err != nil {
+			d.stop()
+			return err
+		}
+	}
+	d.started = true
+	return nil
+}
+
+// Stop stops the protocol decoders.
+func (d *Decoder) Stop() error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if !d.started {
+		return fmt.Errorf("decoder not started")
+	}
+	d.stop()
+	d.started = false
+	return nil
+}
+
+func (d *Decoder) stop() {
+	for _, proto := range d.protos {
+		proto.Stop()
+	}
+}
+
+// Read decodes a packet received from the given exporter.
+func (d *Decoder) Read(buf *bytes.Buffer, source net.Addr) ([]record.Record, error) {
+	if buf.Len() < 2 {
+		return nil, fmt.Errorf("packet too short: %d bytes", buf.Len())
+	}
+	version := binary.BigEndian.Uint16(buf.Bytes()[:2])
+
+	proto, found := d.protos[version]
+	if !found {
+		return nil, fmt.Errorf("unsupported or disabled protocol version %d", version)
+	}
+	return proto.OnPacket(buf, source)
+}
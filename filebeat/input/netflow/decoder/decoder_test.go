@@ -0,0 +1,64 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package decoder
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/protocol"
+)
+
+func TestNewDecoder(t *testing.T) {
+	_, err := NewDecoder([]string{"v5", "v9", "ipfix"}, protocol.Config{})
+	assert.NoError(t, err)
+
+	_, err = NewDecoder([]string{"v7"}, protocol.Config{})
+	assert.Error(t, err, "unknown protocol")
+
+	_, err = NewDecoder([]string{"v9", "v9"}, protocol.Config{})
+	assert.Error(t, err, "duplicate protocol")
+}
+
+func TestRead(t *testing.T) {
+	decoder, err := NewDecoder([]string{"v9"}, protocol.Config{})
+	require.NoError(t, err)
+	require.NoError(t, decoder.Start())
+	defer decoder.Stop()
+
+	source := &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 2055}
+
+	// v9 header without flowsets
+	packet := []byte{0, 9, 0, 0, 0, 0, 0, 1, 0x5b, 0xc8, 0x5e, 0x80, 0, 0, 0, 1, 0, 0, 0, 0}
+	records, err := decoder.Read(bytes.NewBuffer(packet), source)
+	assert.NoError(t, err)
+	assert.Len(t, records, 0)
+
+	// v5 is not enabled
+	_, err = decoder.Read(bytes.NewBuffer([]byte{0, 5, 0, 1}), source)
+	assert.Error(t, err)
+
+	_, err = decoder.Read(bytes.NewBuffer([]byte{0}), source)
+	assert.Error(t, err)
+}
@@ -0,0 +1,73 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fields
+
+import (
+	"fmt"
+)
+
+// Key identifies an information element by its enterprise number and its id.
+// Standard (IANA) elements use an EnterpriseID of zero.
+type Key struct {
+	EnterpriseID uint32
+	FieldID      uint16
+}
+
+// Field describes an information element, its name and how it is decoded.
+type Field struct {
+	Name    string
+	Decoder Decoder
+}
+
+// FieldDict maps information element keys to their description.
+type FieldDict map[Key]*Field
+
+// GlobalFields contains all the information elements known to the decoders.
+var GlobalFields = FieldDict{}
+
+// RegisterFields adds the fields of the given dictionary to the global fields.
+// An error is returned if a field is already registered.
+func RegisterFields(dict FieldDict) error {
+	for key, field := range dict {
+		if _, found := GlobalFields[key]; found {
+			return fmt.Errorf("field %v is already registered", key)
+		}
+		GlobalFields[key] = field
+	}
+	return nil
+}
+
+// Lookup returns the field registered for the given key. Unknown fields are
+// passed through as their raw content, under a name derived from the key.
+func (d FieldDict) Lookup(key Key) *Field {
+	if field, found := d[key]; found {
+		return field
+	}
+	return &Field{
+		Name:    key.String(),
+		Decoder: OctetArray,
+	}
+}
+
+// String returns the name used for unknown fields.
+func (k Key) String() string {
+	if k.EnterpriseID == 0 {
+		return fmt.Sprintf("field_%d", k.FieldID)
+	}
+	return fmt.Sprintf("enterprise_%d_field_%d", k.EnterpriseID, k.FieldID)
+}
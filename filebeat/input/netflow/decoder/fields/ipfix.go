@@ -0,0 +1,440 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fields
+
+// ipfixFields are the information elements defined in the IANA IPFIX registry
+// (https://www.iana.org/assignments/ipfix/ipfix.xhtml). The elements of
+// NetFlow v9 share the same ids and are decoded with the same definitions.
+var ipfixFields = []struct {
+	id      uint16
+	name    string
+	decoder Decoder
+}{
+	{1, "octet_delta_count", Unsigned64},
+	{2, "packet_delta_count", Unsigned64},
+	{3, "delta_flow_count", Unsigned64},
+	{4, "protocol_identifier", Unsigned8},
+	{5, "ip_class_of_service", Unsigned8},
+	{6, "tcp_control_bits", Unsigned16},
+	{7, "source_transport_port", Unsigned16},
+	{8, "source_ipv4_address", Ipv4Address},
+	{9, "source_ipv4_prefix_length", Unsigned8},
+	{10, "ingress_interface", Unsigned32},
+	{11, "destination_transport_port", Unsigned16},
+	{12, "destination_ipv4_address", Ipv4Address},
+	{13, "destination_ipv4_prefix_length", Unsigned8},
+	{14, "egress_interface", Unsigned32},
+	{15, "ip_next_hop_ipv4_address", Ipv4Address},
+	{16, "bgp_source_as_number", Unsigned32},
+	{17, "bgp_destination_as_number", Unsigned32},
+	{18, "bgp_next_hop_ipv4_address", Ipv4Address},
+	{19, "post_mcast_packet_delta_count", Unsigned64},
+	{20, "post_mcast_octet_delta_count", Unsigned64},
+	{21, "flow_end_sys_up_time", Unsigned32},
+	{22, "flow_start_sys_up_time", Unsigned32},
+	{23, "post_octet_delta_count", Unsigned64},
+	{24, "post_packet_delta_count", Unsigned64},
+	{25, "minimum_ip_total_length", Unsigned64},
+	{26, "maximum_ip_total_length", Unsigned64},
+	{27, "source_ipv6_address", Ipv6Address},
+	{28, "destination_ipv6_address", Ipv6Address},
+	{29, "source_ipv6_prefix_length", Unsigned8},
+	{30, "destination_ipv6_prefix_length", Unsigned8},
+	{31, "flow_label_ipv6", Unsigned32},
+	{32, "icmp_type_code_ipv4", Unsigned16},
+	{33, "igmp_type", Unsigned8},
+	{34, "sampling_interval", Unsigned32},
+	{35, "sampling_algorithm", Unsigned8},
+	{36, "flow_active_timeout", Unsigned16},
+	{37, "flow_idle_timeout", Unsigned16},
+	{38, "engine_type", Unsigned8},
+	{39, "engine_id", Unsigned8},
+	{40, "exported_octet_total_count", Unsigned64},
+	{41, "exported_message_total_count", Unsigned64},
+	{42, "exported_flow_record_total_count", Unsigned64},
+	{43, "ipv4_router_sc", Ipv4Address},
+	{44, "source_ipv4_prefix", Ipv4Address},
+	{45, "destination_ipv4_prefix", Ipv4Address},
+	{46, "mpls_top_label_type", Unsigned8},
+	{47, "mpls_top_label_ipv4_address", Ipv4Address},
+	{48, "sampler_id", Unsigned8},
+	{49, "sampler_mode", Unsigned8},
+	{50, "sampler_random_interval", Unsigned32},
+	{51, "class_id", Unsigned8},
+	{52, "minimum_ttl", Unsigned8},
+	{53, "maximum_ttl", Unsigned8},
+	{54, "fragment_identification", Unsigned32},
+	{55, "post_ip_class_of_service", Unsigned8},
+	{56, "source_mac_address", MacAddress},
+	{57, "post_destination_mac_address", MacAddress},
+	{58, "vlan_id", Unsigned16},
+	{59, "post_vlan_id", Unsigned16},
+	{60, "ip_version", Unsigned8},
+	{61, "flow_direction", Unsigned8},
+	{62, "ip_next_hop_ipv6_address", Ipv6Address},
+	{63, "bgp_next_hop_ipv6_address", Ipv6Address},
+	{64, "ipv6_extension_headers", Unsigned32},
+	{70, "mpls_top_label_stack_section", OctetArray},
+	{71, "mpls_label_stack_section2", OctetArray},
+	{72, "mpls_label_stack_section3", OctetArray},
+	{73, "mpls_label_stack_section4", OctetArray},
+	{74, "mpls_label_stack_section5", OctetArray},
+	{75, "mpls_label_stack_section6", OctetArray},
+	{76, "mpls_label_stack_section7", OctetArray},
+	{77, "mpls_label_stack_section8", OctetArray},
+	{78, "mpls_label_stack_section9", OctetArray},
+	{79, "mpls_label_stack_section10", OctetArray},
+	{80, "destination_mac_address", MacAddress},
+	{81, "post_source_mac_address", MacAddress},
+	{82, "interface_name", String},
+	{83, "interface_description", String},
+	{84, "sampler_name", String},
+	{85, "octet_total_count", Unsigned64},
+	{86, "packet_total_count", Unsigned64},
+	{87, "flags_and_sampler_id", Unsigned32},
+	{88, "fragment_offset", Unsigned16},
+	{89, "forwarding_status", Unsigned32},
+	{90, "mpls_vpn_route_distinguisher", OctetArray},
+	{91, "mpls_top_label_prefix_length", Unsigned8},
+	{92, "src_traffic_index", Unsigned32},
+	{93, "dst_traffic_index", Unsigned32},
+	{94, "application_description", String},
+	{95, "application_id", OctetArray},
+	{96, "application_name", String},
+	{98, "post_ip_diff_serv_code_point", Unsigned8},
+	{99, "multicast_replication_factor", Unsigned32},
+	{100, "class_name", String},
+	{101, "classification_engine_id", Unsigned8},
+	{102, "layer2packet_section_offset", Unsigned16},
+	{103, "layer2packet_section_size", Unsigned16},
+	{104, "layer2packet_section_data", OctetArray},
+	{128, "bgp_next_adjacent_as_number", Unsigned32},
+	{129, "bgp_prev_adjacent_as_number", Unsigned32},
+	{130, "exporter_ipv4_address", Ipv4Address},
+	{131, "exporter_ipv6_address", Ipv6Address},
+	{132, "dropped_octet_delta_count", Unsigned64},
+	{133, "dropped_packet_delta_count", Unsigned64},
+	{134, "dropped_octet_total_count", Unsigned64},
+	{135, "dropped_packet_total_count", Unsigned64},
+	{136, "flow_end_reason", Unsigned8},
+	{137, "common_properties_id", Unsigned64},
+	{138, "observation_point_id", Unsigned64},
+	{139, "icmp_type_code_ipv6", Unsigned16},
+	{140, "mpls_top_label_ipv6_address", Ipv6Address},
+	{141, "line_card_id", Unsigned32},
+	{142, "port_id", Unsigned32},
+	{143, "metering_process_id", Unsigned32},
+	{144, "exporting_process_id", Unsigned32},
+	{145, "template_id", Unsigned16},
+	{146, "wlan_channel_id", Unsigned8},
+	{147, "wlan_ssid", String},
+	{148, "flow_id", Unsigned64},
+	{149, "observation_domain_id", Unsigned32},
+	{150, "flow_start_seconds", DateTimeSeconds},
+	{151, "flow_end_seconds", DateTimeSeconds},
+	{152, "flow_start_milliseconds", DateTimeMilliseconds},
+	{153, "flow_end_milliseconds", DateTimeMilliseconds},
+	{154, "flow_start_microseconds", DateTimeMicroseconds},
+	{155, "flow_end_microseconds", DateTimeMicroseconds},
+	{156, "flow_start_nanoseconds", DateTimeNanoseconds},
+	{157, "flow_end_nanoseconds", DateTimeNanoseconds},
+	{158, "flow_start_delta_microseconds", Unsigned32},
+	{159, "flow_end_delta_microseconds", Unsigned32},
+	{160, "system_init_time_milliseconds", DateTimeMilliseconds},
+	{161, "flow_duration_milliseconds", Unsigned32},
+	{162, "flow_duration_microseconds", Unsigned32},
+	{163, "observed_flow_total_count", Unsigned64},
+	{164, "ignored_packet_total_count", Unsigned64},
+	{165, "ignored_octet_total_count", Unsigned64},
+	{166, "not_sent_flow_total_count", Unsigned64},
+	{167, "not_sent_packet_total_count", Unsigned64},
+	{168, "not_sent_octet_total_count", Unsigned64},
+	{169, "destination_ipv6_prefix", Ipv6Address},
+	{170, "source_ipv6_prefix", Ipv6Address},
+	{171, "post_octet_total_count", Unsigned64},
+	{172, "post_packet_total_count", Unsigned64},
+	{173, "flow_key_indicator", Unsigned64},
+	{174, "post_mcast_packet_total_count", Unsigned64},
+	{175, "post_mcast_octet_total_count", Unsigned64},
+	{176, "icmp_type_ipv4", Unsigned8},
+	{177, "icmp_code_ipv4", Unsigned8},
+	{178, "icmp_type_ipv6", Unsigned8},
+	{179, "icmp_code_ipv6", Unsigned8},
+	{180, "udp_source_port", Unsigned16},
+	{181, "udp_destination_port", Unsigned16},
+	{182, "tcp_source_port", Unsigned16},
+	{183, "tcp_destination_port", Unsigned16},
+	{184, "tcp_sequence_number", Unsigned32},
+	{185, "tcp_acknowledgement_number", Unsigned32},
+	{186, "tcp_window_size", Unsigned16},
+	{187, "tcp_urgent_pointer", Unsigned16},
+	{188, "tcp_header_length", Unsigned8},
+	{189, "ip_header_length", Unsigned8},
+	{190, "total_length_ipv4", Unsigned16},
+	{191, "payload_length_ipv6", Unsigned16},
+	{192, "ip_ttl", Unsigned8},
+	{193, "next_header_ipv6", Unsigned8},
+	{194, "mpls_payload_length", Unsigned32},
+	{195, "ip_diff_serv_code_point", Unsigned8},
+	{196, "ip_precedence", Unsigned8},
+	{197, "fragment_flags", Unsigned8},
+	{198, "octet_delta_sum_of_squares", Unsigned64},
+	{199, "octet_total_sum_of_squares", Unsigned64},
+	{200, "mpls_top_label_ttl", Unsigned8},
+	{201, "mpls_label_stack_length", Unsigned32},
+	{202, "mpls_label_stack_depth", Unsigned32},
+	{203, "mpls_top_label_exp", Unsigned8},
+	{204, "ip_payload_length", Unsigned32},
+	{205, "udp_message_length", Unsigned16},
+	{206, "is_multicast", Unsigned8},
+	{207, "ipv4_ihl", Unsigned8},
+	{208, "ipv4_options", Unsigned32},
+	{209, "tcp_options", Unsigned64},
+	{210, "padding_octets", OctetArray},
+	{211, "collector_ipv4_address", Ipv4Address},
+	{212, "collector_ipv6_address", Ipv6Address},
+	{213, "export_interface", Unsigned32},
+	{214, "export_protocol_version", Unsigned8},
+	{215, "export_transport_protocol", Unsigned8},
+	{216, "collector_transport_port", Unsigned16},
+	{217, "exporter_transport_port", Unsigned16},
+	{218, "tcp_syn_total_count", Unsigned64},
+	{219, "tcp_fin_total_count", Unsigned64},
+	{220, "tcp_rst_total_count", Unsigned64},
+	{221, "tcp_psh_total_count", Unsigned64},
+	{222, "tcp_ack_total_count", Unsigned64},
+	{223, "tcp_urg_total_count", Unsigned64},
+	{224, "ip_total_length", Unsigned64},
+	{225, "post_nat_source_ipv4_address", Ipv4Address},
+	{226, "post_nat_destination_ipv4_address", Ipv4Address},
+	{227, "post_napt_source_transport_port", Unsigned16},
+	{228, "post_napt_destination_transport_port", Unsigned16},
+	{229, "nat_originating_address_realm", Unsigned8},
+	{230, "nat_event", Unsigned8},
+	{231, "initiator_octets", Unsigned64},
+	{232, "responder_octets", Unsigned64},
+	{233, "firewall_event", Unsigned8},
+	{234, "ingress_vrfid", Unsigned32},
+	{235, "egress_vrfid", Unsigned32},
+	{236, "vrf_name", String},
+	{237, "post_mpls_top_label_exp", Unsigned8},
+	{238, "tcp_window_scale", Unsigned16},
+	{239, "biflow_direction", Unsigned8},
+	{240, "ethernet_header_length", Unsigned8},
+	{241, "ethernet_payload_length", Unsigned16},
+	{242, "ethernet_total_length", Unsigned16},
+	{243, "dot1q_vlan_id", Unsigned16},
+	{244, "dot1q_priority", Unsigned8},
+	{245, "dot1q_customer_vlan_id", Unsigned16},
+	{246, "dot1q_customer_priority", Unsigned8},
+	{247, "metro_evc_id", String},
+	{248, "metro_evc_type", Unsigned8},
+	{249, "pseudo_wire_id", Unsigned32},
+	{250, "pseudo_wire_type", Unsigned16},
+	{251, "pseudo_wire_control_word", Unsigned32},
+	{252, "ingress_physical_interface", Unsigned32},
+	{253, "egress_physical_interface", Unsigned32},
+	{254, "post_dot1q_vlan_id", Unsigned16},
+	{255, "post_dot1q_customer_vlan_id", Unsigned16},
+	{256, "ethernet_type", Unsigned16},
+	{257, "post_ip_precedence", Unsigned8},
+	{258, "collection_time_milliseconds", DateTimeMilliseconds},
+	{259, "export_sctp_stream_id", Unsigned16},
+	{260, "max_export_seconds", DateTimeSeconds},
+	{261, "max_flow_end_seconds", DateTimeSeconds},
+	{262, "message_md5_checksum", OctetArray},
+	{263, "message_scope", Unsigned8},
+	{264, "min_export_seconds", DateTimeSeconds},
+	{265, "min_flow_start_seconds", DateTimeSeconds},
+	{266, "opaque_octets", OctetArray},
+	{267, "session_scope", Unsigned8},
+	{268, "max_flow_end_microseconds", DateTimeMicroseconds},
+	{269, "max_flow_end_milliseconds", DateTimeMilliseconds},
+	{270, "max_flow_end_nanoseconds", DateTimeNanoseconds},
+	{271, "min_flow_start_microseconds", DateTimeMicroseconds},
+	{272, "min_flow_start_milliseconds", DateTimeMilliseconds},
+	{273, "min_flow_start_nanoseconds", DateTimeNanoseconds},
+	{274, "collector_certificate", OctetArray},
+	{275, "exporter_certificate", OctetArray},
+	{276, "data_records_reliability", Boolean},
+	{277, "observation_point_type", Unsigned8},
+	{278, "new_connection_delta_count", Unsigned32},
+	{279, "connection_sum_duration_seconds", Unsigned64},
+	{280, "connection_transaction_id", Unsigned64},
+	{281, "post_nat_source_ipv6_address", Ipv6Address},
+	{282, "post_nat_destination_ipv6_address", Ipv6Address},
+	{283, "nat_pool_id", Unsigned32},
+	{284, "nat_pool_name", String},
+	{285, "anonymization_flags", Unsigned16},
+	{286, "anonymization_technique", Unsigned16},
+	{287, "information_element_index", Unsigned16},
+	{288, "p2p_technology", String},
+	{289, "tunnel_technology", String},
+	{290, "encrypted_technology", String},
+	{291, "basic_list", OctetArray},
+	{292, "sub_template_list", OctetArray},
+	{293, "sub_template_multi_list", OctetArray},
+	{294, "bgp_validity_state", Unsigned8},
+	{295, "ip_sec_spi", Unsigned32},
+	{296, "gre_key", Unsigned32},
+	{297, "nat_type", Unsigned8},
+	{298, "initiator_packets", Unsigned64},
+	{299, "responder_packets", Unsigned64},
+	{300, "observation_domain_name", String},
+	{301, "selection_sequence_id", Unsigned64},
+	{302, "selector_id", Unsigned64},
+	{303, "information_element_id", Unsigned16},
+	{304, "selector_algorithm", Unsigned16},
+	{305, "sampling_packet_interval", Unsigned32},
+	{306, "sampling_packet_space", Unsigned32},
+	{307, "sampling_time_interval", Unsigned32},
+	{308, "sampling_time_space", Unsigned32},
+	{309, "sampling_size", Unsigned32},
+	{310, "sampling_population", Unsigned32},
+	{311, "sampling_probability", Float64},
+	{312, "data_link_frame_size", Unsigned16},
+	{313, "ip_header_packet_section", OctetArray},
+	{314, "ip_payload_packet_section", OctetArray},
+	{315, "data_link_frame_section", OctetArray},
+	{316, "mpls_label_stack_section", OctetArray},
+	{317, "mpls_payload_packet_section", OctetArray},
+	{318, "selector_id_total_pkts_observed", Unsigned64},
+	{319, "selector_id_total_pkts_selected", Unsigned64},
+	{320, "absolute_error", Float64},
+	{321, "relative_error", Float64},
+	{322, "observation_time_seconds", DateTimeSeconds},
+	{323, "observation_time_milliseconds", DateTimeMilliseconds},
+	{324, "observation_time_microseconds", DateTimeMicroseconds},
+	{325, "observation_time_nanoseconds", DateTimeNanoseconds},
+	{326, "digest_hash_value", Unsigned64},
+	{327, "hash_ip_payload_offset", Unsigned64},
+	{328, "hash_ip_payload_size", Unsigned64},
+	{329, "hash_output_range_min", Unsigned64},
+	{330, "hash_output_range_max", Unsigned64},
+	{331, "hash_selected_range_min", Unsigned64},
+	{332, "hash_selected_range_max", Unsigned64},
+	{333, "hash_digest_output", Boolean},
+	{334, "hash_initialiser_value", Unsigned64},
+	{335, "selector_name", String},
+	{336, "upper_ci_limit", Float64},
+	{337, "lower_ci_limit", Float64},
+	{338, "confidence_level", Float64},
+	{339, "information_element_data_type", Unsigned8},
+	{340, "information_element_description", String},
+	{341, "information_element_name", String},
+	{342, "information_element_range_begin", Unsigned64},
+	{343, "information_element_range_end", Unsigned64},
+	{344, "information_element_semantics", Unsigned8},
+	{345, "information_element_units", Unsigned16},
+	{346, "private_enterprise_number", Unsigned32},
+	{347, "virtual_station_interface_id", OctetArray},
+	{348, "virtual_station_interface_name", String},
+	{349, "virtual_station_uuid", OctetArray},
+	{350, "virtual_station_name", String},
+	{351, "layer2_segment_id", Unsigned64},
+	{352, "layer2_octet_delta_count", Unsigned64},
+	{353, "layer2_octet_total_count", Unsigned64},
+	{354, "ingress_unicast_packet_total_count", Unsigned64},
+	{355, "ingress_multicast_packet_total_count", Unsigned64},
+	{356, "ingress_broadcast_packet_total_count", Unsigned64},
+	{357, "egress_unicast_packet_total_count", Unsigned64},
+	{358, "egress_broadcast_packet_total_count", Unsigned64},
+	{359, "monitoring_interval_start_milli_seconds", DateTimeMilliseconds},
+	{360, "monitoring_interval_end_milli_seconds", DateTimeMilliseconds},
+	{361, "port_range_start", Unsigned16},
+	{362, "port_range_end", Unsigned16},
+	{363, "port_range_step_size", Unsigned16},
+	{364, "port_range_num_ports", Unsigned16},
+	{365, "sta_mac_address", MacAddress},
+	{366, "sta_ipv4_address", Ipv4Address},
+	{367, "wtp_mac_address", MacAddress},
+	{368, "ingress_interface_type", Unsigned32},
+	{369, "egress_interface_type", Unsigned32},
+	{370, "rtp_sequence_number", Unsigned16},
+	{371, "user_name", String},
+	{372, "application_category_name", String},
+	{373, "application_sub_category_name", String},
+	{374, "application_group_name", String},
+	{375, "original_flows_present", Unsigned64},
+	{376, "original_flows_initiated", Unsigned64},
+	{377, "original_flows_completed", Unsigned64},
+	{378, "distinct_count_of_source_ip_address", Unsigned64},
+	{379, "distinct_count_of_destination_ip_address", Unsigned64},
+	{380, "distinct_count_of_source_ipv4_address", Unsigned32},
+	{381, "distinct_count_of_destination_ipv4_address", Unsigned32},
+	{382, "distinct_count_of_source_ipv6_address", Unsigned64},
+	{383, "distinct_count_of_destination_ipv6_address", Unsigned64},
+	{384, "value_distribution_method", Unsigned8},
+	{385, "rfc3550_jitter_milliseconds", Unsigned32},
+	{386, "rfc3550_jitter_microseconds", Unsigned32},
+	{387, "rfc3550_jitter_nanoseconds", Unsigned32},
+	{388, "dot1q_dei", Boolean},
+	{389, "dot1q_customer_dei", Boolean},
+	{390, "flow_selector_algorithm", Unsigned16},
+	{391, "flow_selected_octet_delta_count", Unsigned64},
+	{392, "flow_selected_packet_delta_count", Unsigned64},
+	{393, "flow_selected_flow_delta_count", Unsigned64},
+	{394, "selector_id_total_flows_observed", Unsigned64},
+	{395, "selector_id_total_flows_selected", Unsigned64},
+	{396, "sampling_flow_interval", Unsigned64},
+	{397, "sampling_flow_spacing", Unsigned64},
+	{398, "flow_sampling_time_interval", Unsigned64},
+	{399, "flow_sampling_time_spacing", Unsigned64},
+	{400, "hash_flow_domain", Unsigned16},
+	{401, "transport_octet_delta_count", Unsigned64},
+	{402, "transport_packet_delta_count", Unsigned64},
+	{403, "original_exporter_ipv4_address", Ipv4Address},
+	{404, "original_exporter_ipv6_address", Ipv6Address},
+	{405, "original_observation_domain_id", Unsigned32},
+	{406, "intermediate_process_id", Unsigned32},
+	{407, "ignored_data_record_total_count", Unsigned64},
+	{408, "data_link_frame_type", Unsigned16},
+	{409, "section_offset", Unsigned16},
+	{410, "section_exported_octets", Unsigned16},
+	{411, "dot1q_service_instance_tag", OctetArray},
+	{412, "dot1q_service_instance_id", Unsigned32},
+	{413, "dot1q_service_instance_priority", Unsigned8},
+	{414, "dot1q_customer_source_mac_address", MacAddress},
+	{415, "dot1q_customer_destination_mac_address", MacAddress},
+	{417, "post_layer2_octet_delta_count", Unsigned64},
+	{418, "post_mcast_layer2_octet_delta_count", Unsigned64},
+	{420, "post_layer2_octet_total_count", Unsigned64},
+	{421, "post_mcast_layer2_octet_total_count", Unsigned64},
+	{422, "minimum_layer2_total_length", Unsigned64},
+	{423, "maximum_layer2_total_length", Unsigned64},
+	{424, "dropped_layer2_octet_delta_count", Unsigned64},
+	{425, "dropped_layer2_octet_total_count", Unsigned64},
+	{426, "ignored_layer2_octet_total_count", Unsigned64},
+	{427, "not_sent_layer2_octet_total_count", Unsigned64},
+	{428, "layer2_octet_delta_sum_of_squares", Unsigned64},
+	{429, "layer2_octet_total_sum_of_squares", Unsigned64},
+	{430, "layer2_frame_delta_count", Unsigned64},
+	{431, "layer2_frame_total_count", Unsigned64},
+	{432, "pseudo_wire_destination_ipv4_address", Ipv4Address},
+	{433, "ignored_layer2_frame_total_count", Unsigned64},
+}
+
+func init() {
+	dict := make(FieldDict, len(ipfixFields))
+	for _, f := range ipfixFields {
+		dict[Key{FieldID: f.id}] = &Field{Name: f.name, Decoder: f.decoder}
+	}
+	if err := RegisterFields(dict); err != nil {
+		panic(err)
+	}
+}
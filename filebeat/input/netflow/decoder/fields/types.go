@@ -0,0 +1,247 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fields
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"time"
+)
+
+// Decoder decodes the content of an information element.
+type Decoder interface {
+	// MinLength is the minimum length of the encoded value.
+	MinLength() uint16
+	// MaxLength is the maximum length of the encoded value.
+	MaxLength() uint16
+	// Decode returns the value of an encoded element.
+	Decode(data []byte) (interface{}, error)
+}
+
+// Abstract data types defined in RFC 7011
+var (
+	Unsigned8            Decoder = unsignedDecoder(1)
+	Unsigned16           Decoder = unsignedDecoder(2)
+	Unsigned32           Decoder = unsignedDecoder(4)
+	Unsigned64           Decoder = unsignedDecoder(8)
+	Signed8              Decoder = signedDecoder(1)
+	Signed16             Decoder = signedDecoder(2)
+	Signed32             Decoder = signedDecoder(4)
+	Signed64             Decoder = signedDecoder(8)
+	Float32              Decoder = floatDecoder(4)
+	Float64              Decoder = floatDecoder(8)
+	Boolean              Decoder = booleanDecoder{}
+	MacAddress           Decoder = macAddressDecoder{}
+	OctetArray           Decoder = octetArrayDecoder{}
+	String               Decoder = stringDecoder{}
+	DateTimeSeconds      Decoder = dateTimeSecondsDecoder{}
+	DateTimeMilliseconds Decoder = dateTimeMillisecondsDecoder{}
+	DateTimeMicroseconds Decoder = ntpDecoder{precision: time.Microsecond}
+	DateTimeNanoseconds  Decoder = ntpDecoder{precision: time.Nanosecond}
+	Ipv4Address          Decoder = ipAddressDecoder(net.IPv4len)
+	Ipv6Address          Decoder = ipAddressDecoder(net.IPv6len)
+)
+
+// ErrOutOfBounds is returned when the length of an encoded value is not
+// supported by the decoder of the element.
+var ErrOutOfBounds = errors.New("encoded value has an invalid length")
+
+// ntpEpoch is the difference in seconds between the NTP (1900) and the Unix epoch.
+const ntpEpoch = 2208988800
+
+func checkLength(d Decoder, data []byte) error {
+	if n := len(data); n < int(d.MinLength()) || n > int(d.MaxLength()) {
+		return ErrOutOfBounds
+	}
+	return nil
+}
+
+// unsignedDecoder decodes unsigned integers. Reduced-size encoding is supported.
+type unsignedDecoder uint16
+
+func (u unsignedDecoder) MinLength() uint16 { return 1 }
+func (u unsignedDecoder) MaxLength() uint16 { return uint16(u) }
+
+func (u unsignedDecoder) Decode(data []byte) (interface{}, error) {
+	if err := checkLength(u, data); err != nil {
+		return nil, err
+	}
+	var value uint64
+	for _, b := range data {
+		value = value<<8 | uint64(b)
+	}
+	return value, nil
+}
+
+// signedDecoder decodes signed integers. Reduced-size encoding is supported.
+type signedDecoder uint16
+
+func (s signedDecoder) MinLength() uint16 { return 1 }
+func (s signedDecoder) MaxLength() uint16 { return uint16(s) }
+
+func (s signedDecoder) Decode(data []byte) (interface{}, error) {
+	if err := checkLength(s, data); err != nil {
+		return nil, err
+	}
+	value := int64(int8(data[0]))
+	for _, b := range data[1:] {
+		value = value<<8 | int64(b)
+	}
+	return value, nil
+}
+
+// floatDecoder decodes floats. A float64 can be encoded as float32.
+type floatDecoder uint16
+
+func (f floatDecoder) MinLength() uint16 { return 4 }
+func (f floatDecoder) MaxLength() uint16 { return uint16(f) }
+
+func (f floatDecoder) Decode(data []byte) (interface{}, error) {
+	switch len(data) {
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(data))), nil
+	case 8:
+		if f == 8 {
+			return math.Float64frombits(binary.BigEndian.Uint64(data)), nil
+		}
+	}
+	return nil, ErrOutOfBounds
+}
+
+type booleanDecoder struct{}
+
+func (booleanDecoder) MinLength() uint16 { return 1 }
+func (booleanDecoder) MaxLength() uint16 { return 1 }
+
+func (b booleanDecoder) Decode(data []byte) (interface{}, error) {
+	if err := checkLength(b, data); err != nil {
+		return nil, err
+	}
+	switch data[0] {
+	case 1:
+		return true, nil
+	case 2:
+		return false, nil
+	}
+	return nil, fmt.Errorf("invalid boolean value %d", data[0])
+}
+
+type macAddressDecoder struct{}
+
+func (macAddressDecoder) MinLength() uint16 { return 6 }
+func (macAddressDecoder) MaxLength() uint16 { return 6 }
+
+func (m macAddressDecoder) Decode(data []byte) (interface{}, error) {
+	if err := checkLength(m, data); err != nil {
+		return nil, err
+	}
+	return net.HardwareAddr(copyBytes(data)), nil
+}
+
+type octetArrayDecoder struct{}
+
+func (octetArrayDecoder) MinLength() uint16 { return 0 }
+func (octetArrayDecoder) MaxLength() uint16 { return math.MaxUint16 }
+
+func (octetArrayDecoder) Decode(data []byte) (interface{}, error) {
+	return copyBytes(data), nil
+}
+
+type stringDecoder struct{}
+
+func (stringDecoder) MinLength() uint16 { return 0 }
+func (stringDecoder) MaxLength() uint16 { return math.MaxUint16 }
+
+// Decode returns the string without the padding added by some exporters.
+func (stringDecoder) Decode(data []byte) (interface{}, error) {
+	end := len(data)
+	for end > 0 && data[end-1] == 0 {
+		end--
+	}
+	return string(data[:end]), nil
+}
+
+type dateTimeSecondsDecoder struct{}
+
+func (dateTimeSecondsDecoder) MinLength() uint16 { return 4 }
+func (dateTimeSecondsDecoder) MaxLength() uint16 { return 4 }
+
+func (d dateTimeSecondsDecoder) Decode(data []byte) (interface{}, error) {
+	if err := checkLength(d, data); err != nil {
+		return nil, err
+	}
+	return time.Unix(int64(binary.BigEndian.Uint32(data)), 0).UTC(), nil
+}
+
+type dateTimeMillisecondsDecoder struct{}
+
+func (dateTimeMillisecondsDecoder) MinLength() uint16 { return 8 }
+func (dateTimeMillisecondsDecoder) MaxLength() uint16 { return 8 }
+
+func (d dateTimeMillisecondsDecoder) Decode(data []byte) (interface{}, error) {
+	if err := checkLength(d, data); err != nil {
+		return nil, err
+	}
+	millis := int64(binary.BigEndian.Uint64(data))
+	return time.Unix(millis/1000, (millis%1000)*int64(time.Millisecond)).UTC(), nil
+}
+
+// ntpDecoder decodes timestamps encoded in the NTP format, seconds since
+// 1900 followed by the fraction of the second.
+type ntpDecoder struct {
+	precision time.Duration
+}
+
+func (ntpDecoder) MinLength() uint16 { return 8 }
+func (ntpDecoder) MaxLength() uint16 { return 8 }
+
+func (d ntpDecoder) Decode(data []byte) (interface{}, error) {
+	if err := checkLength(d, data); err != nil {
+		return nil, err
+	}
+	seconds := int64(binary.BigEndian.Uint32(data[:4])) - ntpEpoch
+	fraction := uint64(binary.BigEndian.Uint32(data[4:]))
+	if d.precision == time.Microsecond {
+		// The last 11 bits of the fraction are not used for microseconds
+		fraction &^= 0x7ff
+	}
+	nanos := time.Duration((fraction * uint64(time.Second)) >> 32).Truncate(d.precision)
+	return time.Unix(seconds, int64(nanos)).UTC(), nil
+}
+
+type ipAddressDecoder uint16
+
+func (i ipAddressDecoder) MinLength() uint16 { return uint16(i) }
+func (i ipAddressDecoder) MaxLength() uint16 { return uint16(i) }
+
+func (i ipAddressDecoder) Decode(data []byte) (interface{}, error) {
+	if err := checkLength(i, data); err != nil {
+		return nil, err
+	}
+	return net.IP(copyBytes(data)), nil
+}
+
+// copyBytes copies the data, as the decoded packet buffers are reused.
+func copyBytes(data []byte) []byte {
+	result := make([]byte, len(data))
+	copy(result, data)
+	return result
+}
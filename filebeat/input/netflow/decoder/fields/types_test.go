@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package fields
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecoders(t *testing.T) {
+	tests := []struct {
+		name     string
+		decoder  Decoder
+		data     []byte
+		expected interface{}
+	}{
+		{"unsigned8", Unsigned8, []byte{0xff}, uint64(255)},
+		{"unsigned32", Unsigned32, []byte{0, 1, 0, 1}, uint64(65537)},
+		{"unsigned64 reduced size", Unsigned64, []byte{1, 0}, uint64(256)},
+		{"signed16", Signed16, []byte{0xff, 0xfe}, int64(-2)},
+		{"signed32 reduced size", Signed32, []byte{0x80}, int64(-128)},
+		{"float32", Float32, []byte{0x3f, 0xc0, 0, 0}, float64(1.5)},
+		{"float64 as float32", Float64, []byte{0x40, 0x20, 0, 0}, float64(2.5)},
+		{"float64", Float64, []byte{0x40, 0x04, 0, 0, 0, 0, 0, 0}, float64(2.5)},
+		{"boolean true", Boolean, []byte{1}, true},
+		{"boolean false", Boolean, []byte{2}, false},
+		{"mac", MacAddress, []byte{0, 0x1b, 0x21, 0x3c, 0x4d, 0x5e}, net.HardwareAddr{0, 0x1b, 0x21, 0x3c, 0x4d, 0x5e}},
+		{"octets", OctetArray, []byte{1, 2, 3}, []byte{1, 2, 3}},
+		{"string", String, []byte("eth0\x00\x00"), "eth0"},
+		{"ipv4", Ipv4Address, []byte{192, 168, 1, 1}, net.IP{192, 168, 1, 1}},
+		{"ipv6", Ipv6Address, net.ParseIP("2001:db8::1"), net.ParseIP("2001:db8::1")},
+		{"seconds", DateTimeSeconds, []byte{0x5b, 0xf0, 0xc5, 0x00}, time.Unix(0x5bf0c500, 0).UTC()},
+		{"milliseconds", DateTimeMilliseconds, []byte{0, 0, 0x01, 0x67, 0x41, 0x5d, 0xf9, 0x69}, time.Unix(1542989937, 1000000).UTC()},
+		{"microseconds", DateTimeMicroseconds, []byte{0x83, 0xaa, 0x7e, 0x81, 0x80, 0, 0, 0}, time.Unix(1, 500000000).UTC()},
+		{"nanoseconds", DateTimeNanoseconds, []byte{0x83, 0xaa, 0x7e, 0x80, 0x40, 0, 0, 0}, time.Unix(0, 250000000).UTC()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := test.decoder.Decode(test.data)
+			if assert.NoError(t, err) {
+				assert.Equal(t, test.expected, value)
+			}
+		})
+	}
+}
+
+func TestDecodersInvalidLength(t *testing.T) {
+	tests := map[string]struct {
+		decoder Decoder
+		data    []byte
+	}{
+		"unsigned16 too long": {Unsigned16, []byte{1, 2, 3}},
+		"unsigned8 empty":     {Unsigned8, nil},
+		"ipv4 too short":      {Ipv4Address, []byte{10, 0, 0}},
+		"mac too long":        {MacAddress, make([]byte, 8)},
+		"float32 as float64":  {Float32, make([]byte, 8)},
+		"invalid boolean":     {Boolean, []byte{3}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := test.decoder.Decode(test.data)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	field := GlobalFields.Lookup(Key{FieldID: 8})
+	assert.Equal(t, "source_ipv4_address", field.Name)
+
+	unknown := GlobalFields.Lookup(Key{FieldID: 65000})
+	assert.Equal(t, "field_65000", unknown.Name)
+	assert.Equal(t, OctetArray, unknown.Decoder)
+
+	enterprise := GlobalFields.Lookup(Key{EnterpriseID: 9, FieldID: 12235})
+	assert.Equal(t, "enterprise_9_field_12235", enterprise.Name)
+}
+
+func TestRegisterFieldsDuplicate(t *testing.T) {
+	err := RegisterFields(FieldDict{Key{FieldID: 1}: {Name: "duplicate", Decoder: Unsigned8}})
+	assert.Error(t, err)
+}
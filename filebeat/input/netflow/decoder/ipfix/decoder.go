@@ -0,0 +1,175 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ipfix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/fields"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/record"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/template"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/v9"
+)
+
+const (
+	// TemplateSetID is the id of template sets.
+	TemplateSetID = 2
+	// OptionsTemplateSetID is the id of options template sets.
+	OptionsTemplateSetID = 3
+
+	headerLength = 16
+
+	// enterpriseBit is set in the element id of enterprise specific elements.
+	enterpriseBit = 0x8000
+)
+
+// DecoderIPFIX decodes IPFIX packets.
+type DecoderIPFIX struct {
+	Fields fields.FieldDict
+}
+
+var _ v9.Decoder = DecoderIPFIX{}
+
+// ReadPacketHeader reads an IPFIX message header.
+func (DecoderIPFIX) ReadPacketHeader(buf *bytes.Buffer) (v9.PacketHeader, *bytes.Buffer, error) {
+	data := buf.Next(headerLength)
+	if len(data) < headerLength {
+		return v9.PacketHeader{}, nil, io.EOF
+	}
+	header := v9.PacketHeader{
+		Version:    binary.BigEndian.Uint16(data[:2]),
+		ExportTime: time.Unix(int64(binary.BigEndian.Uint32(data[4:8])), 0).UTC(),
+		SequenceNo: binary.BigEndian.Uint32(data[8:12]),
+		SourceID:   binary.BigEndian.Uint32(data[12:16]),
+	}
+
+	length := int(binary.BigEndian.Uint16(data[2:4]))
+	if length < headerLength || length-headerLength > buf.Len() {
+		return header, nil, fmt.Errorf("invalid message length %d", length)
+	}
+	return header, bytes.NewBuffer(buf.Next(length - headerLength)), nil
+}
+
+// ReadSetHeader reads a set header.
+func (DecoderIPFIX) ReadSetHeader(buf *bytes.Buffer) (v9.SetHeader, error) {
+	return v9.ReadSetHeader(buf)
+}
+
+// IsTemplateSet returns true for template and options template sets.
+func (DecoderIPFIX) IsTemplateSet(setID uint16) bool {
+	return setID == TemplateSetID || setID == OptionsTemplateSetID
+}
+
+// ReadTemplateSet reads the templates of a template or options template set.
+// Template withdrawals are returned as templates without fields.
+func (d DecoderIPFIX) ReadTemplateSet(setID uint16, buf *bytes.Buffer) ([]*template.Template, error) {
+	var templates []*template.Template
+	for {
+		t, err := d.readTemplate(setID == OptionsTemplateSetID, buf)
+		if err == io.EOF {
+			// no more templates, the remaining data is padding
+			return templates, nil
+		}
+		if err != nil {
+			return templates, err
+		}
+		templates = append(templates, t)
+	}
+}
+
+func (d DecoderIPFIX) readTemplate(options bool, buf *bytes.Buffer) (*template.Template, error) {
+	data := buf.Next(4)
+	if len(data) < 4 {
+		return nil, io.EOF
+	}
+
+	id := binary.BigEndian.Uint16(data[:2])
+	count := int(binary.BigEndian.Uint16(data[2:4]))
+	if count == 0 {
+		// withdrawal of the template, or of all templates if the id is the set id
+		return template.New(id, nil, 0), nil
+	}
+	if id < 256 {
+		return nil, io.EOF
+	}
+
+	scopeCount := 0
+	if options {
+		data = buf.Next(2)
+		if len(data) < 2 {
+			return nil, v9.ErrNoData
+		}
+		scopeCount = int(binary.BigEndian.Uint16(data))
+		if scopeCount == 0 || scopeCount > count {
+			return nil, fmt.Errorf("invalid options template %d: %d scope fields for %d fields", id, scopeCount, count)
+		}
+	}
+
+	fieldTemplates := make([]template.FieldTemplate, count)
+	for i := range fieldTemplates {
+		f, err := d.readFieldSpecifier(buf)
+		if err != nil {
+			return nil, err
+		}
+		fieldTemplates[i] = f
+	}
+	return template.New(id, fieldTemplates, scopeCount), nil
+}
+
+// readFieldSpecifier reads a field of a template. Enterprise specific fields
+// include the private enterprise number of the element.
+func (d DecoderIPFIX) readFieldSpecifier(buf *bytes.Buffer) (template.FieldTemplate, error) {
+	data := buf.Next(4)
+	if len(data) < 4 {
+		return template.FieldTemplate{}, v9.ErrNoData
+	}
+
+	var key fields.Key
+	key.FieldID = binary.BigEndian.Uint16(data[:2])
+	length := binary.BigEndian.Uint16(data[2:4])
+
+	if key.FieldID&enterpriseBit != 0 {
+		key.FieldID &^= enterpriseBit
+		pen := buf.Next(4)
+		if len(pen) < 4 {
+			return template.FieldTemplate{}, v9.ErrNoData
+		}
+		key.EnterpriseID = binary.BigEndian.Uint32(pen)
+	}
+
+	return template.FieldTemplate{
+		Length: length,
+		Info:   d.Fields.Lookup(key),
+	}, nil
+}
+
+// ExporterMetadata returns the exporter information of an IPFIX message. The
+// source id is the observation domain id.
+func (DecoderIPFIX) ExporterMetadata(header v9.PacketHeader, source net.Addr) record.Map {
+	return record.Map{
+		"version":   uint64(header.Version),
+		"timestamp": header.ExportTime,
+		"address":   source.String(),
+		"source_id": uint64(header.SourceID),
+	}
+}
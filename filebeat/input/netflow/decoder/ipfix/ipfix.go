@@ -0,0 +1,47 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package ipfix decodes IPFIX messages. It shares the template handling of
+// the NetFlow v9 decoder.
+package ipfix
+
+import (
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/fields"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/protocol"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/v9"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+const (
+	// ProtocolName is the name used to enable the protocol in the configuration.
+	ProtocolName = "ipfix"
+
+	// ProtocolVersion is the version number in the message header.
+	ProtocolVersion = 10
+)
+
+func init() {
+	if err := protocol.Register(ProtocolName, New); err != nil {
+		panic(err)
+	}
+}
+
+// New returns an IPFIX decoder.
+func New(config protocol.Config) protocol.Protocol {
+	logger := logp.NewLogger("netflow").With("protocol", ProtocolName)
+	return v9.NewProtocolWithDecoder(DecoderIPFIX{Fields: fields.GlobalFields}, ProtocolVersion, config, logger)
+}
@@ -0,0 +1,148 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package ipfix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/protocol"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/record"
+)
+
+var exporterAddr = &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 4739}
+
+func write(buf *bytes.Buffer, values ...interface{}) {
+	for _, v := range values {
+		binary.Write(buf, binary.BigEndian, v)
+	}
+}
+
+// message returns an IPFIX message containing the given sets.
+func message(sequence uint32, sets ...[]byte) *bytes.Buffer {
+	length := 16
+	for _, set := range sets {
+		length += len(set)
+	}
+	buf := new(bytes.Buffer)
+	write(buf, uint16(10), uint16(length), uint32(1540000000), sequence, uint32(5))
+	for _, set := range sets {
+		buf.Write(set)
+	}
+	return buf
+}
+
+func set(id uint16, values ...interface{}) []byte {
+	body := new(bytes.Buffer)
+	write(body, values...)
+	buf := new(bytes.Buffer)
+	write(buf, id, uint16(4+body.Len()))
+	buf.Write(body.Bytes())
+	return buf.Bytes()
+}
+
+// templateSet defines template 300 with the source IPv6 address, the flow
+// start in milliseconds, the application name with a variable length and an
+// enterprise specific field.
+var templateSet = set(TemplateSetID,
+	uint16(300), uint16(4),
+	uint16(27), uint16(16),
+	uint16(152), uint16(8),
+	uint16(96), uint16(0xffff),
+	uint16(0x8000|100), uint16(2), uint32(29305),
+)
+
+func TestTemplateAndData(t *testing.T) {
+	proto := New(protocol.Config{})
+	assert.Equal(t, uint16(10), proto.Version())
+
+	dataSet := set(300,
+		net.ParseIP("2001:db8::1"), uint64(1540000000123), uint8(3), []byte("dns"), uint16(0xabcd),
+		net.ParseIP("2001:db8::2"), uint64(1540000000456), uint8(255), uint16(4), []byte("http"), uint16(0x1234),
+		uint16(0), // padding
+	)
+
+	records, err := proto.OnPacket(message(1, templateSet, dataSet), exporterAddr)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, record.Flow, records[0].Type)
+	assert.Equal(t, record.Map{
+		"source_ipv6_address":        net.ParseIP("2001:db8::1"),
+		"flow_start_milliseconds":    time.Unix(1540000000, 123000000).UTC(),
+		"application_name":           "dns",
+		"enterprise_29305_field_100": []byte{0xab, 0xcd},
+	}, records[0].Fields)
+	assert.Equal(t, "http", records[1].Fields["application_name"])
+	assert.Equal(t, record.Map{
+		"version":   uint64(10),
+		"timestamp": time.Unix(1540000000, 0).UTC(),
+		"address":   exporterAddr.String(),
+		"source_id": uint64(5),
+	}, records[0].Exporter)
+}
+
+func TestOptionsTemplate(t *testing.T) {
+	proto := New(protocol.Config{})
+
+	optionsSet := set(OptionsTemplateSetID,
+		uint16(301), uint16(2), uint16(1),
+		uint16(149), uint16(4),
+		uint16(41), uint16(8),
+	)
+	dataSet := set(301, uint32(5), uint64(1234))
+
+	records, err := proto.OnPacket(message(1, optionsSet, dataSet), exporterAddr)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	assert.Equal(t, record.Options, records[0].Type)
+	assert.Equal(t, record.Map{"observation_domain_id": uint64(5)}, records[0].Options)
+	assert.Equal(t, record.Map{"exported_message_total_count": uint64(1234)}, records[0].Fields)
+}
+
+func TestTemplateWithdrawal(t *testing.T) {
+	proto := New(protocol.Config{})
+	dataSet := set(300, net.ParseIP("2001:db8::1"), uint64(0), uint8(0), uint16(0))
+
+	records, err := proto.OnPacket(message(1, templateSet, dataSet), exporterAddr)
+	require.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	withdrawal := set(TemplateSetID, uint16(300), uint16(0))
+	records, err = proto.OnPacket(message(2, withdrawal, dataSet), exporterAddr)
+	require.NoError(t, err)
+	assert.Len(t, records, 0)
+}
+
+func TestInvalidMessageLength(t *testing.T) {
+	proto := New(protocol.Config{})
+
+	msg := message(1, templateSet)
+	msg.Truncate(msg.Len() - 4)
+	_, err := proto.OnPacket(msg, exporterAddr)
+	assert.Error(t, err)
+}
@@ -0,0 +1,89 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/record"
+)
+
+// Config contains the settings shared by the protocol decoders.
+type Config struct {
+	// ExpirationTimeout is the time after which unused templates and sessions are removed.
+	ExpirationTimeout time.Duration
+	// DetectSequenceReset resets the templates of a session when the sequence
+	// number of the packets indicates that the exporter was restarted.
+	DetectSequenceReset bool
+}
+
+// Protocol decodes the packets of a flow protocol version.
+type Protocol interface {
+	// Version is the version number in the header of the packets.
+	Version() uint16
+	// OnPacket decodes a packet received from the given exporter.
+	OnPacket(data *bytes.Buffer, source net.Addr) ([]record.Record, error)
+	// Start starts the background tasks of the protocol.
+	Start() error
+	// Stop stops the background tasks of the protocol.
+	Stop() error
+}
+
+// Factory creates a protocol decoder.
+type Factory func(config Config) Protocol
+
+var registry = map[string]Factory{}
+
+// Register adds a protocol to the registry.
+func Register(name string, factory Factory) error {
+	if name == "" {
+		return fmt.Errorf("Error registering protocol: name cannot be empty")
+	}
+	if factory == nil {
+		return fmt.Errorf("Error registering protocol '%v': factory cannot be empty", name)
+	}
+	if _, exists := registry[name]; exists {
+		return fmt.Errorf("Error registering protocol '%v': already registered", name)
+	}
+
+	registry[name] = factory
+	return nil
+}
+
+// Get returns the factory of a registered protocol.
+func Get(name string) (Factory, error) {
+	factory, found := registry[name]
+	if !found {
+		return nil, fmt.Errorf("protocol '%v' not registered", name)
+	}
+	return factory, nil
+}
+
+// Names returns the names of the registered protocols.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package record
+
+import (
+	"time"
+)
+
+// Type of a flow record.
+type Type uint8
+
+const (
+	// Flow records contain information about a flow.
+	Flow Type = iota
+	// Options records contain information about the exporter or the metering process.
+	Options
+)
+
+var typeNames = map[Type]string{
+	Flow:    "flow",
+	Options: "options",
+}
+
+func (t Type) String() string {
+	if name, found := typeNames[t]; found {
+		return name
+	}
+	return "unknown"
+}
+
+// Map of decoded fields, by field name.
+type Map = map[string]interface{}
+
+// Record is a flow or options record decoded from a packet.
+type Record struct {
+	Type      Type
+	Timestamp time.Time
+	// Fields contains the decoded information elements.
+	Fields Map
+	// Exporter contains information about the exporter and packet header.
+	Exporter Map
+	// Options contains the scope fields of options records.
+	Options Map
+}
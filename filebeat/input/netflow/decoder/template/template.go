@@ -0,0 +1,152 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package template
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/fields"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/record"
+)
+
+// VariableLength is the length of a field encoded with a variable length.
+const VariableLength uint16 = 0xffff
+
+// ErrEmptyTemplate is returned when a template without fields is applied.
+var ErrEmptyTemplate = errors.New("template has no fields")
+
+// FieldTemplate is a field in a template.
+type FieldTemplate struct {
+	Length uint16
+	Info   *fields.Field
+}
+
+// Template describes the layout of data records.
+type Template struct {
+	ID     uint16
+	Fields []FieldTemplate
+	// Length is the minimum length of a record. Variable length
+	// fields account for one byte.
+	Length int
+	// VariableLength is set if a field has a variable length.
+	VariableLength bool
+	// ScopeFields is the number of scope fields of an options template.
+	// The scope fields are the first fields of the template.
+	ScopeFields int
+}
+
+// New returns a template for the given fields.
+func New(id uint16, fieldTemplates []FieldTemplate, scopeFields int) *Template {
+	t := &Template{
+		ID:          id,
+		Fields:      fieldTemplates,
+		ScopeFields: scopeFields,
+	}
+	for _, f := range fieldTemplates {
+		if f.Length == VariableLength {
+			t.VariableLength = true
+			t.Length++
+		} else {
+			t.Length += int(f.Length)
+		}
+	}
+	return t
+}
+
+// Apply decodes the records of a data set. The remaining data after the last
+// complete record is considered padding.
+func (t *Template) Apply(data *bytes.Buffer) ([]record.Record, error) {
+	if len(t.Fields) == 0 || t.Length == 0 {
+		return nil, ErrEmptyTemplate
+	}
+
+	var records []record.Record
+	for data.Len() >= t.Length {
+		r, err := t.readRecord(data)
+		if err != nil {
+			if err == io.EOF && len(records) > 0 {
+				// Padding at the end of a set with variable length records
+				break
+			}
+			return records, err
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+func (t *Template) readRecord(data *bytes.Buffer) (record.Record, error) {
+	r := record.Record{
+		Type:   record.Flow,
+		Fields: make(record.Map, len(t.Fields)-t.ScopeFields),
+	}
+	if t.ScopeFields > 0 {
+		r.Type = record.Options
+		r.Options = make(record.Map, t.ScopeFields)
+	}
+
+	for idx, field := range t.Fields {
+		length := field.Length
+		if length == VariableLength {
+			var err error
+			length, err = readVariableLength(data)
+			if err != nil {
+				return r, err
+			}
+		}
+
+		raw := data.Next(int(length))
+		if len(raw) < int(length) {
+			return r, io.EOF
+		}
+
+		value, err := field.Info.Decoder.Decode(raw)
+		if err != nil {
+			// Skip fields with invalid values, the record can still be used
+			continue
+		}
+
+		if idx < t.ScopeFields {
+			r.Options[field.Info.Name] = value
+		} else {
+			r.Fields[field.Info.Name] = value
+		}
+	}
+	return r, nil
+}
+
+// readVariableLength reads the length of a variable length field, encoded in
+// one byte or, if the first byte is 255, in the following two bytes.
+func readVariableLength(data *bytes.Buffer) (uint16, error) {
+	b, err := data.ReadByte()
+	if err != nil {
+		return 0, io.EOF
+	}
+	if b < 255 {
+		return uint16(b), nil
+	}
+
+	buf := data.Next(2)
+	if len(buf) < 2 {
+		return 0, io.EOF
+	}
+	return binary.BigEndian.Uint16(buf), nil
+}
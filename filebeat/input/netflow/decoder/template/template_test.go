@@ -0,0 +1,83 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package template
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/fields"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/record"
+)
+
+var (
+	portField   = &fields.Field{Name: "port", Decoder: fields.Unsigned16}
+	nameField   = &fields.Field{Name: "name", Decoder: fields.String}
+	statusField = &fields.Field{Name: "status", Decoder: fields.Boolean}
+)
+
+func TestApplyFixedLength(t *testing.T) {
+	tmpl := New(256, []FieldTemplate{{Length: 2, Info: portField}, {Length: 1, Info: statusField}}, 0)
+	assert.Equal(t, 3, tmpl.Length)
+	assert.False(t, tmpl.VariableLength)
+
+	// two records, the second one has an invalid boolean, and padding
+	records, err := tmpl.Apply(bytes.NewBuffer([]byte{0, 80, 1, 1, 187, 9, 0}))
+	require.NoError(t, err)
+	assert.Equal(t, []record.Record{
+		{Type: record.Flow, Fields: record.Map{"port": uint64(80), "status": true}},
+		{Type: record.Flow, Fields: record.Map{"port": uint64(443)}},
+	}, records)
+}
+
+func TestApplyVariableLength(t *testing.T) {
+	tmpl := New(256, []FieldTemplate{{Length: VariableLength, Info: nameField}, {Length: 2, Info: portField}}, 0)
+	assert.Equal(t, 3, tmpl.Length)
+	assert.True(t, tmpl.VariableLength)
+
+	long := bytes.Repeat([]byte("a"), 300)
+	data := []byte{3, 'd', 'n', 's', 0, 53, 255, 1, 44}
+	data = append(data, long...)
+	data = append(data, 0, 80, 0, 0)
+
+	records, err := tmpl.Apply(bytes.NewBuffer(data))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, record.Map{"name": "dns", "port": uint64(53)}, records[0].Fields)
+	assert.Equal(t, record.Map{"name": string(long), "port": uint64(80)}, records[1].Fields)
+}
+
+func TestApplyOptions(t *testing.T) {
+	tmpl := New(256, []FieldTemplate{{Length: 2, Info: portField}, {Length: 1, Info: statusField}}, 1)
+
+	records, err := tmpl.Apply(bytes.NewBuffer([]byte{0, 80, 1}))
+	require.NoError(t, err)
+	assert.Equal(t, []record.Record{
+		{Type: record.Options, Fields: record.Map{"status": true}, Options: record.Map{"port": uint64(80)}},
+	}, records)
+}
+
+func TestApplyEmptyTemplate(t *testing.T) {
+	_, err := New(256, nil, 0).Apply(bytes.NewBuffer([]byte{1, 2, 3}))
+	assert.Equal(t, ErrEmptyTemplate, err)
+}
@@ -0,0 +1,157 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package v5 decodes NetFlow version 5 packets.
+package v5
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/fields"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/protocol"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/record"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/template"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+const (
+	// ProtocolName is the name used to enable the protocol in the configuration.
+	ProtocolName = "v5"
+
+	// ProtocolVersion is the version number in the packet header.
+	ProtocolVersion = 5
+
+	headerLength = 24
+	recordLength = 48
+	maxRecords   = 30
+)
+
+// recordTemplate describes the fixed layout of a v5 flow record with the
+// equivalent IPFIX information elements.
+var recordTemplate = template.New(0, []template.FieldTemplate{
+	{Length: 4, Info: field(fields.Key{FieldID: 8})},  // srcaddr
+	{Length: 4, Info: field(fields.Key{FieldID: 12})}, // dstaddr
+	{Length: 4, Info: field(fields.Key{FieldID: 15})}, // nexthop
+	{Length: 2, Info: field(fields.Key{FieldID: 10})}, // input
+	{Length: 2, Info: field(fields.Key{FieldID: 14})}, // output
+	{Length: 4, Info: field(fields.Key{FieldID: 2})},  // dPkts
+	{Length: 4, Info: field(fields.Key{FieldID: 1})},  // dOctets
+	{Length: 4, Info: field(fields.Key{FieldID: 22})}, // first
+	{Length: 4, Info: field(fields.Key{FieldID: 21})}, // last
+	{Length: 2, Info: field(fields.Key{FieldID: 7})},  // srcport
+	{Length: 2, Info: field(fields.Key{FieldID: 11})}, // dstport
+	{Length: 1, Info: padding},                        // pad1
+	{Length: 1, Info: field(fields.Key{FieldID: 6})},  // tcp_flags
+	{Length: 1, Info: field(fields.Key{FieldID: 4})},  // prot
+	{Length: 1, Info: field(fields.Key{FieldID: 5})},  // tos
+	{Length: 2, Info: field(fields.Key{FieldID: 16})}, // src_as
+	{Length: 2, Info: field(fields.Key{FieldID: 17})}, // dst_as
+	{Length: 1, Info: field(fields.Key{FieldID: 9})},  // src_mask
+	{Length: 1, Info: field(fields.Key{FieldID: 13})}, // dst_mask
+	{Length: 2, Info: padding},                        // pad2
+}, 0)
+
+var padding = &fields.Field{Name: "padding_octets", Decoder: fields.OctetArray}
+
+func field(key fields.Key) *fields.Field {
+	return fields.GlobalFields.Lookup(key)
+}
+
+func init() {
+	if err := protocol.Register(ProtocolName, New); err != nil {
+		panic(err)
+	}
+}
+
+// NetflowV5Protocol decodes NetFlow v5 packets.
+type NetflowV5Protocol struct {
+	logger *logp.Logger
+}
+
+// New returns a NetFlow v5 decoder.
+func New(config protocol.Config) protocol.Protocol {
+	return &NetflowV5Protocol{
+		logger: logp.NewLogger("netflow").With("protocol", ProtocolName),
+	}
+}
+
+// Version returns the NetFlow version.
+func (*NetflowV5Protocol) Version() uint16 {
+	return ProtocolVersion
+}
+
+// Start does nothing, v5 doesn't keep any state.
+func (*NetflowV5Protocol) Start() error {
+	return nil
+}
+
+// Stop does nothing, v5 doesn't keep any state.
+func (*NetflowV5Protocol) Stop() error {
+	return nil
+}
+
+// OnPacket decodes the flow records of a packet.
+func (p *NetflowV5Protocol) OnPacket(data *bytes.Buffer, source net.Addr) ([]record.Record, error) {
+	header := data.Next(headerLength)
+	if len(header) < headerLength {
+		return nil, fmt.Errorf("packet too short for a v5 header: %d bytes", len(header))
+	}
+
+	count := int(binary.BigEndian.Uint16(header[2:4]))
+	if count == 0 || count > maxRecords {
+		return nil, fmt.Errorf("invalid v5 record count: %d", count)
+	}
+	if data.Len() < count*recordLength {
+		return nil, fmt.Errorf("v5 packet with %d records is truncated: %d bytes left", count, data.Len())
+	}
+
+	uptime := binary.BigEndian.Uint32(header[4:8])
+	timestamp := time.Unix(int64(binary.BigEndian.Uint32(header[8:12])), int64(binary.BigEndian.Uint32(header[12:16]))).UTC()
+	exporter := record.Map{
+		"version":       uint64(ProtocolVersion),
+		"timestamp":     timestamp,
+		"uptime_millis": uint64(uptime),
+		"address":       source.String(),
+		"engine_type":   uint64(header[20]),
+		"engine_id":     uint64(header[21]),
+	}
+	// The first two bits are the sampling mode, the other 14 the sampling interval
+	sampling := binary.BigEndian.Uint16(header[22:24])
+
+	records, err := recordTemplate.Apply(bytes.NewBuffer(data.Next(count * recordLength)))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range records {
+		r := &records[i]
+		r.Timestamp = timestamp
+		r.Exporter = exporter
+		delete(r.Fields, padding.Name)
+		r.Fields[field(fields.Key{FieldID: 38}).Name] = exporter["engine_type"]
+		r.Fields[field(fields.Key{FieldID: 39}).Name] = exporter["engine_id"]
+		r.Fields[field(fields.Key{FieldID: 34}).Name] = uint64(sampling & 0x3fff)
+		r.Fields[field(fields.Key{FieldID: 49}).Name] = uint64(sampling >> 14)
+	}
+
+	p.logger.Debugf("Decoded %d v5 records from %v", len(records), source)
+	return records, nil
+}
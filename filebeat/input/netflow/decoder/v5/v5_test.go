@@ -0,0 +1,123 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package v5
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/protocol"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/record"
+)
+
+var exporterAddr = &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 4444}
+
+func writePacket(count int) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	header := []interface{}{
+		uint16(5), uint16(count),
+		uint32(3600000),               // uptime
+		uint32(1540000000), uint32(0), // timestamp
+		uint32(42),         // sequence
+		uint8(1), uint8(2), // engine type and id
+		uint16(1<<14 | 100), // sampling mode 1, interval 100
+	}
+	for _, v := range header {
+		binary.Write(buf, binary.BigEndian, v)
+	}
+
+	for i := 0; i < count; i++ {
+		flow := []interface{}{
+			[4]byte{192, 168, 0, byte(i)}, [4]byte{10, 0, 0, 2}, [4]byte{10, 0, 0, 254},
+			uint16(1), uint16(2), // input, output
+			uint32(10), uint32(1500), // packets, octets
+			uint32(3590000), uint32(3599000), // first, last
+			uint16(51234), uint16(443),
+			uint8(0), uint8(0x12), uint8(6), uint8(0), // pad, tcp flags, protocol, tos
+			uint16(64512), uint16(64513), // src and dst as
+			uint8(24), uint8(16), // masks
+			uint16(0), // pad
+		}
+		for _, v := range flow {
+			binary.Write(buf, binary.BigEndian, v)
+		}
+	}
+	return buf
+}
+
+func TestOnPacket(t *testing.T) {
+	proto := New(protocol.Config{})
+	assert.Equal(t, uint16(5), proto.Version())
+
+	records, err := proto.OnPacket(writePacket(2), exporterAddr)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	r := records[1]
+	assert.Equal(t, record.Flow, r.Type)
+	assert.Equal(t, time.Unix(1540000000, 0).UTC(), r.Timestamp)
+	assert.Equal(t, record.Map{
+		"source_ipv4_address":            net.IP{192, 168, 0, 1},
+		"destination_ipv4_address":       net.IP{10, 0, 0, 2},
+		"ip_next_hop_ipv4_address":       net.IP{10, 0, 0, 254},
+		"ingress_interface":              uint64(1),
+		"egress_interface":               uint64(2),
+		"packet_delta_count":             uint64(10),
+		"octet_delta_count":              uint64(1500),
+		"flow_start_sys_up_time":         uint64(3590000),
+		"flow_end_sys_up_time":           uint64(3599000),
+		"source_transport_port":          uint64(51234),
+		"destination_transport_port":     uint64(443),
+		"tcp_control_bits":               uint64(0x12),
+		"protocol_identifier":            uint64(6),
+		"ip_class_of_service":            uint64(0),
+		"bgp_source_as_number":           uint64(64512),
+		"bgp_destination_as_number":      uint64(64513),
+		"source_ipv4_prefix_length":      uint64(24),
+		"destination_ipv4_prefix_length": uint64(16),
+		"engine_type":                    uint64(1),
+		"engine_id":                      uint64(2),
+		"sampling_interval":              uint64(100),
+		"sampler_mode":                   uint64(1),
+	}, r.Fields)
+	assert.Equal(t, exporterAddr.String(), r.Exporter["address"])
+	assert.Equal(t, uint64(3600000), r.Exporter["uptime_millis"])
+}
+
+func TestOnPacketInvalid(t *testing.T) {
+	proto := New(protocol.Config{})
+
+	_, err := proto.OnPacket(bytes.NewBuffer([]byte{0, 5, 0, 1}), exporterAddr)
+	assert.Error(t, err, "short header")
+
+	truncated := writePacket(2)
+	truncated.Truncate(truncated.Len() - 10)
+	_, err = proto.OnPacket(truncated, exporterAddr)
+	assert.Error(t, err, "truncated records")
+
+	_, err = proto.OnPacket(writePacket(0), exporterAddr)
+	assert.Error(t, err, "no records")
+}
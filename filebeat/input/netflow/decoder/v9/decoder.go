@@ -0,0 +1,237 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v9
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/fields"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/record"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/template"
+)
+
+const (
+	// TemplateFlowSetID is the id of template flowsets.
+	TemplateFlowSetID = 0
+	// OptionsTemplateFlowSetID is the id of options template flowsets.
+	OptionsTemplateFlowSetID = 1
+
+	headerLength    = 20
+	setHeaderLength = 4
+)
+
+// ErrNoData is returned when the data is too short to be decoded.
+var ErrNoData = errors.New("not enough data")
+
+// PacketHeader contains the information of a packet header.
+type PacketHeader struct {
+	Version    uint16
+	Count      uint16
+	SysUptime  uint32
+	ExportTime time.Time
+	SequenceNo uint32
+	SourceID   uint32
+}
+
+// SetHeader is the header of a set (flowset in v9).
+type SetHeader struct {
+	SetID  uint16
+	Length uint16
+}
+
+// Decoder reads the protocol specific structures of a packet. It allows to
+// share the template handling between NetFlow v9 and IPFIX.
+type Decoder interface {
+	// ReadPacketHeader reads the packet header and returns the buffer containing the sets.
+	ReadPacketHeader(buf *bytes.Buffer) (PacketHeader, *bytes.Buffer, error)
+	// ReadSetHeader reads the header of the next set.
+	ReadSetHeader(buf *bytes.Buffer) (SetHeader, error)
+	// IsTemplateSet returns true for the ids of template and options template sets.
+	IsTemplateSet(setID uint16) bool
+	// ReadTemplateSet reads the templates defined in a set.
+	ReadTemplateSet(setID uint16, buf *bytes.Buffer) ([]*template.Template, error)
+	// ExporterMetadata returns the information about the exporter added to the records.
+	ExporterMetadata(header PacketHeader, source net.Addr) record.Map
+}
+
+// DecoderV9 decodes NetFlow v9 packets.
+type DecoderV9 struct {
+	Fields fields.FieldDict
+}
+
+var _ Decoder = DecoderV9{}
+
+// scopeFields are the scope field types of v9 options templates.
+var scopeFields = map[uint16]*fields.Field{
+	1: {Name: "scope_system", Decoder: fields.OctetArray},
+	2: {Name: "scope_interface", Decoder: fields.Unsigned32},
+	3: {Name: "scope_line_card", Decoder: fields.Unsigned32},
+	4: {Name: "scope_netflow_cache", Decoder: fields.OctetArray},
+	5: {Name: "scope_template", Decoder: fields.OctetArray},
+}
+
+// ReadPacketHeader reads a v9 packet header.
+func (DecoderV9) ReadPacketHeader(buf *bytes.Buffer) (PacketHeader, *bytes.Buffer, error) {
+	data := buf.Next(headerLength)
+	if len(data) < headerLength {
+		return PacketHeader{}, nil, io.EOF
+	}
+	header := PacketHeader{
+		Version:    binary.BigEndian.Uint16(data[:2]),
+		Count:      binary.BigEndian.Uint16(data[2:4]),
+		SysUptime:  binary.BigEndian.Uint32(data[4:8]),
+		ExportTime: time.Unix(int64(binary.BigEndian.Uint32(data[8:12])), 0).UTC(),
+		SequenceNo: binary.BigEndian.Uint32(data[12:16]),
+		SourceID:   binary.BigEndian.Uint32(data[16:20]),
+	}
+	return header, buf, nil
+}
+
+// ReadSetHeader reads a flowset header.
+func (DecoderV9) ReadSetHeader(buf *bytes.Buffer) (SetHeader, error) {
+	return ReadSetHeader(buf)
+}
+
+// ReadSetHeader reads a set header, which has the same format in v9 and IPFIX.
+func ReadSetHeader(buf *bytes.Buffer) (SetHeader, error) {
+	data := buf.Next(setHeaderLength)
+	if len(data) < setHeaderLength {
+		return SetHeader{}, io.EOF
+	}
+	header := SetHeader{
+		SetID:  binary.BigEndian.Uint16(data[:2]),
+		Length: binary.BigEndian.Uint16(data[2:4]),
+	}
+	if header.Length < setHeaderLength {
+		return header, fmt.Errorf("invalid set length %d", header.Length)
+	}
+	return header, nil
+}
+
+// IsTemplateSet returns true for template and options template flowsets.
+func (DecoderV9) IsTemplateSet(setID uint16) bool {
+	return setID == TemplateFlowSetID || setID == OptionsTemplateFlowSetID
+}
+
+// ReadTemplateSet reads the templates of a template or options template flowset.
+func (d DecoderV9) ReadTemplateSet(setID uint16, buf *bytes.Buffer) ([]*template.Template, error) {
+	var templates []*template.Template
+	for {
+		var t *template.Template
+		var err error
+		if setID == TemplateFlowSetID {
+			t, err = d.readTemplate(buf)
+		} else {
+			t, err = d.readOptionsTemplate(buf)
+		}
+		if err == io.EOF {
+			// no more templates, the remaining data is padding
+			return templates, nil
+		}
+		if err != nil {
+			return templates, err
+		}
+		templates = append(templates, t)
+	}
+}
+
+func (d DecoderV9) readTemplate(buf *bytes.Buffer) (*template.Template, error) {
+	data := buf.Next(4)
+	if len(data) < 4 {
+		return nil, io.EOF
+	}
+	id := binary.BigEndian.Uint16(data[:2])
+	count := int(binary.BigEndian.Uint16(data[2:4]))
+	if id < 256 {
+		return nil, io.EOF
+	}
+
+	fieldTemplates, err := d.readFields(buf, count, d.Fields)
+	if err != nil {
+		return nil, err
+	}
+	return template.New(id, fieldTemplates, 0), nil
+}
+
+func (d DecoderV9) readOptionsTemplate(buf *bytes.Buffer) (*template.Template, error) {
+	data := buf.Next(6)
+	if len(data) < 6 {
+		return nil, io.EOF
+	}
+	id := binary.BigEndian.Uint16(data[:2])
+	scopeLength := int(binary.BigEndian.Uint16(data[2:4]))
+	optionsLength := int(binary.BigEndian.Uint16(data[4:6]))
+	if id < 256 {
+		return nil, io.EOF
+	}
+	if scopeLength%4 != 0 || optionsLength%4 != 0 {
+		return nil, fmt.Errorf("invalid options template %d: scope length %d, options length %d", id, scopeLength, optionsLength)
+	}
+
+	scope, err := d.readFields(buf, scopeLength/4, scopeDict)
+	if err != nil {
+		return nil, err
+	}
+	options, err := d.readFields(buf, optionsLength/4, d.Fields)
+	if err != nil {
+		return nil, err
+	}
+	return template.New(id, append(scope, options...), len(scope)), nil
+}
+
+var scopeDict = func() fields.FieldDict {
+	dict := fields.FieldDict{}
+	for id, field := range scopeFields {
+		dict[fields.Key{FieldID: id}] = field
+	}
+	return dict
+}()
+
+func (DecoderV9) readFields(buf *bytes.Buffer, count int, dict fields.FieldDict) ([]template.FieldTemplate, error) {
+	data := buf.Next(count * 4)
+	if len(data) < count*4 {
+		return nil, ErrNoData
+	}
+
+	result := make([]template.FieldTemplate, count)
+	for i := range result {
+		key := fields.Key{FieldID: binary.BigEndian.Uint16(data[i*4:])}
+		result[i] = template.FieldTemplate{
+			Length: binary.BigEndian.Uint16(data[i*4+2:]),
+			Info:   dict.Lookup(key),
+		}
+	}
+	return result, nil
+}
+
+// ExporterMetadata returns the exporter information of a v9 packet.
+func (DecoderV9) ExporterMetadata(header PacketHeader, source net.Addr) record.Map {
+	return record.Map{
+		"version":       uint64(header.Version),
+		"timestamp":     header.ExportTime,
+		"uptime_millis": uint64(header.SysUptime),
+		"address":       source.String(),
+		"source_id":     uint64(header.SourceID),
+	}
+}
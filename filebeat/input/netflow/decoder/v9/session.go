@@ -0,0 +1,206 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v9
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/template"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// MaxSequenceDifference is the maximum difference between the sequence numbers
+// of consecutive packets before the exporter is considered restarted.
+const MaxSequenceDifference = 1000
+
+// SessionKey identifies a session, the templates of an exporter for a source
+// id (v9) or observation domain (IPFIX).
+type SessionKey struct {
+	Addr     string
+	SourceID uint32
+}
+
+// MakeSessionKey returns the session key for an exporter address and source id.
+func MakeSessionKey(addr net.Addr, sourceID uint32) SessionKey {
+	return SessionKey{
+		Addr:     addr.String(),
+		SourceID: sourceID,
+	}
+}
+
+type templateEntry struct {
+	template *template.Template
+	// expired is set by the cleanup and reset every time the template is used.
+	expired bool
+}
+
+// SessionState contains the templates of a session.
+type SessionState struct {
+	mutex        sync.Mutex
+	templates    map[uint16]*templateEntry
+	lastSequence uint32
+	started      bool
+	expired      bool
+}
+
+func newSession() *SessionState {
+	return &SessionState{
+		templates: map[uint16]*templateEntry{},
+	}
+}
+
+// AddTemplate adds or replaces a template. Templates without fields are
+// withdrawals: they remove the template with the same id, or all the
+// templates if the id is not a data set id.
+func (s *SessionState) AddTemplate(t *template.Template) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(t.Fields) > 0 {
+		s.templates[t.ID] = &templateEntry{template: t}
+		return
+	}
+
+	if t.ID < 256 {
+		s.templates = map[uint16]*templateEntry{}
+	} else {
+		delete(s.templates, t.ID)
+	}
+}
+
+// GetTemplate returns the template with the given id, or nil if it is unknown.
+func (s *SessionState) GetTemplate(id uint16) *template.Template {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entry, found := s.templates[id]
+	if !found {
+		return nil
+	}
+	entry.expired = false
+	return entry.template
+}
+
+// CheckReset updates the sequence number of the session. If it isn't close
+// to the previous one, the exporter is considered restarted and the templates
+// are removed. It returns true if the session was reset.
+func (s *SessionState) CheckReset(sequence uint32) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	reset := s.started && !isValidSequence(s.lastSequence, sequence)
+	if reset {
+		s.templates = map[uint16]*templateEntry{}
+	}
+	s.lastSequence = sequence
+	s.started = true
+	return reset
+}
+
+func isValidSequence(current, next uint32) bool {
+	return next-current < MaxSequenceDifference || current-next < MaxSequenceDifference
+}
+
+// expireTemplates removes the templates not used since the last call and marks
+// the remaining ones to be removed on the next call.
+func (s *SessionState) expireTemplates() (alive int, removed int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for id, entry := range s.templates {
+		if entry.expired {
+			delete(s.templates, id)
+			removed++
+		} else {
+			entry.expired = true
+			alive++
+		}
+	}
+	return alive, removed
+}
+
+// SessionMap contains the sessions of all the exporters.
+type SessionMap struct {
+	mutex    sync.Mutex
+	sessions map[SessionKey]*SessionState
+}
+
+// NewSessionMap returns an empty session map.
+func NewSessionMap() *SessionMap {
+	return &SessionMap{
+		sessions: map[SessionKey]*SessionState{},
+	}
+}
+
+// GetOrCreate returns the session for the given key, creating it if needed.
+func (m *SessionMap) GetOrCreate(key SessionKey) *SessionState {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	session, found := m.sessions[key]
+	if !found {
+		session = newSession()
+		m.sessions[key] = session
+	}
+	session.expired = false
+	return session
+}
+
+// Cleanup removes the sessions and templates which were not used since the
+// last cleanup.
+func (m *SessionMap) Cleanup() (aliveSessions, removedSessions, aliveTemplates, removedTemplates int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for key, session := range m.sessions {
+		alive, removed := session.expireTemplates()
+		aliveTemplates += alive
+		removedTemplates += removed
+
+		if session.expired {
+			delete(m.sessions, key)
+			removedSessions++
+		} else {
+			session.expired = true
+			aliveSessions++
+		}
+	}
+	return
+}
+
+// CleanupLoop runs the cleanup periodically until done is closed. Unused
+// sessions and templates are removed after one to two intervals.
+func (m *SessionMap) CleanupLoop(interval time.Duration, done <-chan struct{}, logger *logp.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			aliveSessions, removedSessions, aliveTemplates, removedTemplates := m.Cleanup()
+			if removedSessions > 0 || removedTemplates > 0 {
+				logger.Debugf("Expired %d sessions (%d remain) and %d templates (%d remain)",
+					removedSessions, aliveSessions, removedTemplates, aliveTemplates)
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package v9
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/template"
+)
+
+func TestSessionTemplates(t *testing.T) {
+	session := newSession()
+	assert.Nil(t, session.GetTemplate(256))
+
+	t256 := template.New(256, []template.FieldTemplate{{Length: 4}}, 0)
+	t257 := template.New(257, []template.FieldTemplate{{Length: 4}}, 0)
+	session.AddTemplate(t256)
+	session.AddTemplate(t257)
+	assert.Equal(t, t256, session.GetTemplate(256))
+
+	// withdrawal of a single template
+	session.AddTemplate(template.New(256, nil, 0))
+	assert.Nil(t, session.GetTemplate(256))
+	assert.Equal(t, t257, session.GetTemplate(257))
+
+	// withdrawal of all the templates
+	session.AddTemplate(template.New(2, nil, 0))
+	assert.Nil(t, session.GetTemplate(257))
+}
+
+func TestSessionExpiration(t *testing.T) {
+	sessions := NewSessionMap()
+	key := SessionKey{Addr: "10.0.0.1:4444", SourceID: 1}
+
+	session := sessions.GetOrCreate(key)
+	session.AddTemplate(template.New(256, []template.FieldTemplate{{Length: 4}}, 0))
+	session.AddTemplate(template.New(257, []template.FieldTemplate{{Length: 4}}, 0))
+
+	aliveSessions, removedSessions, aliveTemplates, removedTemplates := sessions.Cleanup()
+	assert.Equal(t, []int{1, 0, 2, 0}, []int{aliveSessions, removedSessions, aliveTemplates, removedTemplates})
+
+	// Using the session and a template keeps them
+	assert.Equal(t, session, sessions.GetOrCreate(key))
+	assert.NotNil(t, session.GetTemplate(256))
+
+	aliveSessions, removedSessions, aliveTemplates, removedTemplates = sessions.Cleanup()
+	assert.Equal(t, []int{1, 0, 1, 1}, []int{aliveSessions, removedSessions, aliveTemplates, removedTemplates})
+	assert.Nil(t, session.GetTemplate(257))
+
+	aliveSessions, removedSessions, aliveTemplates, removedTemplates = sessions.Cleanup()
+	assert.Equal(t, []int{0, 1, 0, 1}, []int{aliveSessions, removedSessions, aliveTemplates, removedTemplates})
+	assert.NotEqual(t, session, sessions.GetOrCreate(key))
+}
+
+func TestIsValidSequence(t *testing.T) {
+	assert.True(t, isValidSequence(1, 2))
+	assert.True(t, isValidSequence(1, 1))
+	assert.True(t, isValidSequence(10, 5))
+	assert.True(t, isValidSequence(0xffffffff, 3), "wrap around")
+	assert.False(t, isValidSequence(100000, 1))
+	assert.False(t, isValidSequence(1, 100000))
+}
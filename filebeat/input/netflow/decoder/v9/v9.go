@@ -0,0 +1,162 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package v9 decodes NetFlow version 9 packets.
+package v9
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/fields"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/protocol"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/record"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+const (
+	// ProtocolName is the name used to enable the protocol in the configuration.
+	ProtocolName = "v9"
+
+	// ProtocolVersion is the version number in the packet header.
+	ProtocolVersion = 9
+)
+
+func init() {
+	if err := protocol.Register(ProtocolName, New); err != nil {
+		panic(err)
+	}
+}
+
+// NetflowV9Protocol decodes packets using templates, with the structures
+// read by its Decoder.
+type NetflowV9Protocol struct {
+	decoder     Decoder
+	version     uint16
+	Session     *SessionMap
+	timeout     time.Duration
+	detectReset bool
+	logger      *logp.Logger
+	done        chan struct{}
+}
+
+// New returns a NetFlow v9 decoder.
+func New(config protocol.Config) protocol.Protocol {
+	logger := logp.NewLogger("netflow").With("protocol", ProtocolName)
+	return NewProtocolWithDecoder(DecoderV9{Fields: fields.GlobalFields}, ProtocolVersion, config, logger)
+}
+
+// NewProtocolWithDecoder returns a template based protocol using the given decoder.
+func NewProtocolWithDecoder(decoder Decoder, version uint16, config protocol.Config, logger *logp.Logger) *NetflowV9Protocol {
+	return &NetflowV9Protocol{
+		decoder:     decoder,
+		version:     version,
+		Session:     NewSessionMap(),
+		timeout:     config.ExpirationTimeout,
+		detectReset: config.DetectSequenceReset,
+		logger:      logger,
+	}
+}
+
+// Version returns the version number of the protocol.
+func (p *NetflowV9Protocol) Version() uint16 {
+	return p.version
+}
+
+// Start starts the expiration of unused sessions and templates.
+func (p *NetflowV9Protocol) Start() error {
+	p.done = make(chan struct{})
+	if p.timeout > 0 {
+		go p.Session.CleanupLoop(p.timeout, p.done, p.logger)
+	}
+	return nil
+}
+
+// Stop stops the expiration of unused sessions and templates.
+func (p *NetflowV9Protocol) Stop() error {
+	if p.done != nil {
+		close(p.done)
+	}
+	return nil
+}
+
+// OnPacket decodes the records of a packet. Templates are stored in the
+// session of the exporter, data sets without a known template are dropped.
+func (p *NetflowV9Protocol) OnPacket(buf *bytes.Buffer, source net.Addr) ([]record.Record, error) {
+	header, payload, err := p.decoder.ReadPacketHeader(buf)
+	if err != nil {
+		return nil, fmt.Errorf("error reading packet header: %v", err)
+	}
+
+	session := p.Session.GetOrCreate(MakeSessionKey(source, header.SourceID))
+	if p.detectReset && session.CheckReset(header.SequenceNo) {
+		p.logger.Infof("Sequence number of %v (source id %d) was reset, removing its templates", source, header.SourceID)
+	}
+
+	exporter := p.decoder.ExporterMetadata(header, source)
+
+	var records []record.Record
+	for payload.Len() > 0 {
+		set, err := p.decoder.ReadSetHeader(payload)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return records, err
+		}
+
+		body := payload.Next(int(set.Length) - setHeaderLength)
+		if len(body) < int(set.Length)-setHeaderLength {
+			return records, fmt.Errorf("set %d is truncated: %d of %d bytes", set.SetID, len(body), set.Length)
+		}
+		data := bytes.NewBuffer(body)
+
+		switch {
+		case p.decoder.IsTemplateSet(set.SetID):
+			templates, err := p.decoder.ReadTemplateSet(set.SetID, data)
+			if err != nil {
+				p.logger.Debugf("Error reading templates of set %d from %v: %v", set.SetID, source, err)
+			}
+			for _, t := range templates {
+				session.AddTemplate(t)
+			}
+
+		case set.SetID >= 256:
+			t := session.GetTemplate(set.SetID)
+			if t == nil {
+				p.logger.Debugf("No template %d for %v (source id %d), dropping the data set", set.SetID, source, header.SourceID)
+				continue
+			}
+			decoded, err := t.Apply(data)
+			if err != nil {
+				p.logger.Debugf("Error applying template %d for %v: %v", set.SetID, source, err)
+			}
+			for i := range decoded {
+				decoded[i].Timestamp = header.ExportTime
+				decoded[i].Exporter = exporter
+			}
+			records = append(records, decoded...)
+
+		default:
+			p.logger.Debugf("Ignoring set with reserved id %d from %v", set.SetID, source)
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,157 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package v9
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/protocol"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/record"
+)
+
+var exporterAddr = &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 4444}
+
+func write(buf *bytes.Buffer, values ...interface{}) {
+	for _, v := range values {
+		binary.Write(buf, binary.BigEndian, v)
+	}
+}
+
+func header(sequence uint32, sourceID uint32) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	write(buf, uint16(9), uint16(2), uint32(60000), uint32(1540000000), sequence, sourceID)
+	return buf
+}
+
+// writeTemplate writes a template flowset for template 256: source address,
+// destination port and octets.
+func writeTemplate(buf *bytes.Buffer) {
+	write(buf, uint16(TemplateFlowSetID), uint16(20), uint16(256), uint16(3),
+		uint16(8), uint16(4),
+		uint16(11), uint16(2),
+		uint16(1), uint16(4),
+	)
+}
+
+// writeData writes a data flowset with two records of template 256, and padding.
+func writeData(buf *bytes.Buffer) {
+	write(buf, uint16(256), uint16(4+2*10+4),
+		[4]byte{192, 168, 0, 1}, uint16(53), uint32(100),
+		[4]byte{192, 168, 0, 2}, uint16(80), uint32(2000),
+		uint32(0),
+	)
+}
+
+func TestTemplateAndData(t *testing.T) {
+	proto := New(protocol.Config{})
+	assert.Equal(t, uint16(9), proto.Version())
+
+	packet := header(1, 7)
+	writeTemplate(packet)
+	writeData(packet)
+
+	records, err := proto.OnPacket(packet, exporterAddr)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, record.Flow, records[0].Type)
+	assert.Equal(t, time.Unix(1540000000, 0).UTC(), records[0].Timestamp)
+	assert.Equal(t, record.Map{
+		"source_ipv4_address":        net.IP{192, 168, 0, 1},
+		"destination_transport_port": uint64(53),
+		"octet_delta_count":          uint64(100),
+	}, records[0].Fields)
+	assert.Equal(t, uint64(2000), records[1].Fields["octet_delta_count"])
+	assert.Equal(t, record.Map{
+		"version":       uint64(9),
+		"timestamp":     time.Unix(1540000000, 0).UTC(),
+		"uptime_millis": uint64(60000),
+		"address":       exporterAddr.String(),
+		"source_id":     uint64(7),
+	}, records[0].Exporter)
+
+	// The template is kept for the following packets of the session
+	packet = header(2, 7)
+	writeData(packet)
+	records, err = proto.OnPacket(packet, exporterAddr)
+	require.NoError(t, err)
+	assert.Len(t, records, 2)
+
+	// but not used for other source ids
+	packet = header(1, 8)
+	writeData(packet)
+	records, err = proto.OnPacket(packet, exporterAddr)
+	require.NoError(t, err)
+	assert.Len(t, records, 0)
+}
+
+func TestOptionsTemplate(t *testing.T) {
+	proto := New(protocol.Config{})
+
+	packet := header(1, 0)
+	// options template 257, scoped by interface, with the sampling interval and padding
+	write(packet, uint16(OptionsTemplateFlowSetID), uint16(20),
+		uint16(257), uint16(4), uint16(4),
+		uint16(2), uint16(4),
+		uint16(34), uint16(4),
+		uint16(0),
+	)
+	write(packet, uint16(257), uint16(12), uint32(3), uint32(1000))
+
+	records, err := proto.OnPacket(packet, exporterAddr)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	assert.Equal(t, record.Options, records[0].Type)
+	assert.Equal(t, record.Map{"scope_interface": uint64(3)}, records[0].Options)
+	assert.Equal(t, record.Map{"sampling_interval": uint64(1000)}, records[0].Fields)
+}
+
+func TestSequenceReset(t *testing.T) {
+	proto := New(protocol.Config{DetectSequenceReset: true})
+
+	packet := header(100000, 1)
+	writeTemplate(packet)
+	_, err := proto.OnPacket(packet, exporterAddr)
+	require.NoError(t, err)
+
+	// A restarted exporter starts again with a low sequence number
+	packet = header(1, 1)
+	writeData(packet)
+	records, err := proto.OnPacket(packet, exporterAddr)
+	require.NoError(t, err)
+	assert.Len(t, records, 0)
+}
+
+func TestTruncatedSet(t *testing.T) {
+	proto := New(protocol.Config{})
+
+	packet := header(1, 1)
+	write(packet, uint16(TemplateFlowSetID), uint16(100), uint16(256))
+	_, err := proto.OnPacket(packet, exporterAddr)
+	assert.Error(t, err)
+}
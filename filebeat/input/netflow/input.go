@@ -0,0 +1,210 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netflow
+
+import (
+	"bytes"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/filebeat/channel"
+	"github.com/elastic/beats/filebeat/harvester"
+	"github.com/elastic/beats/filebeat/input"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder/protocol"
+	"github.com/elastic/beats/filebeat/inputsource"
+	"github.com/elastic/beats/filebeat/inputsource/udp"
+	"github.com/elastic/beats/filebeat/util"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+)
+
+var (
+	netflowMetrics = monitoring.Default.NewRegistry("filebeat.input.netflow")
+
+	packetsReceived = monitoring.NewInt(netflowMetrics, "packets.received")
+	packetsDropped  = monitoring.NewInt(netflowMetrics, "packets.dropped")
+	packetsInvalid  = monitoring.NewInt(netflowMetrics, "packets.invalid")
+	flowsReceived   = monitoring.NewInt(netflowMetrics, "flows")
+)
+
+func init() {
+	err := input.Register("netflow", NewInput)
+	if err != nil {
+		panic(err)
+	}
+}
+
+type packet struct {
+	data   []byte
+	source net.Addr
+}
+
+// Input receives NetFlow and IPFIX packets over UDP and publishes an event
+// for each flow record.
+type Input struct {
+	sync.Mutex
+	udp       *udp.Server
+	decoder   *decoder.Decoder
+	outlet    channel.Outleter
+	forwarder *harvester.Forwarder
+	queue     chan packet
+	done      chan struct{}
+	wg        sync.WaitGroup
+	started   bool
+	log       *logp.Logger
+}
+
+// NewInput creates a new netflow input
+func NewInput(
+	cfg *common.Config,
+	outlet channel.Connector,
+	context input.Context,
+) (input.Input, error) {
+	cfgwarn.Experimental("Netflow input type is used")
+
+	out, err := outlet(cfg, context.DynamicFields)
+	if err != nil {
+		return nil, err
+	}
+
+	config := defaultConfig
+	if err = cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	dec, err := decoder.NewDecoder(config.Protocols, protocol.Config{
+		ExpirationTimeout:   config.ExpirationTimeout,
+		DetectSequenceReset: config.DetectSequenceReset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Input{
+		decoder:   dec,
+		outlet:    out,
+		forwarder: harvester.NewForwarder(out),
+		queue:     make(chan packet, config.QueueSize),
+		done:      make(chan struct{}),
+		log:       logp.NewLogger("netflow").With("address", config.Host),
+	}
+	p.udp = udp.New(&config.Config, p.onPacket)
+
+	return p, nil
+}
+
+// onPacket queues a received packet for decoding. The packet is dropped if
+// the queue is full, so that the UDP socket is never blocked.
+func (p *Input) onPacket(data []byte, metadata inputsource.NetworkMetadata) {
+	packetsReceived.Inc()
+	if metadata.Truncated {
+		packetsInvalid.Inc()
+		p.log.Debugf("Dropping truncated packet from %v", metadata.RemoteAddr)
+		return
+	}
+
+	select {
+	case p.queue <- packet{data: data, source: metadata.RemoteAddr}:
+	default:
+		packetsDropped.Inc()
+	}
+}
+
+// Run starts the UDP server and the decoding of the received packets
+func (p *Input) Run() {
+	p.Lock()
+	defer p.Unlock()
+
+	if !p.started {
+		p.log.Info("Starting netflow input")
+		if err := p.decoder.Start(); err != nil {
+			p.log.Errorw("Error starting the netflow decoder", "error", err)
+			return
+		}
+
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.decodeLoop()
+		}()
+
+		err := p.udp.Start()
+		if err != nil {
+			p.log.Errorw("Error running harvester", "error", err)
+		}
+		p.started = true
+	}
+}
+
+func (p *Input) decodeLoop() {
+	for {
+		select {
+		case <-p.done:
+			return
+		case pkt := <-p.queue:
+			records, err := p.decoder.Read(bytes.NewBuffer(pkt.data), pkt.source)
+			if err != nil {
+				packetsInvalid.Inc()
+				p.log.Debugf("Error decoding packet from %v: %v", pkt.source, err)
+			}
+
+			flowsReceived.Add(int64(len(records)))
+			for _, r := range records {
+				e := util.NewData()
+				e.Event = toEvent(r)
+				if e.Event.Timestamp.IsZero() {
+					e.Event.Timestamp = time.Now()
+				}
+				if err := p.forwarder.Send(e); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Stop stops the netflow input
+func (p *Input) Stop() {
+	p.Lock()
+	defer p.Unlock()
+
+	if !p.started {
+		p.outlet.Close()
+		return
+	}
+
+	p.log.Info("Stopping netflow input")
+	p.udp.Stop()
+	close(p.done)
+
+	// Closing the outlet unblocks the decoding loop if the output is blocked
+	p.outlet.Close()
+	p.wg.Wait()
+
+	p.decoder.Stop()
+	p.started = false
+}
+
+// Wait suspends the netflow input
+func (p *Input) Wait() {
+	p.Stop()
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/elastic/beats/filebeat/inputsource"
 	"github.com/elastic/beats/filebeat/inputsource/tcp"
 	"github.com/elastic/beats/filebeat/inputsource/udp"
+	"github.com/elastic/beats/filebeat/inputsource/unix"
 	"github.com/elastic/beats/libbeat/common"
 )
 
@@ -52,6 +53,13 @@ var defaultUDP = udp.Config{
 	Timeout:        time.Minute * 5,
 }
 
+var defaultUnix = unix.Config{
+	SocketType:     unix.DatagramSocket,
+	LineDelimiter:  "\n",
+	Timeout:        time.Minute * 5,
+	MaxMessageSize: 20 * humanize.MiByte,
+}
+
 func factory(
 	cb inputsource.NetworkFunc,
 	config common.ConfigNamespace,
@@ -71,7 +79,13 @@ func factory(
 			return nil, err
 		}
 		return udp.New(&config, cb), nil
+	case unix.Name:
+		config := defaultUnix
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+		return unix.New(&config, cb)
 	default:
-		return nil, fmt.Errorf("you must choose between TCP or UDP")
+		return nil, fmt.Errorf("you must choose between TCP, UDP or Unix")
 	}
 }
@@ -0,0 +1,67 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/cfgtype"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+)
+
+// Name is the human readable name and identifier.
+const Name = "http"
+
+// Config exposes the http configuration.
+type Config struct {
+	Host           string                  `config:"host"`
+	URL            string                  `config:"url"`
+	Timeout        time.Duration           `config:"timeout" validate:"nonzero,positive"`
+	MaxMessageSize cfgtype.ByteSize        `config:"max_message_size" validate:"nonzero,positive"`
+	BasicAuth      *BasicAuthConfig        `config:"basic_auth"`
+	SecretHeader   *SecretHeaderConfig     `config:"secret_header"`
+	TLS            *tlscommon.ServerConfig `config:"ssl"`
+}
+
+// BasicAuthConfig contains the credentials a client must send using HTTP basic authentication.
+type BasicAuthConfig struct {
+	Username string `config:"username" validate:"required"`
+	Password string `config:"password" validate:"required"`
+}
+
+// SecretHeaderConfig contains the name of a request header and the value it must hold.
+type SecretHeaderConfig struct {
+	Header string `config:"header" validate:"required"`
+	Value  string `config:"value" validate:"required"`
+}
+
+// Validate validates the Config option for the http input source.
+func (c *Config) Validate() error {
+	if len(c.Host) == 0 {
+		return fmt.Errorf("need to specify the host using the `host:port` syntax")
+	}
+	if !strings.HasPrefix(c.URL, "/") {
+		return fmt.Errorf("url must start with a '/', got '%s'", c.URL)
+	}
+	if c.BasicAuth != nil && c.SecretHeader != nil {
+		return fmt.Errorf("basic_auth and secret_header cannot be used at the same time")
+	}
+	return nil
+}
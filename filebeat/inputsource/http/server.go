@@ -0,0 +1,267 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/elastic/beats/filebeat/inputsource"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+)
+
+var (
+	errUnsupportedType = errors.New("only JSON objects or arrays of JSON objects are accepted")
+	errEmptyBody       = errors.New("body is empty")
+)
+
+// Server represent an HTTP server receiving JSON documents.
+type Server struct {
+	config    *Config
+	callback  inputsource.NetworkFunc
+	Listener  net.Listener
+	server    *http.Server
+	wg        sync.WaitGroup
+	log       *logp.Logger
+	tlsConfig *transport.TLSConfig
+}
+
+// New creates a new http server.
+func New(
+	config *Config,
+	callback inputsource.NetworkFunc,
+) (*Server, error) {
+	tlsConfig, err := tlscommon.LoadTLSServerConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		config:    config,
+		callback:  callback,
+		log:       logp.NewLogger("http").With("address", config.Host),
+		tlsConfig: tlsConfig,
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(config.URL, s)
+	s.server = &http.Server{
+		Handler:      mux,
+		ReadTimeout:  config.Timeout,
+		WriteTimeout: config.Timeout,
+	}
+	return s, nil
+}
+
+// Start listen to the HTTP socket.
+func (s *Server) Start() error {
+	var err error
+	s.Listener, err = s.createServer()
+	if err != nil {
+		return err
+	}
+
+	s.log.Info("Started listening for HTTP requests")
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		err := s.server.Serve(s.Listener)
+		if err != nil && err != http.ErrServerClosed {
+			s.log.Errorw("HTTP server stopped unexpectedly", "error", err)
+		}
+	}()
+	return nil
+}
+
+// Stop stops accepting new requests and waits for the in-flight ones to complete.
+func (s *Server) Stop() {
+	s.log.Info("Stopping HTTP server")
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.Timeout)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		s.log.Debugw("Error while shutting down the HTTP server", "error", err)
+		s.server.Close()
+	}
+	s.wg.Wait()
+	s.log.Info("HTTP server stopped")
+}
+
+// ServeHTTP authenticates the request, decodes the JSON body and sends every object
+// it contains to the callback.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		sendResponse(w, http.StatusMethodNotAllowed, "only POST requests are allowed")
+		return
+	}
+
+	if !s.authorized(r) {
+		if s.config.BasicAuth != nil {
+			w.Header().Set("WWW-Authenticate", `Basic realm="filebeat"`)
+		}
+		sendResponse(w, http.StatusUnauthorized, "incorrect credentials")
+		return
+	}
+
+	limit := int64(s.config.MaxMessageSize)
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, limit+1))
+	if err != nil {
+		sendResponse(w, http.StatusBadRequest, fmt.Sprintf("error reading body: %v", err))
+		return
+	}
+	if int64(len(body)) > limit {
+		sendResponse(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("body exceeds %d bytes", limit))
+		return
+	}
+
+	objs, err := decodeBody(body)
+	if err != nil {
+		s.log.Debugw("Rejected request body", "remote_address", r.RemoteAddr, "error", err)
+		sendResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	metadata := inputsource.NetworkMetadata{
+		RemoteAddr: remoteAddr(r),
+		TLS:        extractSSLInformation(r.TLS),
+	}
+	for _, obj := range objs {
+		s.callback(obj, metadata)
+	}
+
+	sendResponse(w, http.StatusOK, fmt.Sprintf("%d events received", len(objs)))
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	switch {
+	case s.config.BasicAuth != nil:
+		username, password, ok := r.BasicAuth()
+		return ok &&
+			secureEqual(username, s.config.BasicAuth.Username) &&
+			secureEqual(password, s.config.BasicAuth.Password)
+	case s.config.SecretHeader != nil:
+		return secureEqual(r.Header.Get(s.config.SecretHeader.Header), s.config.SecretHeader.Value)
+	}
+	return true
+}
+
+func (s *Server) createServer() (net.Listener, error) {
+	if s.tlsConfig != nil {
+		t := s.tlsConfig.BuildModuleConfig(s.config.Host)
+		s.log.Info("Listening over TLS")
+		return tls.Listen("tcp", s.config.Host, t)
+	}
+	return net.Listen("tcp", s.config.Host)
+}
+
+// decodeBody splits a body made of a single JSON object, an array of objects, or
+// newline delimited objects into its individual objects. The whole body is rejected
+// if any part of it is invalid, so a client can safely retry a failed request.
+func decodeBody(body []byte) ([][]byte, error) {
+	var objs [][]byte
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	for {
+		var raw json.RawMessage
+		err := dec.Decode(&raw)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("malformed JSON body: %v", err)
+		}
+
+		switch raw[0] {
+		case '{':
+			objs = append(objs, raw)
+		case '[':
+			var elems []json.RawMessage
+			if err := json.Unmarshal(raw, &elems); err != nil {
+				return nil, fmt.Errorf("malformed JSON array: %v", err)
+			}
+			for _, elem := range elems {
+				if elem[0] != '{' {
+					return nil, errUnsupportedType
+				}
+				objs = append(objs, elem)
+			}
+		default:
+			return nil, errUnsupportedType
+		}
+	}
+
+	if len(objs) == 0 {
+		return nil, errEmptyBody
+	}
+	return objs, nil
+}
+
+func sendResponse(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"message": message})
+}
+
+func secureEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+func remoteAddr(r *http.Request) net.Addr {
+	addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr)
+	if err != nil {
+		return nil
+	}
+	return addr
+}
+
+func extractSSLInformation(state *tls.ConnectionState) *inputsource.TLSMetadata {
+	if state == nil {
+		return nil
+	}
+	return &inputsource.TLSMetadata{
+		TLSVersion:       tlscommon.ResolveTLSVersion(state.Version),
+		CipherSuite:      tlscommon.ResolveCipherSuite(state.CipherSuite),
+		ServerName:       state.ServerName,
+		PeerCertificates: extractCertificate(state.PeerCertificates),
+	}
+}
+
+func extractCertificate(certificates []*x509.Certificate) []string {
+	strCertificate := make([]string, len(certificates))
+	for idx, c := range certificates {
+		// Ignore errors here, problematics cert have failed
+		// the handshake at this point.
+		b, _ := x509.MarshalPKIXPublicKey(c.PublicKey)
+		strCertificate[idx] = string(b)
+	}
+	return strCertificate
+}
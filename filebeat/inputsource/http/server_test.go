@@ -0,0 +1,259 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/filebeat/inputsource"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+var defaultConfig = Config{
+	URL:            "/",
+	Timeout:        time.Second * 5,
+	MaxMessageSize: 10 * humanize.MiByte,
+}
+
+type info struct {
+	message string
+	mt      inputsource.NetworkMetadata
+}
+
+func TestConfigValidation(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"MissingHost": {},
+		"RelativeURL": {"host": "localhost:0", "url": "webhook"},
+		"BothAuthMethods": {
+			"host":          "localhost:0",
+			"basic_auth":    map[string]interface{}{"username": "a", "password": "b"},
+			"secret_header": map[string]interface{}{"header": "X-Secret", "value": "c"},
+		},
+		"IncompleteBasicAuth": {
+			"host":       "localhost:0",
+			"basic_auth": map[string]interface{}{"username": "a"},
+		},
+	}
+
+	for name, cfg := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, _ := common.NewConfigFrom(cfg)
+			config := defaultConfig
+			assert.Error(t, c.Unpack(&config))
+		})
+	}
+}
+
+func TestDecodeBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "SingleObject",
+			body:     `{"a": 1}`,
+			expected: []string{`{"a": 1}`},
+		},
+		{
+			name:     "Array",
+			body:     `[{"a": 1}, {"b": 2}]`,
+			expected: []string{`{"a": 1}`, `{"b": 2}`},
+		},
+		{
+			name:     "NDJSON",
+			body:     "{\"a\": 1}\n{\"b\": 2}\n",
+			expected: []string{`{"a": 1}`, `{"b": 2}`},
+		},
+		{
+			name: "Empty",
+			body: " \n",
+			err:  true,
+		},
+		{
+			name: "Malformed",
+			body: "{\"a\": 1}\n{\"b\":",
+			err:  true,
+		},
+		{
+			name: "Scalar",
+			body: `"hello"`,
+			err:  true,
+		},
+		{
+			name: "ArrayOfScalars",
+			body: `[{"a": 1}, 2]`,
+			err:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objs, err := decodeBody([]byte(test.body))
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			var actual []string
+			for _, obj := range objs {
+				actual = append(actual, string(obj))
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestReceiveEventsAndMetadata(t *testing.T) {
+	tests := []struct {
+		name             string
+		cfg              map[string]interface{}
+		method           string
+		header           http.Header
+		basicAuth        []string
+		body             string
+		expectedStatus   int
+		expectedMessages []string
+	}{
+		{
+			name:             "NDJSON",
+			cfg:              map[string]interface{}{},
+			body:             "{\"a\":1}\n{\"b\":2}",
+			expectedStatus:   http.StatusOK,
+			expectedMessages: []string{`{"a":1}`, `{"b":2}`},
+		},
+		{
+			name:           "WrongMethod",
+			cfg:            map[string]interface{}{},
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "BodyTooLarge",
+			cfg:            map[string]interface{}{"max_message_size": 10},
+			body:           `{"message": "hello world"}`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "BasicAuth",
+			cfg: map[string]interface{}{
+				"basic_auth": map[string]interface{}{"username": "beat", "password": "secret"},
+			},
+			basicAuth:        []string{"beat", "secret"},
+			body:             `{"a":1}`,
+			expectedStatus:   http.StatusOK,
+			expectedMessages: []string{`{"a":1}`},
+		},
+		{
+			name: "BasicAuthWrongPassword",
+			cfg: map[string]interface{}{
+				"basic_auth": map[string]interface{}{"username": "beat", "password": "secret"},
+			},
+			basicAuth:      []string{"beat", "wrong"},
+			body:           `{"a":1}`,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "SecretHeader",
+			cfg: map[string]interface{}{
+				"secret_header": map[string]interface{}{"header": "X-Secret", "value": "s3cr3t"},
+			},
+			header:           http.Header{"X-Secret": []string{"s3cr3t"}},
+			body:             `[{"a":1}]`,
+			expectedStatus:   http.StatusOK,
+			expectedMessages: []string{`{"a":1}`},
+		},
+		{
+			name: "MissingSecretHeader",
+			cfg: map[string]interface{}{
+				"secret_header": map[string]interface{}{"header": "X-Secret", "value": "s3cr3t"},
+			},
+			body:           `{"a":1}`,
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ch := make(chan *info, 10)
+			to := func(message []byte, mt inputsource.NetworkMetadata) {
+				ch <- &info{message: string(message), mt: mt}
+			}
+			test.cfg["host"] = "localhost:0"
+			cfg, _ := common.NewConfigFrom(test.cfg)
+			config := defaultConfig
+			err := cfg.Unpack(&config)
+			if !assert.NoError(t, err) {
+				return
+			}
+			server, err := New(&config, to)
+			if !assert.NoError(t, err) {
+				return
+			}
+			err = server.Start()
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer server.Stop()
+
+			method := test.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			url := "http://" + server.Listener.Addr().String() + "/"
+			req, err := http.NewRequest(method, url, strings.NewReader(test.body))
+			if !assert.NoError(t, err) {
+				return
+			}
+			for k, v := range test.header {
+				req.Header[k] = v
+			}
+			if test.basicAuth != nil {
+				req.SetBasicAuth(test.basicAuth[0], test.basicAuth[1])
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if !assert.NoError(t, err) {
+				return
+			}
+			resp.Body.Close()
+			assert.Equal(t, test.expectedStatus, resp.StatusCode)
+
+			close(ch)
+			var events []*info
+			for event := range ch {
+				events = append(events, event)
+			}
+			if assert.Equal(t, len(test.expectedMessages), len(events)) {
+				for idx, e := range events {
+					assert.Equal(t, test.expectedMessages[idx], e.message)
+					assert.NotNil(t, e.mt.RemoteAddr)
+				}
+			}
+		})
+	}
+}
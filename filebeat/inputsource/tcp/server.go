@@ -60,7 +60,7 @@ func New(
 		return nil, err
 	}
 
-	sf := splitFunc([]byte(config.LineDelimiter))
+	sf := SplitFunc([]byte(config.LineDelimiter))
 	return &Server{
 		config:    config,
 		callback:  callback,
@@ -190,7 +190,8 @@ func (s *Server) clientsCount() int {
 	return len(s.clients)
 }
 
-func splitFunc(lineDelimiter []byte) bufio.SplitFunc {
+// SplitFunc returns a function that splits a stream into lines using the given delimiter.
+func SplitFunc(lineDelimiter []byte) bufio.SplitFunc {
 	ld := []byte(lineDelimiter)
 	if bytes.Equal(ld, []byte("\n")) {
 		// This will work for most usecases and will also strip \r if present.
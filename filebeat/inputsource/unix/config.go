@@ -0,0 +1,95 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package unix
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/cfgtype"
+)
+
+// Name is the human readable name and identifier.
+const Name = "unix"
+
+// SocketType is the type of the Unix socket: stream or datagram.
+type SocketType uint8
+
+const (
+	// StreamSocket reads delimited lines from connections established on the socket.
+	StreamSocket SocketType = iota + 1
+	// DatagramSocket reads every datagram sent to the socket as a single message.
+	DatagramSocket
+)
+
+var socketTypes = map[string]SocketType{
+	"stream":   StreamSocket,
+	"datagram": DatagramSocket,
+}
+
+// Unpack unpacks the socket type from its name.
+func (s *SocketType) Unpack(v string) error {
+	t, found := socketTypes[v]
+	if !found {
+		return fmt.Errorf("unknown socket type '%v', must be one of 'stream' or 'datagram'", v)
+	}
+	*s = t
+	return nil
+}
+
+func (s SocketType) String() string {
+	for name, t := range socketTypes {
+		if t == s {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// Config exposes the unix socket configuration.
+type Config struct {
+	Path           string           `config:"path"`
+	Group          *string          `config:"group"`
+	Mode           *string          `config:"mode"`
+	SocketType     SocketType       `config:"socket_type"`
+	LineDelimiter  string           `config:"line_delimiter" validate:"nonzero"`
+	Timeout        time.Duration    `config:"timeout" validate:"nonzero,positive"`
+	MaxMessageSize cfgtype.ByteSize `config:"max_message_size" validate:"nonzero,positive"`
+}
+
+// Validate validates the Config option for the unix input source.
+func (c *Config) Validate() error {
+	if len(c.Path) == 0 {
+		return fmt.Errorf("need to specify the path to the unix socket")
+	}
+	if c.Mode != nil {
+		if _, err := parseFileMode(*c.Mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseFileMode(mode string) (uint32, error) {
+	m, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || m > 0777 {
+		return 0, fmt.Errorf("invalid socket mode '%s', must be an octal value like '0660'", mode)
+	}
+	return uint32(m), nil
+}
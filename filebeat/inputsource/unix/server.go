@@ -0,0 +1,242 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package unix
+
+import (
+	"bufio"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/filebeat/inputsource"
+	"github.com/elastic/beats/filebeat/inputsource/tcp"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// Server represent a Unix domain socket server, accepting either stream connections or
+// datagrams depending on the configured socket type.
+type Server struct {
+	sync.RWMutex
+	config    *Config
+	callback  inputsource.NetworkFunc
+	Listener  net.Listener
+	Conn      net.PacketConn
+	clients   map[net.Conn]struct{}
+	wg        sync.WaitGroup
+	done      chan struct{}
+	splitFunc bufio.SplitFunc
+	log       *logp.Logger
+}
+
+// New creates a new unix server.
+func New(
+	config *Config,
+	callback inputsource.NetworkFunc,
+) (*Server, error) {
+	if len(config.LineDelimiter) == 0 {
+		return nil, errors.New("empty line delimiter")
+	}
+
+	return &Server{
+		config:    config,
+		callback:  callback,
+		clients:   make(map[net.Conn]struct{}),
+		done:      make(chan struct{}),
+		splitFunc: tcp.SplitFunc([]byte(config.LineDelimiter)),
+		log:       logp.NewLogger("unix").With("path", config.Path, "socket_type", config.SocketType),
+	}, nil
+}
+
+// Start listen to the Unix socket.
+func (s *Server) Start() error {
+	if err := cleanupStaleSocket(s.config.Path, s.config.SocketType); err != nil {
+		return err
+	}
+
+	var err error
+	switch s.config.SocketType {
+	case DatagramSocket:
+		s.Conn, err = net.ListenPacket("unixgram", s.config.Path)
+	default:
+		s.Listener, err = net.Listen("unix", s.config.Path)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := setSocketOwnership(s.config.Path, s.config.Group, s.config.Mode); err != nil {
+		s.close()
+		return err
+	}
+
+	s.log.Info("Started listening on Unix socket")
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if s.Conn != nil {
+			s.runDatagram()
+		} else {
+			s.runStream()
+		}
+	}()
+	return nil
+}
+
+func (s *Server) runStream() {
+	for {
+		conn, err := s.Listener.Accept()
+		if err != nil {
+			select {
+			case <-s.done:
+				return
+			default:
+				s.log.Debugw("Can not accept the connection", "error", err)
+				continue
+			}
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer logp.Recover("recovering from a unix client crash")
+			defer s.wg.Done()
+			defer conn.Close()
+
+			s.registerClient(conn)
+			defer s.unregisterClient(conn)
+			s.log.Debugw("New client", "total", s.clientsCount())
+
+			if err := s.handle(conn); err != nil {
+				s.log.Debugw("Client error", "error", err)
+			}
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) error {
+	r := tcp.NewResetableLimitedReader(tcp.NewDeadlineReader(conn, s.config.Timeout), uint64(s.config.MaxMessageSize))
+	scanner := bufio.NewScanner(bufio.NewReader(r))
+	scanner.Split(s.splitFunc)
+
+	metadata := inputsource.NetworkMetadata{RemoteAddr: s.remoteAddr(conn.RemoteAddr())}
+	for scanner.Scan() {
+		r.Reset()
+		s.callback(scanner.Bytes(), metadata)
+	}
+
+	if err := scanner.Err(); err != nil {
+		// This is a user defined limit and we should notify the user.
+		if tcp.IsMaxReadBufferErr(err) {
+			s.log.Errorw("client error", "error", err)
+		}
+		return errors.Wrap(err, "unix client error")
+	}
+	return nil
+}
+
+func (s *Server) runDatagram() {
+	// The callback must not retain the buffer, it is reused for the next datagram.
+	buffer := make([]byte, s.config.MaxMessageSize)
+	for {
+		length, addr, err := s.Conn.ReadFrom(buffer)
+		if err != nil {
+			select {
+			case <-s.done:
+				return
+			default:
+				s.log.Errorw("Error reading from the socket", "error", err)
+				continue
+			}
+		}
+
+		if length > 0 {
+			s.callback(buffer[:length], inputsource.NetworkMetadata{RemoteAddr: s.remoteAddr(addr)})
+		}
+	}
+}
+
+// remoteAddr returns the address of the peer, local clients are usually not bound to
+// a path, Linux reports them as "@", so the address of the socket itself is used instead.
+func (s *Server) remoteAddr(addr net.Addr) net.Addr {
+	if ua, ok := addr.(*net.UnixAddr); ok && ua != nil && ua.Name != "" && ua.Name != "@" {
+		return ua
+	}
+	network := "unix"
+	if s.config.SocketType == DatagramSocket {
+		network = "unixgram"
+	}
+	return &net.UnixAddr{Name: s.config.Path, Net: network}
+}
+
+// Stop stops accepting new data, closes any active clients and removes the socket file.
+func (s *Server) Stop() {
+	s.log.Info("Stopping Unix socket server")
+	close(s.done)
+	s.close()
+	for _, conn := range s.allClients() {
+		conn.Close()
+	}
+	s.wg.Wait()
+	s.log.Info("Unix socket server stopped")
+}
+
+func (s *Server) close() {
+	if s.Listener == nil && s.Conn == nil {
+		// The socket was never created, the file at the path is not ours to remove.
+		return
+	}
+	if s.Listener != nil {
+		s.Listener.Close()
+	}
+	if s.Conn != nil {
+		s.Conn.Close()
+	}
+	if err := os.Remove(s.config.Path); err != nil && !os.IsNotExist(err) {
+		s.log.Debugw("Cannot remove socket file", "error", err)
+	}
+}
+
+func (s *Server) registerClient(conn net.Conn) {
+	s.Lock()
+	defer s.Unlock()
+	s.clients[conn] = struct{}{}
+}
+
+func (s *Server) unregisterClient(conn net.Conn) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.clients, conn)
+}
+
+func (s *Server) allClients() []net.Conn {
+	s.RLock()
+	defer s.RUnlock()
+	conns := make([]net.Conn, 0, len(s.clients))
+	for conn := range s.clients {
+		conns = append(conns, conn)
+	}
+	return conns
+}
+
+func (s *Server) clientsCount() int {
+	s.RLock()
+	defer s.RUnlock()
+	return len(s.clients)
+}
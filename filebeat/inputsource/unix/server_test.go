@@ -0,0 +1,221 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !windows
+
+package unix
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/filebeat/inputsource"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+var defaultConfig = Config{
+	SocketType:     StreamSocket,
+	LineDelimiter:  "\n",
+	Timeout:        time.Minute * 5,
+	MaxMessageSize: 20 * humanize.MiByte,
+}
+
+type info struct {
+	message string
+	mt      inputsource.NetworkMetadata
+}
+
+func TestErrorOnInvalidConfig(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"MissingPath":       {},
+		"UnknownSocketType": {"path": "/tmp/test.sock", "socket_type": "raw"},
+		"InvalidMode":       {"path": "/tmp/test.sock", "mode": "0999"},
+		"EmptyDelimiter":    {"path": "/tmp/test.sock", "line_delimiter": ""},
+	}
+
+	for name, cfg := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, _ := common.NewConfigFrom(cfg)
+			config := defaultConfig
+			assert.Error(t, c.Unpack(&config))
+		})
+	}
+}
+
+func TestReceiveEventsAndMetadata(t *testing.T) {
+	messages := []string{"first message", "second message", "third message"}
+
+	tests := []struct {
+		name    string
+		cfg     map[string]interface{}
+		network string
+		send    func(conn net.Conn)
+	}{
+		{
+			name:    "Stream",
+			cfg:     map[string]interface{}{},
+			network: "unix",
+			send: func(conn net.Conn) {
+				fmt.Fprint(conn, strings.Join(messages, "\n"))
+			},
+		},
+		{
+			name:    "StreamCustomDelimiter",
+			cfg:     map[string]interface{}{"line_delimiter": "<END>"},
+			network: "unix",
+			send: func(conn net.Conn) {
+				fmt.Fprint(conn, strings.Join(messages, "<END>"))
+			},
+		},
+		{
+			name:    "Datagram",
+			cfg:     map[string]interface{}{"socket_type": "datagram"},
+			network: "unixgram",
+			send: func(conn net.Conn) {
+				for _, m := range messages {
+					fmt.Fprint(conn, m)
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "unix")
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "test.sock")
+
+			ch := make(chan *info, len(messages))
+			to := func(message []byte, mt inputsource.NetworkMetadata) {
+				ch <- &info{message: string(message), mt: mt}
+			}
+			test.cfg["path"] = path
+			cfg, _ := common.NewConfigFrom(test.cfg)
+			config := defaultConfig
+			err = cfg.Unpack(&config)
+			if !assert.NoError(t, err) {
+				return
+			}
+			server, err := New(&config, to)
+			if !assert.NoError(t, err) {
+				return
+			}
+			err = server.Start()
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer server.Stop()
+
+			conn, err := net.Dial(test.network, path)
+			if !assert.NoError(t, err) {
+				return
+			}
+			test.send(conn)
+			conn.Close()
+
+			for _, expected := range messages {
+				select {
+				case event := <-ch:
+					assert.Equal(t, expected, event.message)
+					assert.Equal(t, path, event.mt.RemoteAddr.String())
+				case <-time.After(5 * time.Second):
+					t.Fatal("timeout waiting for event")
+				}
+			}
+		})
+	}
+}
+
+func TestSocketPermissionsAndCleanup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unix")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.sock")
+
+	mode := "0640"
+	group := fmt.Sprintf("%d", os.Getgid())
+	config := defaultConfig
+	config.Path = path
+	config.Mode = &mode
+	config.Group = &group
+
+	// Leave a stale socket behind, like a crashed process would.
+	stale, err := net.Listen("unix", path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	stale.Close()
+
+	server, err := New(&config, func([]byte, inputsource.NetworkMetadata) {})
+	if !assert.NoError(t, err) {
+		return
+	}
+	if !assert.NoError(t, server.Start()) {
+		return
+	}
+
+	info, err := os.Stat(path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, os.FileMode(0640), info.Mode().Perm())
+	}
+
+	// A second server must not take over a socket that is in use.
+	other, err := New(&config, func([]byte, inputsource.NetworkMetadata) {})
+	if assert.NoError(t, err) {
+		assert.Error(t, other.Start())
+		other.Stop()
+	}
+
+	server.Stop()
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestRefuseToRemoveRegularFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "unix")
+	if !assert.NoError(t, err) {
+		return
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	config := defaultConfig
+	config.Path = f.Name()
+	server, err := New(&config, func([]byte, inputsource.NetworkMetadata) {})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Error(t, server.Start())
+
+	_, err = os.Stat(f.Name())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package unix
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+	"time"
+)
+
+// cleanupStaleSocket removes a socket file left behind by a process that did not
+// shutdown cleanly. An error is returned if the path is used by another file or by
+// a socket that is still accepting connections.
+func cleanupStaleSocket(path string, socketType SocketType) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("refusing to remove file at location %s, it is not a socket", path)
+	}
+
+	network := "unix"
+	if socketType == DatagramSocket {
+		network = "unixgram"
+	}
+	conn, err := net.DialTimeout(network, path, time.Second)
+	if err == nil {
+		conn.Close()
+		return fmt.Errorf("socket %s is already in use", path)
+	}
+
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("cannot remove stale socket %s: %v", path, err)
+	}
+	return nil
+}
+
+// setSocketOwnership applies the configured group and permissions to the socket file.
+func setSocketOwnership(path string, group *string, mode *string) error {
+	if group != nil {
+		gid, err := lookupGID(*group)
+		if err != nil {
+			return err
+		}
+		if err := os.Chown(path, -1, gid); err != nil {
+			return fmt.Errorf("cannot change group of socket %s to %s: %v", path, *group, err)
+		}
+	}
+
+	if mode != nil {
+		m, err := parseFileMode(*mode)
+		if err != nil {
+			return err
+		}
+		if err := os.Chmod(path, os.FileMode(m)); err != nil {
+			return fmt.Errorf("cannot change permissions of socket %s to %s: %v", path, *mode, err)
+		}
+	}
+	return nil
+}
+
+// lookupGID resolves a group name or a numeric group id.
+func lookupGID(group string) (int, error) {
+	if gid, err := strconv.Atoi(group); err == nil {
+		return gid, nil
+	}
+
+	g, err := user.LookupGroup(group)
+	if err != nil {
+		return 0, fmt.Errorf("cannot find group %s: %v", group, err)
+	}
+	return strconv.Atoi(g.Gid)
+}
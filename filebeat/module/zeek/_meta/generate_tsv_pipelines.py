@@ -0,0 +1,86 @@
+"""
+Generates the ingest pipelines parsing the tab separated logs of the zeek
+filesets.
+
+The columns of each log are read from the `#fields` and `#types` header lines
+of the test log of the fileset, which is written by Zeek with the default
+columns. The script converting the values is shared by all the pipelines and
+is read from tsv.painless.
+
+Usage: python generate_tsv_pipelines.py
+"""
+
+import json
+import os
+from collections import OrderedDict
+
+module_path = os.path.abspath(os.path.join(os.path.dirname(__file__), ".."))
+
+
+def read_header(fileset):
+    header = {}
+    with open(os.path.join(module_path, fileset, "test", fileset + ".log")) as f:
+        for line in f:
+            name, _, values = line.rstrip("\n").partition("\t")
+            if name in ("#fields", "#types"):
+                header[name] = values.split("\t")
+    return header["#fields"], header["#types"]
+
+
+def pipeline(fileset, script):
+    fields, types = read_header(fileset)
+    return OrderedDict([
+        ("description", "Pipeline for parsing the Zeek {} logs in TSV format".format(fileset)),
+        ("processors", [
+            {"split": OrderedDict([
+                ("field", "message"),
+                ("separator", "\\t"),
+                ("target_field", "zeek.values"),
+            ])},
+            {"script": OrderedDict([
+                ("lang", "painless"),
+                ("source", script),
+                ("params", OrderedDict([
+                    ("log", fileset),
+                    ("fields", fields),
+                    ("types", types),
+                ])),
+            ])},
+            {"rename": OrderedDict([
+                ("field", "@timestamp"),
+                ("target_field", "read_timestamp"),
+            ])},
+            {"date": OrderedDict([
+                ("field", "zeek.{}.ts".format(fileset)),
+                ("target_field", "@timestamp"),
+                ("formats", ["UNIX", "ISO8601"]),
+            ])},
+            {"remove": OrderedDict([
+                ("field", ["message", "zeek.{}.ts".format(fileset)]),
+            ])},
+        ]),
+        ("on_failure", [
+            {"set": OrderedDict([
+                ("field", "error.message"),
+                ("value", "{{ _ingest.on_failure_message }}"),
+            ])},
+        ]),
+    ])
+
+
+def main():
+    with open(os.path.join(module_path, "_meta", "tsv.painless")) as f:
+        script = " ".join(line.strip() for line in f if line.strip())
+
+    for fileset in sorted(os.listdir(module_path)):
+        if not os.path.isfile(os.path.join(module_path, fileset, "manifest.yml")):
+            continue
+
+        path = os.path.join(module_path, fileset, "ingest", "pipeline-tsv.json")
+        with open(path, "w") as f:
+            json.dump(pipeline(fileset, script), f, indent=4, separators=(",", ": "))
+            f.write("\n")
+
+
+if __name__ == "__main__":
+    main()
@@ -37,6 +37,13 @@ type Reader struct {
 
 	// join partial lines
 	partial bool
+
+	// format of the log lines, `auto`, `docker` or `cri`
+	format string
+
+	// line of another stream read while joining partial lines, it is
+	// returned by the next call
+	pending *reader.Message
 }
 
 type dockerLog struct {
@@ -49,13 +56,16 @@ type crioLog struct {
 	Timestamp time.Time
 	Stream    string
 	Log       []byte
+	// Partial is true for lines tagged with P, which are continued in the next line
+	Partial bool
 }
 
 // New creates a new reader renaming a field
-func New(r reader.Reader, stream string, partial bool) *Reader {
+func New(r reader.Reader, stream string, partial bool, format string) *Reader {
 	return &Reader{
 		stream:  stream,
 		partial: partial,
+		format:  format,
 		reader:  r,
 	}
 }
@@ -63,6 +73,10 @@ func New(r reader.Reader, stream string, partial bool) *Reader {
 // parseCRILog parses logs in CRI log format.
 // CRI log format example :
 // 2017-09-12T22:32:21.212861448Z stdout 2017-09-12 22:32:21.212 [INFO][88] table.go 710: Invalidating dataplane cache
+//
+// Newer runtimes add a tag after the stream, `P` for partial lines continued
+// in the following line and `F` for full or last lines:
+// 2017-09-12T22:32:21.212861448Z stdout F 2017-09-12 22:32:21.212 [INFO][88] table.go 710: Invalidating dataplane cache
 func parseCRILog(message reader.Message, msg *crioLog) (reader.Message, error) {
 	log := strings.SplitN(string(message.Content), " ", 3)
 	if len(log) < 3 {
@@ -72,10 +86,28 @@ func parseCRILog(message reader.Message, msg *crioLog) (reader.Message, error) {
 	if err != nil {
 		return message, errors.Wrap(err, "parsing CRI timestamp")
 	}
+	if log[1] != "stdout" && log[1] != "stderr" {
+		return message, errors.Errorf("invalid CRI log stream '%s'", log[1])
+	}
+
+	content := log[2]
+	tag, rest := content, ""
+	if idx := strings.IndexByte(content, ' '); idx >= 0 {
+		tag, rest = content[:idx], content[idx+1:]
+	}
+	// Tags can contain multiple flags separated by `:`, the first one is P or F
+	switch strings.SplitN(tag, ":", 2)[0] {
+	case "P":
+		msg.Partial = true
+		content = rest
+	case "F":
+		msg.Partial = false
+		content = rest
+	}
 
 	msg.Timestamp = ts
 	msg.Stream = log[1]
-	msg.Log = []byte(log[2])
+	msg.Log = []byte(content)
 	message.AddFields(common.MapStr{
 		"stream": msg.Stream,
 	})
@@ -109,42 +141,114 @@ func parseDockerJSONLog(message reader.Message, msg *dockerLog) (reader.Message,
 	return message, nil
 }
 
+// isDockerJSON decides the format of a line, based on the configured
+// format or on the content of the line when it is detected automatically.
+func (p *Reader) isDockerJSON(message reader.Message) bool {
+	switch p.format {
+	case "docker":
+		return true
+	case "cri":
+		return false
+	}
+	return bytes.HasPrefix(message.Content, []byte("{"))
+}
+
 // Next returns the next line.
 func (p *Reader) Next() (reader.Message, error) {
+	// bytes of the lines skipped by the stream filter, they are added to the
+	// next message so the offset of the file state stays correct.
+	skipped := 0
+
 	for {
-		message, err := p.reader.Next()
+		message, err := p.next()
 		if err != nil {
 			return message, err
 		}
 
-		var dockerLine dockerLog
-		var crioLine crioLog
-
-		if strings.HasPrefix(string(message.Content), "{") {
-			message, err = parseDockerJSONLog(message, &dockerLine)
-			if err != nil {
-				return message, err
-			}
-			// Handle multiline messages, join lines that don't end with \n
-			for p.partial && message.Content[len(message.Content)-1] != byte('\n') {
-				next, err := p.reader.Next()
-				if err != nil {
-					return message, err
-				}
-				next, err = parseDockerJSONLog(next, &dockerLine)
-				if err != nil {
-					return message, err
-				}
-				message.Content = append(message.Content, next.Content...)
-			}
+		var stream string
+		if p.isDockerJSON(message) {
+			message, stream, err = p.nextDockerJSON(message)
 		} else {
-			message, err = parseCRILog(message, &crioLine)
+			message, stream, err = p.nextCRI(message)
 		}
+		message.Bytes += skipped
 
-		if p.stream != "all" && p.stream != dockerLine.Stream && p.stream != crioLine.Stream {
+		if err == nil && p.stream != "all" && p.stream != stream {
+			skipped = message.Bytes
 			continue
 		}
 
 		return message, err
 	}
 }
+
+// next returns the line kept back while joining partial lines, or reads a
+// new line.
+func (p *Reader) next() (reader.Message, error) {
+	if p.pending != nil {
+		message := *p.pending
+		p.pending = nil
+		return message, nil
+	}
+	return p.reader.Next()
+}
+
+// nextDockerJSON parses a docker JSON line. With partial enabled, lines are
+// joined until the log ends with a new line.
+func (p *Reader) nextDockerJSON(message reader.Message) (reader.Message, string, error) {
+	var dockerLine dockerLog
+	message, err := parseDockerJSONLog(message, &dockerLine)
+	if err != nil {
+		return message, "", err
+	}
+
+	// Handle multiline messages, join lines of the same stream that don't end with \n
+	for p.partial && !bytes.HasSuffix(message.Content, []byte("\n")) {
+		raw, err := p.reader.Next()
+		if err != nil {
+			return message, dockerLine.Stream, err
+		}
+		var nextLine dockerLog
+		next, err := parseDockerJSONLog(raw, &nextLine)
+		if err != nil {
+			return message, dockerLine.Stream, err
+		}
+		if nextLine.Stream != dockerLine.Stream {
+			p.pending = &raw
+			break
+		}
+		message.Content = append(message.Content, next.Content...)
+		message.Bytes += next.Bytes
+	}
+	return message, dockerLine.Stream, nil
+}
+
+// nextCRI parses a CRI line. With partial enabled, lines tagged as partial
+// are joined with the following lines until a full line is found.
+func (p *Reader) nextCRI(message reader.Message) (reader.Message, string, error) {
+	var crioLine crioLog
+	message, err := parseCRILog(message, &crioLine)
+	if err != nil {
+		return message, "", err
+	}
+
+	for p.partial && crioLine.Partial {
+		raw, err := p.reader.Next()
+		if err != nil {
+			return message, crioLine.Stream, err
+		}
+		var nextLine crioLog
+		next, err := parseCRILog(raw, &nextLine)
+		if err != nil {
+			return message, crioLine.Stream, err
+		}
+		if nextLine.Stream != crioLine.Stream {
+			p.pending = &raw
+			break
+		}
+		crioLine.Partial = nextLine.Partial
+		message.Content = append(message.Content, next.Content...)
+		message.Bytes += next.Bytes
+	}
+	return message, crioLine.Stream, nil
+}
@@ -32,6 +32,7 @@ func TestDockerJSON(t *testing.T) {
 		input           [][]byte
 		stream          string
 		partial         bool
+		format          string
 		expectedError   bool
 		expectedMessage reader.Message
 	}{
@@ -43,6 +44,7 @@ func TestDockerJSON(t *testing.T) {
 				Content: []byte("1:M 09 Nov 13:27:36.276 # User requested shutdown...\n"),
 				Fields:  common.MapStr{"stream": "stdout"},
 				Ts:      time.Date(2017, 11, 9, 13, 27, 36, 277747246, time.UTC),
+				Bytes:   122,
 			},
 		},
 		// Wrong JSON
@@ -77,6 +79,7 @@ func TestDockerJSON(t *testing.T) {
 				Content: []byte("2017-09-12 22:32:21.212 [INFO][88] table.go 710: Invalidating dataplane cache"),
 				Fields:  common.MapStr{"stream": "stdout"},
 				Ts:      time.Date(2017, 9, 12, 22, 32, 21, 212861448, time.UTC),
+				Bytes:   115,
 			},
 		},
 		// Filtering stream
@@ -91,6 +94,7 @@ func TestDockerJSON(t *testing.T) {
 				Content: []byte("unfiltered\n"),
 				Fields:  common.MapStr{"stream": "stderr"},
 				Ts:      time.Date(2017, 11, 9, 13, 27, 36, 277747246, time.UTC),
+				Bytes:   158,
 			},
 		},
 		// Filtering stream
@@ -105,6 +109,7 @@ func TestDockerJSON(t *testing.T) {
 				Content: []byte("2017-11-12 23:32:21.212 [ERROR][77] table.go 111: error"),
 				Fields:  common.MapStr{"stream": "stderr"},
 				Ts:      time.Date(2017, 11, 12, 23, 32, 21, 212771448, time.UTC),
+				Bytes:   208,
 			},
 		},
 		// Split lines
@@ -119,6 +124,7 @@ func TestDockerJSON(t *testing.T) {
 				Content: []byte("1:M 09 Nov 13:27:36.276 # User requested shutdown...\n"),
 				Fields:  common.MapStr{"stream": "stdout"},
 				Ts:      time.Date(2017, 11, 9, 13, 27, 36, 277747246, time.UTC),
+				Bytes:   190,
 			},
 		},
 		// Split lines with partial disabled
@@ -133,13 +139,76 @@ func TestDockerJSON(t *testing.T) {
 				Content: []byte("1:M 09 Nov 13:27:36.276 # User requested "),
 				Fields:  common.MapStr{"stream": "stdout"},
 				Ts:      time.Date(2017, 11, 9, 13, 27, 36, 277747246, time.UTC),
+				Bytes:   109,
 			},
 		},
+		// CRI log with tag
+		{
+			input:  [][]byte{[]byte(`2017-09-12T22:32:21.212861448Z stdout F 2017-09-12 22:32:21.212 [INFO][88] table.go 710: Invalidating dataplane cache`)},
+			stream: "all",
+			expectedMessage: reader.Message{
+				Content: []byte("2017-09-12 22:32:21.212 [INFO][88] table.go 710: Invalidating dataplane cache"),
+				Fields:  common.MapStr{"stream": "stdout"},
+				Ts:      time.Date(2017, 9, 12, 22, 32, 21, 212861448, time.UTC),
+				Bytes:   117,
+			},
+		},
+		// CRI split lines
+		{
+			input: [][]byte{
+				[]byte(`2017-10-12T13:32:21.232861448Z stdout P 2017-10-12 13:32:21.212 [INFO][88] `),
+				[]byte(`2017-10-12T13:32:21.232861448Z stdout P table.go 710: `),
+				[]byte(`2017-10-12T13:32:21.232861448Z stdout F Invalidating dataplane cache`),
+			},
+			stream:  "all",
+			partial: true,
+			expectedMessage: reader.Message{
+				Content: []byte("2017-10-12 13:32:21.212 [INFO][88] table.go 710: Invalidating dataplane cache"),
+				Fields:  common.MapStr{"stream": "stdout"},
+				Ts:      time.Date(2017, 10, 12, 13, 32, 21, 232861448, time.UTC),
+				Bytes:   197,
+			},
+		},
+		// CRI split lines with partial disabled
+		{
+			input: [][]byte{
+				[]byte(`2017-10-12T13:32:21.232861448Z stdout P 2017-10-12 13:32:21.212 [INFO][88] `),
+				[]byte(`2017-10-12T13:32:21.232861448Z stdout F table.go 710: Invalidating dataplane cache`),
+			},
+			stream:  "all",
+			partial: false,
+			expectedMessage: reader.Message{
+				Content: []byte("2017-10-12 13:32:21.212 [INFO][88] "),
+				Fields:  common.MapStr{"stream": "stdout"},
+				Ts:      time.Date(2017, 10, 12, 13, 32, 21, 232861448, time.UTC),
+				Bytes:   75,
+			},
+		},
+		// CRI invalid stream
+		{
+			input:         [][]byte{[]byte(`2017-09-12T22:32:21.212861448Z other F message`)},
+			stream:        "all",
+			expectedError: true,
+		},
+		// CRI log with docker format forced
+		{
+			input:         [][]byte{[]byte(`2017-09-12T22:32:21.212861448Z stdout F message`)},
+			stream:        "all",
+			format:        "docker",
+			expectedError: true,
+		},
+		// Docker log with CRI format forced
+		{
+			input:         [][]byte{[]byte(`{"log":"message\n","stream":"stdout","time":"2017-11-09T13:27:36.277747246Z"}`)},
+			stream:        "all",
+			format:        "cri",
+			expectedError: true,
+		},
 	}
 
 	for _, test := range tests {
 		r := &mockReader{messages: test.input}
-		json := New(r, test.stream, test.partial)
+		json := New(r, test.stream, test.partial, test.format)
 		message, err := json.Next()
 
 		assert.Equal(t, test.expectedError, err != nil)
@@ -150,6 +219,65 @@ func TestDockerJSON(t *testing.T) {
 	}
 }
 
+func TestDockerJSONBytes(t *testing.T) {
+	input := [][]byte{
+		[]byte(`2017-10-12T13:32:21.232861448Z stdout F filtered`),
+		[]byte(`2017-10-12T13:32:21.232861448Z stderr P partial `),
+		[]byte(`2017-10-12T13:32:21.232861448Z stderr F line`),
+	}
+	expected := 0
+	for _, line := range input {
+		expected += len(line)
+	}
+
+	r := &mockReader{messages: input}
+	message, err := New(r, "stderr", true, "auto").Next()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "partial line", string(message.Content))
+		assert.Equal(t, expected, message.Bytes)
+	}
+}
+
+func TestDockerJSONPartialStreams(t *testing.T) {
+	tests := map[string]struct {
+		input    [][]byte
+		expected []string
+	}{
+		"docker": {
+			input: [][]byte{
+				[]byte(`{"log":"stdout partial ","stream":"stdout","time":"2017-11-09T13:27:36.277747246Z"}`),
+				[]byte(`{"log":"stderr line\n","stream":"stderr","time":"2017-11-09T13:27:36.277747246Z"}`),
+				[]byte(`{"log":"stdout line\n","stream":"stdout","time":"2017-11-09T13:27:36.277747246Z"}`),
+			},
+			expected: []string{"stdout partial ", "stderr line\n", "stdout line\n"},
+		},
+		"cri": {
+			input: [][]byte{
+				[]byte(`2017-10-12T13:32:21.232861448Z stdout P stdout partial `),
+				[]byte(`2017-10-12T13:32:21.232861448Z stderr F stderr line`),
+				[]byte(`2017-10-12T13:32:21.232861448Z stdout F stdout line`),
+			},
+			expected: []string{"stdout partial ", "stderr line", "stdout line"},
+		},
+	}
+
+	for format, test := range tests {
+		t.Run(format, func(t *testing.T) {
+			r := &mockReader{messages: test.input}
+			json := New(r, "all", true, format)
+
+			// a line of another stream is not joined to a partial line
+			for i, expected := range test.expected {
+				message, err := json.Next()
+				if assert.NoError(t, err) {
+					assert.Equal(t, expected, string(message.Content))
+					assert.Equal(t, len(test.input[i]), message.Bytes)
+				}
+			}
+		})
+	}
+}
+
 type mockReader struct {
 	messages [][]byte
 }
@@ -159,5 +287,6 @@ func (m *mockReader) Next() (reader.Message, error) {
 	m.messages = m.messages[1:]
 	return reader.Message{
 		Content: message,
+		Bytes:   len(message),
 	}, nil
 }
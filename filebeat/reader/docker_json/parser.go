@@ -0,0 +1,76 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package docker_json
+
+import (
+	"fmt"
+
+	"github.com/elastic/beats/filebeat/reader"
+	"github.com/elastic/beats/filebeat/reader/parser"
+	"github.com/elastic/beats/filebeat/reader/strip_newline"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+type config struct {
+	// Stream can be all, stdout or stderr
+	Stream string `config:"stream"`
+
+	// Partial joins partial lines
+	Partial bool `config:"partial"`
+
+	// Format can be auto, docker or cri
+	Format string `config:"format"`
+}
+
+var defaultConfig = config{
+	Stream:  "all",
+	Partial: true,
+	Format:  "auto",
+}
+
+func (c *config) Validate() error {
+	switch c.Stream {
+	case "all", "stdout", "stderr":
+	default:
+		return fmt.Errorf("Invalid value for stream: %s, supported values are: all, stdout, stderr", c.Stream)
+	}
+
+	switch c.Format {
+	case "auto", "docker", "cri":
+	default:
+		return fmt.Errorf("Invalid value for format: %s, supported values are: auto, docker, cri", c.Format)
+	}
+	return nil
+}
+
+func init() {
+	err := parser.Register("docker_json", newParser)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func newParser(r reader.Reader, cfg *common.Config, settings parser.Settings) (reader.Reader, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	// The log lines of containers end with a newline, which is used to detect partial lines
+	return strip_newline.New(New(r, config.Stream, config.Partial, config.Format)), nil
+}
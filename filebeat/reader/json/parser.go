@@ -0,0 +1,42 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package json
+
+import (
+	"github.com/elastic/beats/filebeat/reader"
+	"github.com/elastic/beats/filebeat/reader/parser"
+	"github.com/elastic/beats/filebeat/reader/strip_newline"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	err := parser.Register("json", newParser)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func newParser(r reader.Reader, cfg *common.Config, settings parser.Settings) (reader.Reader, error) {
+	config := Config{}
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	// The decoded message_key can end with a newline
+	return strip_newline.New(New(r, &config)), nil
+}
@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package multiline
+
+import (
+	"github.com/elastic/beats/filebeat/reader"
+)
+
+// readFirstCount starts a new multiline event made of a fixed number of lines.
+func (mlr *Reader) readFirstCount() (reader.Message, error) {
+	message, err := mlr.readFirstLine()
+	if err != nil {
+		return message, err
+	}
+
+	mlr.clear()
+	mlr.load(message)
+	if mlr.totalLines >= mlr.linesCount {
+		return mlr.finalize(), nil
+	}
+
+	mlr.setState((*Reader).readNextCount)
+	return mlr.readNextCount()
+}
+
+func (mlr *Reader) readNextCount() (reader.Message, error) {
+	for {
+		message, err := mlr.reader.Next()
+		if err != nil {
+			msg, done, err := mlr.handleReadError(message, err)
+			if !done {
+				continue
+			}
+			return msg, err
+		}
+
+		mlr.addLine(message)
+		if mlr.totalLines >= mlr.linesCount {
+			msg := mlr.finalize()
+			mlr.resetState()
+			return msg, nil
+		}
+	}
+}
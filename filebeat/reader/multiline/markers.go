@@ -0,0 +1,75 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package multiline
+
+import (
+	"github.com/elastic/beats/filebeat/reader"
+)
+
+// readFirstMarkers starts a new multiline event if the line matches the begin
+// pattern, lines outside of begin and end markers are returned as they are.
+func (mlr *Reader) readFirstMarkers() (reader.Message, error) {
+	message, err := mlr.readFirstLine()
+	if err != nil {
+		return message, err
+	}
+
+	if !mlr.beginMatcher.Match(message.Content) {
+		return message, nil
+	}
+
+	mlr.clear()
+	mlr.load(message)
+	mlr.depth = 1
+	if mlr.endMatcher.Match(message.Content) {
+		return mlr.finalize(), nil
+	}
+
+	mlr.setState((*Reader).readNextMarkers)
+	return mlr.readNextMarkers()
+}
+
+// readNextMarkers collects lines until the end marker matching the first
+// begin marker is found. Begin markers found within the event increase the
+// nesting depth, so the same number of end markers is required.
+func (mlr *Reader) readNextMarkers() (reader.Message, error) {
+	for {
+		message, err := mlr.reader.Next()
+		if err != nil {
+			msg, done, err := mlr.handleReadError(message, err)
+			if !done {
+				continue
+			}
+			return msg, err
+		}
+
+		mlr.addLine(message)
+		if mlr.beginMatcher.Match(message.Content) {
+			mlr.depth++
+		}
+		if mlr.endMatcher.Match(message.Content) {
+			mlr.depth--
+		}
+
+		if mlr.depth == 0 {
+			msg := mlr.finalize()
+			mlr.resetState()
+			return msg, nil
+		}
+	}
+}
@@ -26,12 +26,13 @@ import (
 	"github.com/elastic/beats/filebeat/reader/timeout"
 	"github.com/elastic/beats/libbeat/common/match"
 	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
 )
 
 // MultiLine reader combining multiple line events into one multi-line event.
 //
 // Lines to be combined are matched by some configurable predicate using
-// regular expression.
+// regular expression, by counting lines, or by begin and end markers.
 //
 // The maximum number of bytes and lines to be returned is fully configurable.
 // Even if limits are reached subsequent lines are matched, until event is
@@ -41,14 +42,22 @@ import (
 // multiline event first and finally return the actual error on next call to Next.
 type Reader struct {
 	reader       reader.Reader
+	mode         multilineType
 	pred         matcher
 	flushMatcher *match.Matcher
+	beginMatcher *match.Matcher
+	endMatcher   *match.Matcher
+	linesCount   int
 	maxBytes     int // bytes stored in content
 	maxLines     int
 	separator    []byte
 	last         []byte
-	numLines     int
+	numLines     int   // lines stored in content
+	totalLines   int   // lines read, including the ones dropped because of limits
+	depth        int   // nesting depth of begin and end markers
+	truncated    bool  // content was dropped because of limits
 	err          error // last seen error
+	first        func(*Reader) (reader.Message, error)
 	state        func(*Reader) (reader.Message, error)
 	message      reader.Message
 }
@@ -67,6 +76,14 @@ type matcher func(last, current []byte) bool
 
 var (
 	sigMultilineTimeout = errors.New("multiline timeout")
+
+	multilineMetrics = monitoring.Default.NewRegistry("filebeat.multiline")
+	truncatedEvents  = map[multilineType]*monitoring.Int{
+		patternMode:      monitoring.NewInt(multilineMetrics, "pattern.truncated"),
+		countMode:        monitoring.NewInt(multilineMetrics, "count.truncated"),
+		whilePatternMode: monitoring.NewInt(multilineMetrics, "while_pattern.truncated"),
+		markersMode:      monitoring.NewInt(multilineMetrics, "markers.truncated"),
+	}
 )
 
 // New creates a new multi-line reader combining stream of
@@ -77,30 +94,77 @@ func New(
 	maxBytes int,
 	config *Config,
 ) (*Reader, error) {
-	types := map[string]func(match.Matcher) (matcher, error){
-		"before": beforeMatcher,
-		"after":  afterMatcher,
+	mlr := &Reader{
+		mode:      config.Type,
+		maxBytes:  maxBytes,
+		maxLines:  defaultMaxLines,
+		separator: []byte(separator),
+		message:   reader.Message{},
 	}
 
-	matcherType, ok := types[config.Match]
-	if !ok {
-		return nil, fmt.Errorf("unknown matcher type: %s", config.Match)
-	}
+	switch config.Type {
+	case patternMode:
+		types := map[string]func(match.Matcher) (matcher, error){
+			"before": beforeMatcher,
+			"after":  afterMatcher,
+		}
 
-	matcher, err := matcherType(*config.Pattern)
-	if err != nil {
-		return nil, err
-	}
+		matcherType, ok := types[config.Match]
+		if !ok {
+			return nil, fmt.Errorf("unknown matcher type: %s", config.Match)
+		}
+
+		matcher, err := matcherType(*config.Pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		if config.Negate {
+			matcher = negatedMatcher(matcher)
+		}
+
+		mlr.pred = matcher
+		mlr.flushMatcher = config.FlushPattern
+		mlr.first = (*Reader).readFirst
+	case countMode:
+		if config.LinesCount <= 0 {
+			return nil, fmt.Errorf("count_lines %v must be positive", config.LinesCount)
+		}
+		// All the counted lines are kept unless the user asks for less.
+		if config.LinesCount > mlr.maxLines {
+			mlr.maxLines = config.LinesCount
+		}
+		mlr.linesCount = config.LinesCount
+		mlr.first = (*Reader).readFirstCount
+	case whilePatternMode:
+		matcher, err := afterMatcher(*config.Pattern)
+		if err != nil {
+			return nil, err
+		}
 
-	flushMatcher := config.FlushPattern
+		if config.Negate {
+			matcher = negatedMatcher(matcher)
+		}
 
-	if config.Negate {
-		matcher = negatedMatcher(matcher)
+		mlr.pred = matcher
+		mlr.first = (*Reader).readFirstWhilePattern
+	case markersMode:
+		mlr.beginMatcher = config.BeginPattern
+		mlr.endMatcher = config.EndPattern
+		mlr.first = (*Reader).readFirstMarkers
+	default:
+		return nil, fmt.Errorf("unknown multiline type: %v", config.Type)
 	}
 
-	maxLines := defaultMaxLines
 	if config.MaxLines != nil {
-		maxLines = *config.MaxLines
+		mlr.maxLines = *config.MaxLines
+	}
+
+	if config.MaxBytes != nil {
+		limit := int(*config.MaxBytes)
+		if mlr.maxBytes <= 0 || limit < mlr.maxBytes {
+			mlr.maxBytes = limit
+		}
 	}
 
 	tout := defaultMultilineTimeout
@@ -115,16 +179,8 @@ func New(
 		r = timeout.New(r, sigMultilineTimeout, tout)
 	}
 
-	mlr := &Reader{
-		reader:       r,
-		pred:         matcher,
-		flushMatcher: flushMatcher,
-		state:        (*Reader).readFirst,
-		maxBytes:     maxBytes,
-		maxLines:     maxLines,
-		separator:    []byte(separator),
-		message:      reader.Message{},
-	}
+	mlr.reader = r
+	mlr.state = mlr.first
 	return mlr, nil
 }
 
@@ -240,6 +296,68 @@ func (mlr *Reader) readNext() (reader.Message, error) {
 	}
 }
 
+// readFirstLine returns the next non empty line, a new multiline event
+// can be started with.
+func (mlr *Reader) readFirstLine() (reader.Message, error) {
+	for {
+		message, err := mlr.reader.Next()
+		if err != nil {
+			// no lines buffered -> ignore timeout
+			if err == sigMultilineTimeout {
+				continue
+			}
+
+			// pass error to caller (next layer) for handling
+			return message, err
+		}
+
+		if message.Bytes == 0 {
+			continue
+		}
+
+		return message, nil
+	}
+}
+
+// handleReadError handles an error returned by the underlying reader while
+// lines of a multiline event are collected. If the returned bool is false, the
+// error has been consumed and the caller must continue reading lines.
+func (mlr *Reader) handleReadError(message reader.Message, err error) (reader.Message, bool, error) {
+	if err == sigMultilineTimeout {
+		// no lines buffered -> ignore timeout
+		if mlr.message.Bytes == 0 {
+			return reader.Message{}, false, nil
+		}
+
+		logp.Debug("multiline", "Multiline event flushed because timeout reached.")
+
+		msg := mlr.finalize()
+		mlr.resetState()
+		return msg, true, nil
+	}
+
+	mlr.addLine(message)
+
+	// no lines buffered -> return error
+	if mlr.message.Bytes == 0 {
+		return reader.Message{}, true, err
+	}
+
+	// lines buffered, return multiline and error on next read
+	msg := mlr.finalize()
+	mlr.err = err
+	mlr.setState((*Reader).readFailed)
+	return msg, true, nil
+}
+
+// readPending returns the line which has been loaded after the last
+// multiline event was finalized as a single event.
+func (mlr *Reader) readPending() (reader.Message, error) {
+	msg := mlr.finalize()
+	mlr.resetState()
+	return msg, nil
+}
+
 // readFailed returns empty message and error and resets line reader
 func (mlr *Reader) readFailed() (reader.Message, error) {
 	err := mlr.err
@@ -262,11 +380,18 @@ func (mlr *Reader) clear() {
 	mlr.message = reader.Message{}
 	mlr.last = nil
 	mlr.numLines = 0
+	mlr.totalLines = 0
+	mlr.depth = 0
+	mlr.truncated = false
 	mlr.err = nil
 }
 
 // finalize writes the existing content into the returned message and resets all reader variables.
 func (mlr *Reader) finalize() reader.Message {
+	if mlr.truncated {
+		truncatedEvents[mlr.mode].Inc()
+	}
+
 	// Copy message from existing content
 	msg := mlr.message
 	mlr.clear()
@@ -303,16 +428,23 @@ func (mlr *Reader) addLine(m reader.Message) {
 		}
 		mlr.message.Content = append(tmp, m.Content[:space]...)
 		mlr.numLines++
+
+		if space < len(m.Content) {
+			mlr.truncated = true
+		}
+	} else {
+		mlr.truncated = true
 	}
 
+	mlr.totalLines++
 	mlr.last = m.Content
 	mlr.message.Bytes += m.Bytes
 	mlr.message.AddFields(m.Fields)
 }
 
-// resetState sets state of the reader to readFirst
+// resetState sets state of the reader to the first state of the selected mode
 func (mlr *Reader) resetState() {
-	mlr.setState((*Reader).readFirst)
+	mlr.setState(mlr.first)
 }
 
 // setState sets state to the given function
@@ -21,23 +21,82 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/elastic/beats/libbeat/common/cfgtype"
 	"github.com/elastic/beats/libbeat/common/match"
 )
 
+type multilineType uint8
+
+const (
+	patternMode multilineType = iota
+	countMode
+	whilePatternMode
+	markersMode
+)
+
+var multilineTypes = map[string]multilineType{
+	"pattern":       patternMode,
+	"count":         countMode,
+	"while_pattern": whilePatternMode,
+	"markers":       markersMode,
+}
+
 // Config holds the options of multiline readers.
 type Config struct {
-	Negate       bool           `config:"negate"`
-	Match        string         `config:"match" validate:"required"`
-	MaxLines     *int           `config:"max_lines"`
-	Pattern      *match.Matcher `config:"pattern" validate:"required"`
-	Timeout      *time.Duration `config:"timeout" validate:"positive"`
-	FlushPattern *match.Matcher `config:"flush_pattern"`
+	Type         multilineType     `config:"type"`
+	Negate       bool              `config:"negate"`
+	Match        string            `config:"match"`
+	MaxLines     *int              `config:"max_lines"`
+	MaxBytes     *cfgtype.ByteSize `config:"max_bytes"`
+	Pattern      *match.Matcher    `config:"pattern"`
+	Timeout      *time.Duration    `config:"timeout" validate:"positive"`
+	FlushPattern *match.Matcher    `config:"flush_pattern"`
+	LinesCount   int               `config:"count_lines" validate:"positive"`
+	BeginPattern *match.Matcher    `config:"begin_pattern"`
+	EndPattern   *match.Matcher    `config:"end_pattern"`
 }
 
 // Validate validates the Config option for multiline reader.
 func (c *Config) Validate() error {
-	if c.Match != "after" && c.Match != "before" {
-		return fmt.Errorf("unknown matcher type: %s", c.Match)
+	switch c.Type {
+	case patternMode:
+		if c.Match != "after" && c.Match != "before" {
+			return fmt.Errorf("unknown matcher type: %s", c.Match)
+		}
+		if c.Pattern == nil {
+			return fmt.Errorf("multiline.pattern cannot be empty when pattern based matching is selected")
+		}
+	case countMode:
+		if c.LinesCount == 0 {
+			return fmt.Errorf("multiline.count_lines cannot be zero when count based is selected")
+		}
+	case whilePatternMode:
+		if c.Pattern == nil {
+			return fmt.Errorf("multiline.pattern cannot be empty when while_pattern based matching is selected")
+		}
+	case markersMode:
+		if c.BeginPattern == nil || c.EndPattern == nil {
+			return fmt.Errorf("multiline.begin_pattern and multiline.end_pattern are required when markers based matching is selected")
+		}
+	}
+	return nil
+}
+
+// Unpack selects the appropriate aggregation method for creating multiline events.
+func (m *multilineType) Unpack(value string) error {
+	mType, ok := multilineTypes[value]
+	if !ok {
+		return fmt.Errorf("unknown multiline type: %s", value)
 	}
+	*m = mType
 	return nil
 }
+
+func (m multilineType) String() string {
+	for name, t := range multilineTypes {
+		if t == m {
+			return name
+		}
+	}
+	return "unknown"
+}
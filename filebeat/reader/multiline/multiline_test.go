@@ -22,6 +22,7 @@ package multiline
 import (
 	"bytes"
 	"errors"
+	"io"
 	"os"
 	"strings"
 	"testing"
@@ -33,6 +34,8 @@ import (
 	"github.com/elastic/beats/filebeat/reader/encode"
 	"github.com/elastic/beats/filebeat/reader/encode/encoding"
 	"github.com/elastic/beats/filebeat/reader/strip_newline"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgtype"
 	"github.com/elastic/beats/libbeat/common/match"
 )
 
@@ -151,6 +154,184 @@ func TestMultilineBeforeNegateOKWithEmptyLine(t *testing.T) {
 	)
 }
 
+func TestMultilineCountOK(t *testing.T) {
+	testMultilineOK(t,
+		Config{
+			Type:       countMode,
+			LinesCount: 3,
+		},
+		2,
+		"line1\n line1.1\n line1.2\n",
+		"line2\n line2.1\n line2.2\n",
+	)
+}
+
+func TestMultilineWhilePatternOK(t *testing.T) {
+	pattern := match.MustCompile(`^[ \t]+`) // lines indented by spaces
+	testMultilineOK(t,
+		Config{
+			Type:    whilePatternMode,
+			Pattern: &pattern,
+		},
+		4,
+		"line1\n",
+		"  line1.1\n  line1.2\n",
+		"line2\n",
+		"  line2.1\n",
+	)
+}
+
+func TestMultilineWhilePatternNegateOK(t *testing.T) {
+	pattern := match.MustCompile(`^--`) // separator lines
+	testMultilineOK(t,
+		Config{
+			Type:    whilePatternMode,
+			Pattern: &pattern,
+			Negate:  true,
+		},
+		4,
+		"line1\nline1.1\n",
+		"--\n",
+		"--\n",
+		"line2\nline2.1\nline2.2\n",
+	)
+}
+
+type messagesReader struct {
+	messages []reader.Message
+	errs     []error
+}
+
+func (r *messagesReader) Next() (reader.Message, error) {
+	if len(r.messages) == 0 {
+		return reader.Message{}, io.EOF
+	}
+	msg, err := r.messages[0], r.errs[0]
+	r.messages, r.errs = r.messages[1:], r.errs[1:]
+	return msg, err
+}
+
+func TestMultilineWhilePatternReadErrorWithLine(t *testing.T) {
+	pattern := match.MustCompile(`^[ \t]+`) // lines indented by spaces
+	line := func(content string) reader.Message {
+		return reader.Message{Ts: time.Now(), Content: []byte(content), Bytes: len(content) + 1}
+	}
+
+	for name, test := range map[string]struct {
+		last     string
+		expected []string
+	}{
+		"matching":     {last: "  line1.3", expected: []string{"  line1.1\n  line1.2\n  line1.3"}},
+		"not matching": {last: "line2", expected: []string{"  line1.1\n  line1.2", "line2"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			in := &messagesReader{
+				messages: []reader.Message{line("  line1.1"), line("  line1.2"), line(test.last)},
+				errs:     []error{nil, nil, io.ErrUnexpectedEOF},
+			}
+			r, err := New(in, "\n", 1<<20, &Config{Type: whilePatternMode, Pattern: &pattern})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, expected := range test.expected {
+				message, err := r.Next()
+				if assert.NoError(t, err) {
+					assert.Equal(t, expected, string(message.Content))
+				}
+			}
+
+			_, err = r.Next()
+			assert.Equal(t, io.ErrUnexpectedEOF, err)
+		})
+	}
+}
+
+func TestMultilineMarkersOK(t *testing.T) {
+	begin := match.MustCompile(`^BEGIN`)
+	end := match.MustCompile(`^END`)
+	testMultilineOK(t,
+		Config{
+			Type:         markersMode,
+			BeginPattern: &begin,
+			EndPattern:   &end,
+		},
+		4,
+		"BEGIN\nSELECT 1;\nEND\n",
+		"outside\n",
+		"BEGIN\nBEGIN\nSELECT 2;\nEND\nSELECT 3;\nEND\n",
+		"BEGIN END\n",
+	)
+}
+
+func TestMultilineTruncatedMetrics(t *testing.T) {
+	maxLines := 2
+	cfg := Config{
+		Type:       countMode,
+		LinesCount: 3,
+		MaxLines:   &maxLines,
+	}
+	before := truncatedEvents[countMode].Get()
+
+	_, buf := createLineBuffer("line1\nline1.1\nline1.2\n", "line2\nline2.1\n")
+	r := createMultilineTestReader(t, buf, cfg)
+
+	message, err := r.Next()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "line1\nline1.1", string(message.Content))
+	assert.Equal(t, len("line1\nline1.1\nline1.2\n"), message.Bytes)
+	assert.Equal(t, before+1, truncatedEvents[countMode].Get())
+
+	// the last event is flushed on EOF without being truncated
+	message, err = r.Next()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "line2\nline2.1", string(message.Content))
+	assert.Equal(t, before+1, truncatedEvents[countMode].Get())
+}
+
+func TestMultilineMaxBytes(t *testing.T) {
+	maxBytes := cfgtype.ByteSize(8)
+	cfg := Config{
+		Type:       countMode,
+		LinesCount: 2,
+		MaxBytes:   &maxBytes,
+	}
+
+	_, buf := createLineBuffer("line1\nline1.1\n")
+	r := createMultilineTestReader(t, buf, cfg)
+
+	message, err := r.Next()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "line1\nli", string(message.Content))
+}
+
+func TestMultilineConfigValidation(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"PatternWithoutMatch":         {"pattern": "^a"},
+		"PatternWithoutPattern":       {"match": "after"},
+		"UnknownType":                 {"type": "unknown"},
+		"CountWithoutLines":           {"type": "count"},
+		"WhilePatternWithoutPattern":  {"type": "while_pattern"},
+		"MarkersWithoutEndPattern":    {"type": "markers", "begin_pattern": "^BEGIN"},
+		"MarkersWithoutBeginPattern":  {"type": "markers", "end_pattern": "^END"},
+		"CountWithNegativeCountLines": {"type": "count", "count_lines": -1},
+	}
+
+	for name, cfg := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, _ := common.NewConfigFrom(cfg)
+			var config Config
+			assert.Error(t, c.Unpack(&config))
+		})
+	}
+}
+
 func testMultilineOK(t *testing.T, cfg Config, events int, expected ...string) {
 	_, buf := createLineBuffer(expected...)
 	r := createMultilineTestReader(t, buf, cfg)
@@ -0,0 +1,40 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package multiline
+
+import (
+	"github.com/elastic/beats/filebeat/reader"
+	"github.com/elastic/beats/filebeat/reader/parser"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	err := parser.Register("multiline", newParser)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func newParser(r reader.Reader, cfg *common.Config, settings parser.Settings) (reader.Reader, error) {
+	config := Config{}
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	return New(r, settings.LineSeparator, settings.MaxBytes, &config)
+}
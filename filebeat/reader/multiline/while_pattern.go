@@ -0,0 +1,84 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package multiline
+
+import (
+	"github.com/elastic/beats/filebeat/reader"
+)
+
+// readFirstWhilePattern starts a new multiline event if the line matches the
+// pattern, lines not matching are returned as they are.
+func (mlr *Reader) readFirstWhilePattern() (reader.Message, error) {
+	message, err := mlr.readFirstLine()
+	if err != nil {
+		return message, err
+	}
+
+	if !mlr.pred(nil, message.Content) {
+		return message, nil
+	}
+
+	mlr.clear()
+	mlr.load(message)
+	mlr.setState((*Reader).readNextWhilePattern)
+	return mlr.readNextWhilePattern()
+}
+
+func (mlr *Reader) readNextWhilePattern() (reader.Message, error) {
+	for {
+		message, err := mlr.reader.Next()
+		if err != nil {
+			// a line not matching that is read together with the error is
+			// returned on its own before the error
+			if err != sigMultilineTimeout && message.Bytes > 0 && !mlr.pred(mlr.last, message.Content) {
+				msg := mlr.finalize()
+				mlr.load(message)
+				mlr.err = err
+				mlr.setState((*Reader).readPendingFailed)
+				return msg, nil
+			}
+
+			msg, done, err := mlr.handleReadError(message, err)
+			if !done {
+				continue
+			}
+			return msg, err
+		}
+
+		// the first line not matching ends the multiline event and is
+		// returned on its own on next call
+		if !mlr.pred(mlr.last, message.Content) {
+			msg := mlr.finalize()
+			mlr.load(message)
+			mlr.setState((*Reader).readPending)
+			return msg, nil
+		}
+
+		mlr.addLine(message)
+	}
+}
+
+// readPendingFailed returns the line which has been loaded after the last
+// multiline event was finalized, and the read error on next call.
+func (mlr *Reader) readPendingFailed() (reader.Message, error) {
+	err := mlr.err
+	msg := mlr.finalize()
+	mlr.err = err
+	mlr.setState((*Reader).readFailed)
+	return msg, nil
+}
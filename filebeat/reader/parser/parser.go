@@ -0,0 +1,113 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package parser builds configurable pipelines of readers.
+//
+// Readers processing the lines read by an input, like JSON decoding or
+// multiline aggregation, register a factory under a name. Inputs can then
+// chain them in any order, and more than once, through a list of parsers:
+//
+//  parsers:
+//    - docker_json:
+//        stream: stdout
+//    - json:
+//        message_key: log
+//    - multiline:
+//        pattern: '^\['
+//        negate: true
+//        match: after
+//
+// The lines given to the first parser have their line endings removed, all
+// parsers must return messages without line endings as well.
+package parser
+
+import (
+	"fmt"
+
+	"github.com/elastic/beats/filebeat/reader"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// Settings are the options of the input which are shared by all parsers.
+type Settings struct {
+	// MaxBytes is the maximum number of bytes of a message.
+	MaxBytes int
+	// LineSeparator is the separator used to join lines.
+	LineSeparator string
+}
+
+// Factory is used to register functions creating new parsers reading from the given reader.
+type Factory = func(r reader.Reader, config *common.Config, settings Settings) (reader.Reader, error)
+
+var registry = make(map[string]Factory)
+
+// Register registers a parser factory under the given name.
+func Register(name string, factory Factory) error {
+	if name == "" {
+		return fmt.Errorf("Error registering parser: name cannot be empty")
+	}
+	if factory == nil {
+		return fmt.Errorf("Error registering parser '%v': factory cannot be empty", name)
+	}
+	if _, exists := registry[name]; exists {
+		return fmt.Errorf("Error registering parser '%v': already registered", name)
+	}
+
+	registry[name] = factory
+	return nil
+}
+
+// GetFactory returns the factory registered under the given name.
+func GetFactory(name string) (Factory, error) {
+	if _, exists := registry[name]; !exists {
+		return nil, fmt.Errorf("Error creating parser. No such parser type exist: '%v'", name)
+	}
+	return registry[name], nil
+}
+
+// New chains the configured parsers, in order, to the given reader.
+func New(r reader.Reader, parsers []common.ConfigNamespace, settings Settings) (reader.Reader, error) {
+	for _, p := range parsers {
+		if !p.IsSet() {
+			continue
+		}
+
+		factory, err := GetFactory(p.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		r, err = factory(r, p.Config(), settings)
+		if err != nil {
+			return nil, fmt.Errorf("Error creating parser '%v': %v", p.Name(), err)
+		}
+	}
+	return r, nil
+}
+
+// NewConfig creates the configuration of a single parser. It can be used to
+// derive the parsers from settings which are not set under parsers.
+func NewConfig(name string, config *common.Config) (common.ConfigNamespace, error) {
+	var ns common.ConfigNamespace
+
+	cfg := common.NewConfig()
+	if err := cfg.SetChild(name, -1, config); err != nil {
+		return ns, err
+	}
+	err := ns.Unpack(cfg)
+	return ns, err
+}
@@ -0,0 +1,179 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package parser
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/filebeat/reader"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+type linesReader struct {
+	lines []string
+}
+
+func (r *linesReader) Next() (reader.Message, error) {
+	if len(r.lines) == 0 {
+		return reader.Message{}, io.EOF
+	}
+	line := r.lines[0]
+	r.lines = r.lines[1:]
+	return reader.Message{Content: []byte(line), Bytes: len(line) + 1}, nil
+}
+
+type suffixReader struct {
+	reader reader.Reader
+	suffix []byte
+}
+
+func (r *suffixReader) Next() (reader.Message, error) {
+	message, err := r.reader.Next()
+	if err != nil {
+		return message, err
+	}
+	message.Content = append(message.Content, r.suffix...)
+	return message, nil
+}
+
+type upperReader struct {
+	reader reader.Reader
+}
+
+func (r *upperReader) Next() (reader.Message, error) {
+	message, err := r.reader.Next()
+	message.Content = bytes.ToUpper(message.Content)
+	return message, err
+}
+
+func init() {
+	Register("test_suffix", func(r reader.Reader, cfg *common.Config, _ Settings) (reader.Reader, error) {
+		config := struct {
+			Suffix string `config:"suffix" validate:"required"`
+		}{}
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+		return &suffixReader{reader: r, suffix: []byte(config.Suffix)}, nil
+	})
+	Register("test_upper", func(r reader.Reader, _ *common.Config, _ Settings) (reader.Reader, error) {
+		return &upperReader{reader: r}, nil
+	})
+}
+
+func TestRegister(t *testing.T) {
+	factory := func(r reader.Reader, _ *common.Config, _ Settings) (reader.Reader, error) { return r, nil }
+
+	assert.Error(t, Register("", factory))
+	assert.Error(t, Register("test_nil", nil))
+	assert.Error(t, Register("test_upper", factory))
+}
+
+func TestParsersOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		parsers  []map[string]interface{}
+		expected []string
+	}{
+		{
+			name:     "NoParsers",
+			parsers:  []map[string]interface{}{},
+			expected: []string{"a", "b"},
+		},
+		{
+			name: "UpperThenSuffix",
+			parsers: []map[string]interface{}{
+				{"test_upper": map[string]interface{}{}},
+				{"test_suffix": map[string]interface{}{"suffix": "-x"}},
+			},
+			expected: []string{"A-x", "B-x"},
+		},
+		{
+			name: "SuffixThenUpper",
+			parsers: []map[string]interface{}{
+				{"test_suffix": map[string]interface{}{"suffix": "-x"}},
+				{"test_upper": map[string]interface{}{}},
+			},
+			expected: []string{"A-X", "B-X"},
+		},
+		{
+			name: "Repeated",
+			parsers: []map[string]interface{}{
+				{"test_suffix": map[string]interface{}{"suffix": "-x"}},
+				{"test_suffix": map[string]interface{}{"suffix": "-y"}},
+			},
+			expected: []string{"a-x-y", "b-x-y"},
+		},
+		{
+			name: "Disabled",
+			parsers: []map[string]interface{}{
+				{"test_upper": map[string]interface{}{"enabled": false}},
+			},
+			expected: []string{"a", "b"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := common.MustNewConfigFrom(map[string]interface{}{"parsers": test.parsers})
+			config := struct {
+				Parsers []common.ConfigNamespace `config:"parsers"`
+			}{}
+			if !assert.NoError(t, cfg.Unpack(&config)) {
+				return
+			}
+
+			r, err := New(&linesReader{lines: []string{"a", "b"}}, config.Parsers, Settings{})
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			var actual []string
+			for {
+				message, err := r.Next()
+				if err != nil {
+					break
+				}
+				actual = append(actual, string(message.Content))
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParserErrors(t *testing.T) {
+	unknown, err := NewConfig("unknown", common.NewConfig())
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = New(&linesReader{}, []common.ConfigNamespace{unknown}, Settings{})
+	assert.Error(t, err)
+
+	invalid, err := NewConfig("test_suffix", common.NewConfig())
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = New(&linesReader{}, []common.ConfigNamespace{invalid}, Settings{})
+	assert.Error(t, err)
+}
@@ -202,6 +202,8 @@ func fixState(st *file.State) {
 // The st state is overwritten with the updated fields.
 func mergeStates(st, other *file.State) {
 	st.Finished = st.Finished || other.Finished
+	st.Compressed = st.Compressed || other.Compressed
+	st.Completed = st.Completed || other.Completed
 	if st.Offset < other.Offset { // always select the higher offset
 		st.Offset = other.Offset
 	}
@@ -214,6 +216,8 @@ func mergeStates(st, other *file.State) {
 		st.Timestamp = other.Timestamp
 		st.TTL = other.TTL
 		st.FileStateOS = other.FileStateOS
+		st.IdentifierName = other.IdentifierName
+		st.Fingerprint = other.Fingerprint
 
 		metaOld, metaNew = st.Meta, other.Meta
 	} else {
@@ -8,6 +8,7 @@ import subprocess
 from elasticsearch import Elasticsearch
 import json
 import logging
+import yaml
 from parameterized import parameterized
 
 
@@ -89,6 +90,14 @@ class Test(BaseTest):
             test_file=test_file,
             cfgfile=cfgfile)
 
+    def fileset_vars(self, module, fileset):
+        """
+        Returns the names of the variables declared in the manifest of the fileset.
+        """
+        manifest = os.path.join(self.modules_path, module, fileset, "manifest.yml")
+        with open(manifest) as f:
+            return [var["name"] for var in yaml.safe_load(f).get("var", [])]
+
     def run_on_file(self, module, fileset, test_file, cfgfile):
         print("Testing {}/{} on {}".format(module, fileset, test_file))
 
@@ -111,6 +120,11 @@ class Test(BaseTest):
             "-M", "*.*.input.close_eof=true",
         ]
 
+        # Filesets with a configurable input read the test file with the log input
+        if "input" in self.fileset_vars(module, fileset):
+            cmd += ["-M", "{module}.{fileset}.var.input=file".format(
+                module=module, fileset=fileset)]
+
         output_path = os.path.join(self.working_dir)
         output = open(os.path.join(output_path, "output.log"), "ab")
         output.write(" ".join(cmd) + "\n")
@@ -154,6 +154,53 @@ func IsNoOp(hints common.MapStr, key string) bool {
 	return false
 }
 
+// IsHintGroup returns true if the given hint key identifies a numbered group of hints, like the 1 in
+// co.elastic.logs.1/multiline.pattern. Each group can be used by the builders to generate a separate config.
+func IsHintGroup(key string) bool {
+	_, err := strconv.ParseUint(key, 10, 32)
+	return err == nil
+}
+
+// GetHintGroups returns the numbered groups of hints of the given key, sorted by their number. Hints
+// outside of the groups are applied to every group, unless the group overrides them. If no groups
+// are defined, a single group with all the hints is returned. Each group is returned in the same
+// format as hints so it can be used with the other helpers.
+func GetHintGroups(hints common.MapStr, key string) []common.MapStr {
+	var base common.MapStr
+	if iface, err := hints.GetValue(key); err == nil {
+		base, _ = iface.(common.MapStr)
+	}
+
+	shared := common.MapStr{}
+	var nums []int
+	for k, v := range base {
+		if !IsHintGroup(k) {
+			shared[k] = v
+			continue
+		}
+		if _, ok := v.(common.MapStr); ok {
+			n, _ := strconv.Atoi(k)
+			nums = append(nums, n)
+		}
+	}
+
+	if len(nums) == 0 {
+		return []common.MapStr{hints}
+	}
+
+	sort.Ints(nums)
+
+	groups := make([]common.MapStr, 0, len(nums))
+	for _, n := range nums {
+		group := shared.Clone()
+		for k, v := range base[strconv.Itoa(n)].(common.MapStr) {
+			group[k] = v
+		}
+		groups = append(groups, common.MapStr{key: group})
+	}
+	return groups
+}
+
 // GenerateHints parses annotations based on a prefix and sets up hints that can be picked up by individual Beats.
 func GenerateHints(annotations common.MapStr, container, prefix string) common.MapStr {
 	hints := common.MapStr{}
@@ -170,25 +217,52 @@ func GenerateHints(annotations common.MapStr, container, prefix string) common.M
 					if _, err := hints.GetValue(hintKey); err != nil {
 						hints.Put(hintKey, rawValue)
 					}
-				} else if container != "" {
-					// Only consider annotations that are of type common.MapStr as we are looking for
-					// container level nesting
-					builderHints, ok := rawValue.(common.MapStr)
-					if !ok {
+					continue
+				}
+
+				// Only consider annotations that are of type common.MapStr as we are looking for
+				// group or container level nesting
+				builderHints, ok := rawValue.(common.MapStr)
+				if !ok {
+					continue
+				}
+
+				for hintKey, rawVal := range builderHints {
+					parts := strings.Split(hintKey, "/")
+
+					// Numbered groups like co.elastic.logs.1/ apply to all containers, container
+					// level groups take higher priority.
+					if len(parts) == 2 && IsHintGroup(parts[0]) {
+						hintKey := fmt.Sprintf("%s.%s.%s", key, parts[0], parts[1])
+						if _, err := hints.GetValue(hintKey); err != nil {
+							hints.Put(hintKey, rawVal)
+						}
 						continue
 					}
 
-					// Check for <containerName>/ prefix
-					for hintKey, rawVal := range builderHints {
-						if strings.HasPrefix(hintKey, container) {
-							// Split the key to get part[1] to be the hint
-							parts := strings.Split(hintKey, "/")
-							if len(parts) == 2 {
-								// key will be the hint type
-								hintKey := fmt.Sprintf("%s.%s", key, parts[1])
-								hints.Put(hintKey, rawVal)
+					if container == "" {
+						continue
+					}
+
+					// Check for <containerName>.<group>/ prefix
+					if groupHints, ok := rawVal.(common.MapStr); ok && hintKey == container {
+						for groupKey, groupVal := range groupHints {
+							parts := strings.Split(groupKey, "/")
+							if len(parts) == 2 && IsHintGroup(parts[0]) {
+								hints.Put(fmt.Sprintf("%s.%s.%s", key, parts[0], parts[1]), groupVal)
 							}
 						}
+						continue
+					}
+
+					// Check for <containerName>/ prefix
+					if strings.HasPrefix(hintKey, container) {
+						// Split the key to get part[1] to be the hint
+						if len(parts) == 2 {
+							// key will be the hint type
+							hintKey := fmt.Sprintf("%s.%s", key, parts[1])
+							hints.Put(hintKey, rawVal)
+						}
 					}
 				}
 			}
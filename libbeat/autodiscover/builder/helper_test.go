@@ -62,6 +62,34 @@ func TestGenerateHints(t *testing.T) {
 				},
 			},
 		},
+
+		// Scenarios being tested:
+		// logs.1/stream must be a numbered group under hints.logs
+		// logs.foobar.1/stream is a container level group and must override logs.1/stream
+		// logs.foobar.2/stream must be added as a group
+		// logs.other.1/stream is for a different container and must not be part of hints
+		{
+			annotations: map[string]string{
+				"co.elastic.logs/exclude_lines":     "^DBG",
+				"co.elastic.logs.1/stream":          "stdout",
+				"co.elastic.logs.1/include_lines":   "^INFO",
+				"co.elastic.logs.foobar.1/stream":   "stderr",
+				"co.elastic.logs.foobar.2/stream":   "stdout",
+				"co.elastic.logs.other.1/multiline": "^test",
+			},
+			result: common.MapStr{
+				"logs": common.MapStr{
+					"exclude_lines": "^DBG",
+					"1": common.MapStr{
+						"stream":        "stderr",
+						"include_lines": "^INFO",
+					},
+					"2": common.MapStr{
+						"stream": "stdout",
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -72,3 +100,68 @@ func TestGenerateHints(t *testing.T) {
 		assert.Equal(t, GenerateHints(annMap, "foobar", "co.elastic"), test.result)
 	}
 }
+
+func TestGetHintGroups(t *testing.T) {
+	tests := []struct {
+		msg    string
+		hints  common.MapStr
+		result []common.MapStr
+	}{
+		{
+			msg:    "No hints must return a single group",
+			hints:  common.MapStr{},
+			result: []common.MapStr{{}},
+		},
+		{
+			msg: "Hints without groups must return a single group with all the hints",
+			hints: common.MapStr{
+				"logs": common.MapStr{
+					"include_lines": "^test",
+				},
+			},
+			result: []common.MapStr{
+				{
+					"logs": common.MapStr{
+						"include_lines": "^test",
+					},
+				},
+			},
+		},
+		{
+			msg: "Groups must be sorted and inherit hints outside of the groups",
+			hints: common.MapStr{
+				"logs": common.MapStr{
+					"include_lines": "^test",
+					"exclude_lines": "^DBG",
+					"10": common.MapStr{
+						"stream": "stderr",
+					},
+					"2": common.MapStr{
+						"stream":        "stdout",
+						"include_lines": "^INFO",
+					},
+				},
+			},
+			result: []common.MapStr{
+				{
+					"logs": common.MapStr{
+						"include_lines": "^INFO",
+						"exclude_lines": "^DBG",
+						"stream":        "stdout",
+					},
+				},
+				{
+					"logs": common.MapStr{
+						"include_lines": "^test",
+						"exclude_lines": "^DBG",
+						"stream":        "stderr",
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.result, GetHintGroups(test.hints, "logs"), test.msg)
+	}
+}
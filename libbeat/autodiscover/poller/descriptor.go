@@ -0,0 +1,128 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package poller
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+)
+
+// Descriptor describes a service with a YAML or JSON document
+type Descriptor struct {
+	Name   string        `config:"name"`
+	Host   string        `config:"host"`
+	Port   int           `config:"port"`
+	Labels common.MapStr `config:"labels"`
+	Meta   common.MapStr `config:"meta"`
+}
+
+// Validate checks that the service has a host
+func (d *Descriptor) Validate() error {
+	if d.Host == "" {
+		return errors.New("service has no host")
+	}
+	return nil
+}
+
+// ReadDescriptor reads a service descriptor from YAML or JSON content
+func ReadDescriptor(content []byte, source string) (*Descriptor, error) {
+	cfg, err := common.NewConfigWithYAML(content, source)
+	if err != nil {
+		return nil, err
+	}
+
+	var d Descriptor
+	if err := cfg.Unpack(&d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// ReadDescriptors reads a service descriptor, or a list of service descriptors,
+// from YAML or JSON content
+func ReadDescriptors(content []byte, source string) ([]Descriptor, error) {
+	cfg, err := common.NewConfigWithYAML(content, source)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cfg.IsArray() {
+		var d Descriptor
+		if err := cfg.Unpack(&d); err != nil {
+			return nil, err
+		}
+		return []Descriptor{d}, nil
+	}
+
+	var descriptors []Descriptor
+	if err := cfg.Unpack(&descriptors); err != nil {
+		return nil, err
+	}
+	return descriptors, nil
+}
+
+// Event returns the event of the described service, the labels are converted
+// to strings, as they are used to generate hints
+func (d *Descriptor) Event() bus.Event {
+	meta := common.MapStr{
+		"name": d.Name,
+	}
+	if labels := stringifyLabels(d.Labels); len(labels) > 0 {
+		meta["labels"] = labels
+	}
+	if len(d.Meta) > 0 {
+		meta["meta"] = d.Meta
+	}
+
+	event := bus.Event{
+		"host":    d.Host,
+		"service": meta,
+		"meta": common.MapStr{
+			"service": meta,
+		},
+	}
+	if d.Port != 0 {
+		event["port"] = d.Port
+	}
+	return event
+}
+
+// DescriptorLabels returns the labels of an event of a described service
+func DescriptorLabels(event bus.Event) common.MapStr {
+	labels, _ := common.MapStr(event).GetValue("service.labels")
+	result, _ := labels.(common.MapStr)
+	return result
+}
+
+func stringifyLabels(labels common.MapStr) common.MapStr {
+	result := common.MapStr{}
+	for k, v := range labels {
+		switch v := v.(type) {
+		case common.MapStr:
+			result[k] = stringifyLabels(v)
+		case map[string]interface{}:
+			result[k] = stringifyLabels(common.MapStr(v))
+		default:
+			result[k] = fmt.Sprint(v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,243 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package poller
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/autodiscover"
+	"github.com/elastic/beats/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/libbeat/autodiscover/template"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// Config contains the settings shared by the providers polling their services
+type Config struct {
+	// Time between queries of the services
+	Period time.Duration `config:"period" validate:"positive,nonzero"`
+
+	Prefix       string                  `config:"prefix"`
+	HintsEnabled bool                    `config:"hints.enabled"`
+	Builders     []*common.Config        `config:"builders"`
+	Appenders    []*common.Config        `config:"appenders"`
+	Templates    template.MapperSettings `config:"templates"`
+}
+
+// DefaultConfig returns the default settings of the providers polling their services
+func DefaultConfig() Config {
+	return Config{
+		Period: 10 * time.Second,
+		Prefix: "co.elastic",
+	}
+}
+
+// Validate checks the prefix of the hints and removes its trailing dot
+func (c *Config) Validate() error {
+	if c.Prefix == "" {
+		return errors.New("prefix cannot be empty")
+	}
+	if c.Prefix != "." {
+		c.Prefix = strings.TrimSuffix(c.Prefix, ".")
+	}
+	return nil
+}
+
+// Discoverer queries the services of a provider
+type Discoverer interface {
+	// Discover returns the events of the current services by their key
+	Discover() (map[string]bus.Event, error)
+
+	// Labels returns the labels of an event used to generate hints
+	Labels(event bus.Event) common.MapStr
+}
+
+// Comparer can be implemented by discoverers whose events contain values that
+// change without requiring to restart the service
+type Comparer interface {
+	// Equal returns true if the events describe the same service
+	Equal(a, b bus.Event) bool
+}
+
+// Poller queries the services of a discoverer periodically, and publishes start
+// and stop events when services appear, change or disappear
+type Poller struct {
+	name       string
+	config     *Config
+	bus        bus.Bus
+	discoverer Discoverer
+	builders   autodiscover.Builders
+	appenders  autodiscover.Appenders
+	templates  *template.Mapper
+	services   map[string]bus.Event
+	stop       chan interface{}
+	done       chan interface{}
+}
+
+// New creates a poller publishing the events of the services of the discoverer to the bus
+func New(name string, b bus.Bus, config *Config, discoverer Discoverer) (*Poller, error) {
+	mapper, err := template.NewConfigMapper(config.Templates)
+	if err != nil {
+		return nil, err
+	}
+
+	builders, err := autodiscover.NewBuilders(config.Builders, config.HintsEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	appenders, err := autodiscover.NewAppenders(config.Appenders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Poller{
+		name:       name,
+		config:     config,
+		bus:        b,
+		discoverer: discoverer,
+		builders:   builders,
+		appenders:  appenders,
+		templates:  mapper,
+		services:   map[string]bus.Event{},
+		stop:       make(chan interface{}),
+		done:       make(chan interface{}),
+	}, nil
+}
+
+// Start queries the services every period until the poller is stopped
+func (p *Poller) Start() {
+	go func() {
+		defer close(p.done)
+
+		ticker := time.NewTicker(p.config.Period)
+		defer ticker.Stop()
+
+		p.Scan()
+		for {
+			select {
+			case <-p.stop:
+				return
+			case <-ticker.C:
+				p.Scan()
+			}
+		}
+	}()
+}
+
+// Scan queries the services once and publishes the events of the services
+// that appeared, changed or disappeared since the previous scan
+func (p *Poller) Scan() {
+	services, err := p.discoverer.Discover()
+	if err != nil {
+		// Keep the known services, the source can be temporarily unavailable
+		logp.Err("Error discovering %s services: %v", p.name, err)
+		return
+	}
+
+	// Services are stopped before starting their new versions, keys are sorted
+	// to emit events in a predictable order
+	for _, key := range sortedKeys(p.services) {
+		event := p.services[key]
+		if current, found := services[key]; !found || !p.equal(current, event) {
+			p.emit(event, "stop")
+			delete(p.services, key)
+		}
+	}
+
+	for _, key := range sortedKeys(services) {
+		if _, found := p.services[key]; !found {
+			event := services[key]
+			p.services[key] = event
+			p.emit(event, "start")
+		}
+	}
+}
+
+func (p *Poller) equal(a, b bus.Event) bool {
+	if comparer, ok := p.discoverer.(Comparer); ok {
+		return comparer.Equal(a, b)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func (p *Poller) emit(service bus.Event, flag string) {
+	event := bus.Event{flag: true}
+	for k, v := range service {
+		event[k] = v
+	}
+	p.publish(event)
+}
+
+func (p *Poller) publish(event bus.Event) {
+	// Try to match a config
+	if config := p.templates.GetConfig(event); config != nil {
+		event["config"] = config
+	} else {
+		// If no template matches, try builders:
+		if config := p.builders.GetConfig(p.generateHints(event)); config != nil {
+			event["config"] = config
+		}
+	}
+
+	// Call all appenders to append any extra configuration
+	p.appenders.Append(event)
+
+	p.bus.Publish(event)
+}
+
+func (p *Poller) generateHints(event bus.Event) bus.Event {
+	// Try to build a config with enabled builders. Send a provider agnostic payload.
+	// Builders are Beat specific.
+	e := bus.Event{}
+	if host, ok := event["host"]; ok {
+		e["host"] = host
+	}
+	if port, ok := event["port"]; ok {
+		e["port"] = port
+	}
+	if labels := p.discoverer.Labels(event); len(labels) > 0 {
+		e["hints"] = builder.GenerateHints(labels, "", p.config.Prefix)
+	}
+	return e
+}
+
+// Stop stops querying the services
+func (p *Poller) Stop() {
+	close(p.stop)
+	<-p.done
+}
+
+// String returns the name of the provider
+func (p *Poller) String() string {
+	return p.name
+}
+
+func sortedKeys(m map[string]bus.Event) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,207 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package poller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+)
+
+type testConfig struct {
+	Config `config:",inline"`
+	Path   string `config:"path"`
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		prefix   string
+		expected string
+		err      bool
+	}{
+		"default":      {prefix: "co.elastic", expected: "co.elastic"},
+		"trailing dot": {prefix: "co.elastic.", expected: "co.elastic"},
+		"dot":          {prefix: ".", expected: "."},
+		"empty":        {prefix: "", err: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := testConfig{Config: DefaultConfig()}
+			err := common.MustNewConfigFrom(map[string]interface{}{
+				"prefix": test.prefix,
+			}).Unpack(&config)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, config.Prefix)
+		})
+	}
+}
+
+type testDiscoverer struct {
+	services map[string]bus.Event
+	err      error
+}
+
+func (d *testDiscoverer) Discover() (map[string]bus.Event, error) {
+	return d.services, d.err
+}
+
+func (d *testDiscoverer) Labels(event bus.Event) common.MapStr {
+	return nil
+}
+
+type testComparer struct {
+	testDiscoverer
+}
+
+func (d *testComparer) Equal(a, b bus.Event) bool {
+	return a["host"] == b["host"]
+}
+
+func TestScan(t *testing.T) {
+	b := bus.New("test")
+	listener := b.Subscribe()
+	defer listener.Stop()
+
+	config := DefaultConfig()
+	discoverer := &testDiscoverer{
+		services: map[string]bus.Event{
+			"a": {"host": "10.0.0.1"},
+			"b": {"host": "10.0.0.2"},
+		},
+	}
+	p, err := New("test", b, &config, discoverer)
+	require.NoError(t, err)
+
+	// New services are started
+	p.Scan()
+	assertEvent(t, listener, "start", "10.0.0.1")
+	assertEvent(t, listener, "start", "10.0.0.2")
+
+	// Unchanged services don't emit events
+	p.Scan()
+	assertNoEvent(t, listener)
+
+	// Changed services are restarted, removed services are stopped
+	discoverer.services = map[string]bus.Event{
+		"a": {"host": "10.0.0.3"},
+	}
+	p.Scan()
+	assertEvent(t, listener, "stop", "10.0.0.1")
+	assertEvent(t, listener, "stop", "10.0.0.2")
+	assertEvent(t, listener, "start", "10.0.0.3")
+
+	// Services are kept on errors
+	discoverer.err = errors.New("unavailable")
+	p.Scan()
+	assertNoEvent(t, listener)
+}
+
+func TestScanComparer(t *testing.T) {
+	b := bus.New("test")
+	listener := b.Subscribe()
+	defer listener.Stop()
+
+	config := DefaultConfig()
+	discoverer := &testComparer{}
+	discoverer.services = map[string]bus.Event{
+		"a": {"host": "10.0.0.1", "status": "passing"},
+	}
+	p, err := New("test", b, &config, discoverer)
+	require.NoError(t, err)
+
+	p.Scan()
+	assertEvent(t, listener, "start", "10.0.0.1")
+
+	// Values ignored by the comparer don't restart services
+	discoverer.services = map[string]bus.Event{
+		"a": {"host": "10.0.0.1", "status": "warning"},
+	}
+	p.Scan()
+	assertNoEvent(t, listener)
+}
+
+func TestReadDescriptors(t *testing.T) {
+	descriptors, err := ReadDescriptors([]byte(`{"name": "redis", "host": "10.0.0.1", "port": 6379, "labels": {"co.elastic.metrics/module": "redis", "replicas": 2}}`), "test")
+	require.NoError(t, err)
+	require.Len(t, descriptors, 1)
+
+	meta := common.MapStr{
+		"name": "redis",
+		"labels": common.MapStr{
+			"co": common.MapStr{
+				"elastic": common.MapStr{
+					"metrics/module": "redis",
+				},
+			},
+			"replicas": "2",
+		},
+	}
+	event := descriptors[0].Event()
+	assert.Equal(t, bus.Event{
+		"host":    "10.0.0.1",
+		"port":    6379,
+		"service": meta,
+		"meta": common.MapStr{
+			"service": meta,
+		},
+	}, event)
+	assert.Equal(t, meta["labels"], DescriptorLabels(event))
+
+	descriptors, err = ReadDescriptors([]byte("- name: web\n  host: 10.0.0.2\n- name: web\n  host: 10.0.0.3\n"), "test")
+	require.NoError(t, err)
+	require.Len(t, descriptors, 2)
+	assert.Equal(t, "10.0.0.3", descriptors[1].Host)
+
+	// Services must have a host
+	_, err = ReadDescriptors([]byte("- name: web\n  host: 10.0.0.2\n- name: nohost\n"), "test")
+	assert.Error(t, err)
+
+	_, err = ReadDescriptor([]byte(`{"name": "nohost"}`), "test")
+	assert.Error(t, err)
+}
+
+func assertEvent(t *testing.T, listener bus.Listener, flag, host string) {
+	select {
+	case event := <-listener.Events():
+		assert.Equal(t, true, event[flag])
+		assert.Equal(t, host, event["host"])
+	case <-time.After(time.Second):
+		t.Fatalf("timeout waiting for %s event of %s", flag, host)
+	}
+}
+
+func assertNoEvent(t *testing.T, listener bus.Listener) {
+	select {
+	case event := <-listener.Events():
+		t.Fatalf("unexpected event %v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
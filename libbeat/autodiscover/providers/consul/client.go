@@ -0,0 +1,157 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package consul
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+)
+
+// Health status of the checks of an instance
+const (
+	statusPassing  = "passing"
+	statusWarning  = "warning"
+	statusCritical = "critical"
+)
+
+// client queries the HTTP API of a Consul agent
+type client struct {
+	http       *http.Client
+	address    string
+	datacenter string
+	token      string
+}
+
+// healthEntry is an instance of a service as returned by the health endpoint
+type healthEntry struct {
+	Node struct {
+		Node       string
+		Address    string
+		Datacenter string
+		Meta       map[string]string
+	}
+	Service struct {
+		ID      string
+		Service string
+		Tags    []string
+		Address string
+		Port    int
+		Meta    map[string]string
+	}
+	Checks []struct {
+		CheckID string
+		Status  string
+	}
+}
+
+func newClient(config *Config) (*client, error) {
+	tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("fail to load the TLS config: %v", err)
+	}
+
+	dialer := transport.NetDialer(config.Timeout)
+	tlsDialer, err := transport.TLSDialer(dialer, tlsConfig, config.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client{
+		http: &http.Client{
+			Transport: &http.Transport{
+				Dial:    dialer.Dial,
+				DialTLS: tlsDialer.Dial,
+			},
+			Timeout: config.Timeout,
+		},
+		address:    strings.TrimSuffix(config.Address, "/"),
+		datacenter: config.Datacenter,
+		token:      config.Token,
+	}, nil
+}
+
+// services returns the names of the services in the catalog with their tags
+func (c *client) services() (map[string][]string, error) {
+	var services map[string][]string
+	err := c.get("/v1/catalog/services", &services)
+	return services, err
+}
+
+// health returns the instances of a service with their health checks
+func (c *client) health(service string) ([]healthEntry, error) {
+	var entries []healthEntry
+	err := c.get("/v1/health/service/"+url.PathEscape(service), &entries)
+	return entries, err
+}
+
+func (c *client) get(path string, v interface{}) error {
+	params := url.Values{}
+	if c.datacenter != "" {
+		params.Set("dc", c.datacenter)
+	}
+	u := c.address + path
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+	if c.token != "" {
+		req.Header.Set("X-Consul-Token", c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP error %d in %s: %s", resp.StatusCode, path, strings.TrimSpace(string(body)))
+	}
+
+	return json.Unmarshal(body, v)
+}
+
+// status returns the aggregated status of the checks of an instance, the
+// status of an instance is the worst status of its checks
+func (e *healthEntry) status() string {
+	status := statusPassing
+	for _, check := range e.Checks {
+		switch check.Status {
+		case statusCritical:
+			return statusCritical
+		case statusWarning:
+			status = statusWarning
+		}
+	}
+	return status
+}
@@ -0,0 +1,51 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package consul
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/autodiscover/poller"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+)
+
+type Config struct {
+	poller.Config `config:",inline"`
+
+	// Address of the Consul agent
+	Address    string            `config:"address" validate:"required"`
+	Datacenter string            `config:"datacenter"`
+	Token      string            `config:"token"`
+	TLS        *tlscommon.Config `config:"ssl"`
+	Timeout    time.Duration     `config:"timeout" validate:"positive,nonzero"`
+
+	// Only discover these services, all services are discovered if empty
+	Services []string `config:"services"`
+	// Only discover instances with all these tags
+	Tags []string `config:"tags"`
+	// Only discover instances with all their health checks passing
+	PassingOnly bool `config:"passing_only"`
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Config:  poller.DefaultConfig(),
+		Address: "http://localhost:8500",
+		Timeout: 30 * time.Second,
+	}
+}
@@ -0,0 +1,220 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package consul
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/autodiscover"
+	"github.com/elastic/beats/libbeat/autodiscover/poller"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/common/safemapstr"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("consul", AutodiscoverBuilder)
+}
+
+// Provider discovers the instances of the services registered in Consul
+type Provider struct {
+	*poller.Poller
+	config *Config
+	client *client
+}
+
+// AutodiscoverBuilder builds and returns an autodiscover provider
+func AutodiscoverBuilder(b bus.Bus, c *common.Config) (autodiscover.Provider, error) {
+	cfgwarn.Experimental("The consul autodiscover is experimental")
+	config := defaultConfig()
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		config: config,
+		client: client,
+	}
+	p.Poller, err = poller.New("consul", b, &config.Config, p)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Discover queries the catalog for the selected instances
+func (p *Provider) Discover() (map[string]bus.Event, error) {
+	instances, err := p.discover()
+	if err != nil {
+		return nil, fmt.Errorf("querying the Consul catalog in %s: %v", p.config.Address, err)
+	}
+	return instances, nil
+}
+
+func (p *Provider) discover() (map[string]bus.Event, error) {
+	services, err := p.client.services()
+	if err != nil {
+		return nil, err
+	}
+
+	instances := map[string]bus.Event{}
+	for name, tags := range services {
+		if !p.selected(name, tags) {
+			continue
+		}
+
+		entries, err := p.client.health(name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if !containsAll(entry.Service.Tags, p.config.Tags) {
+				continue
+			}
+
+			status := entry.status()
+			if status == statusCritical || (p.config.PassingOnly && status != statusPassing) {
+				continue
+			}
+
+			instances[entry.Node.Node+"/"+entry.Service.ID] = entry.event(status)
+		}
+	}
+	return instances, nil
+}
+
+// selected checks if a service of the catalog has to be queried for instances
+func (p *Provider) selected(name string, tags []string) bool {
+	if len(p.config.Services) > 0 && !containsAll(p.config.Services, []string{name}) {
+		return false
+	}
+	// Tags of the catalog are the union of the tags of all the instances
+	return containsAll(tags, p.config.Tags)
+}
+
+// Labels returns the labels read from the tags of the instance in the form
+// of <label>=<value>, like co.elastic.metrics/module=redis
+func (p *Provider) Labels(event bus.Event) common.MapStr {
+	rawTags, _ := common.MapStr(event).GetValue("consul.service.tags")
+	tags, _ := rawTags.([]string)
+	labels := common.MapStr{}
+	for _, tag := range tags {
+		parts := strings.SplitN(tag, "=", 2)
+		if len(parts) == 2 {
+			safemapstr.Put(labels, parts[0], parts[1])
+		}
+	}
+	return labels
+}
+
+// Equal compares the instances without their health, instances are not
+// restarted when their health changes
+func (p *Provider) Equal(a, b bus.Event) bool {
+	return reflect.DeepEqual(withoutHealth(a), withoutHealth(b))
+}
+
+func withoutHealth(event bus.Event) common.MapStr {
+	result := common.MapStr(event).Clone()
+	result.Delete("consul.service.health")
+	result.Delete("meta.consul.service.health")
+	return result
+}
+
+func (e *healthEntry) event(status string) bus.Event {
+	// Instances registered without address use the address of the node
+	host := e.Service.Address
+	if host == "" {
+		host = e.Node.Address
+	}
+
+	tags := e.Service.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	service := common.MapStr{
+		"id":     e.Service.ID,
+		"name":   e.Service.Service,
+		"tags":   tags,
+		"health": status,
+	}
+	if len(e.Service.Meta) > 0 {
+		service["meta"] = toMapStr(e.Service.Meta)
+	}
+
+	node := common.MapStr{
+		"name":    e.Node.Node,
+		"address": e.Node.Address,
+	}
+	if len(e.Node.Meta) > 0 {
+		node["meta"] = toMapStr(e.Node.Meta)
+	}
+
+	meta := common.MapStr{
+		"service":    service,
+		"node":       node,
+		"datacenter": e.Node.Datacenter,
+	}
+
+	event := bus.Event{
+		"host":   host,
+		"consul": meta,
+		"meta": common.MapStr{
+			"consul": meta,
+		},
+	}
+	if e.Service.Port != 0 {
+		event["port"] = e.Service.Port
+	}
+	return event
+}
+
+func toMapStr(m map[string]string) common.MapStr {
+	result := common.MapStr{}
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+// containsAll checks if all the wanted values are in the list
+func containsAll(list, wanted []string) bool {
+	for _, w := range wanted {
+		found := false
+		for _, v := range list {
+			if v == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,262 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package consul
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+)
+
+// consulStandIn serves the catalog and health endpoints of a Consul agent
+type consulStandIn struct {
+	sync.Mutex
+	services map[string][]string
+	health   map[string]string
+	token    string
+}
+
+func (c *consulStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.Lock()
+	defer c.Unlock()
+
+	if r.Header.Get("X-Consul-Token") != c.token {
+		http.Error(w, "ACL not found", http.StatusForbidden)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/v1/catalog/services":
+		json.NewEncoder(w).Encode(c.services)
+	case "/v1/health/service/redis":
+		w.Write([]byte(c.health["redis"]))
+	case "/v1/health/service/web":
+		w.Write([]byte(c.health["web"]))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+const redisHealth = `[
+  {
+    "Node": {"Node": "node1", "Address": "10.0.0.1", "Datacenter": "dc1", "Meta": {"rack": "r1"}},
+    "Service": {"ID": "redis1", "Service": "redis", "Tags": ["primary", "co.elastic.metrics/module=redis"], "Address": "", "Port": 6379, "Meta": {"version": "4.0"}},
+    "Checks": [{"CheckID": "serfHealth", "Status": "passing"}, {"CheckID": "service:redis1", "Status": "passing"}]
+  },
+  {
+    "Node": {"Node": "node2", "Address": "10.0.0.2", "Datacenter": "dc1"},
+    "Service": {"ID": "redis2", "Service": "redis", "Tags": ["replica"], "Address": "10.0.1.2", "Port": 6380},
+    "Checks": [{"CheckID": "serfHealth", "Status": "passing"}, {"CheckID": "service:redis2", "Status": "warning"}]
+  },
+  {
+    "Node": {"Node": "node3", "Address": "10.0.0.3", "Datacenter": "dc1"},
+    "Service": {"ID": "redis3", "Service": "redis", "Tags": ["replica"], "Address": "", "Port": 6379},
+    "Checks": [{"CheckID": "serfHealth", "Status": "critical"}]
+  }
+]`
+
+const webHealth = `[
+  {
+    "Node": {"Node": "node1", "Address": "10.0.0.1", "Datacenter": "dc1"},
+    "Service": {"ID": "web", "Service": "web", "Tags": null, "Address": "", "Port": 80},
+    "Checks": [{"CheckID": "serfHealth", "Status": "passing"}]
+  }
+]`
+
+func newTestProvider(t *testing.T, b bus.Bus, server *httptest.Server, settings map[string]interface{}) *Provider {
+	settings["address"] = server.URL
+	provider, err := AutodiscoverBuilder(b, common.MustNewConfigFrom(settings))
+	require.NoError(t, err)
+	return provider.(*Provider)
+}
+
+func TestDiscover(t *testing.T) {
+	standIn := &consulStandIn{
+		services: map[string][]string{
+			"redis": {"primary", "replica", "co.elastic.metrics/module=redis"},
+			"web":   {},
+		},
+		health: map[string]string{"redis": redisHealth, "web": webHealth},
+		token:  "secret",
+	}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	tests := []struct {
+		msg       string
+		settings  map[string]interface{}
+		instances []string
+	}{
+		{
+			msg:       "Instances with critical checks must not be discovered",
+			settings:  map[string]interface{}{},
+			instances: []string{"node1/redis1", "node1/web", "node2/redis2"},
+		},
+		{
+			msg:       "Instances with warning checks must not be discovered with passing_only",
+			settings:  map[string]interface{}{"passing_only": true},
+			instances: []string{"node1/redis1", "node1/web"},
+		},
+		{
+			msg:       "Only selected services must be discovered",
+			settings:  map[string]interface{}{"services": []string{"redis"}},
+			instances: []string{"node1/redis1", "node2/redis2"},
+		},
+		{
+			msg:       "Only instances with all the tags must be discovered",
+			settings:  map[string]interface{}{"tags": []string{"replica"}},
+			instances: []string{"node2/redis2"},
+		},
+	}
+
+	for _, test := range tests {
+		test.settings["token"] = "secret"
+		p := newTestProvider(t, bus.New("test"), server, test.settings)
+
+		instances, err := p.discover()
+		require.NoError(t, err, test.msg)
+
+		var keys []string
+		for key := range instances {
+			keys = append(keys, key)
+		}
+		assert.ElementsMatch(t, test.instances, keys, test.msg)
+	}
+
+	p := newTestProvider(t, bus.New("test"), server, map[string]interface{}{"token": "secret"})
+	instances, err := p.discover()
+	require.NoError(t, err)
+
+	meta := common.MapStr{
+		"datacenter": "dc1",
+		"service": common.MapStr{
+			"id":     "redis1",
+			"name":   "redis",
+			"tags":   []string{"primary", "co.elastic.metrics/module=redis"},
+			"health": "passing",
+			"meta":   common.MapStr{"version": "4.0"},
+		},
+		"node": common.MapStr{
+			"name":    "node1",
+			"address": "10.0.0.1",
+			"meta":    common.MapStr{"rack": "r1"},
+		},
+	}
+	assert.Equal(t, bus.Event{
+		"host":   "10.0.0.1",
+		"port":   6379,
+		"consul": meta,
+		"meta": common.MapStr{
+			"consul": meta,
+		},
+	}, instances["node1/redis1"])
+
+	// Address of the service has priority over the address of the node
+	assert.Equal(t, "10.0.1.2", instances["node2/redis2"]["host"])
+	assert.Equal(t, "warning", instances["node2/redis2"]["consul"].(common.MapStr)["service"].(common.MapStr)["health"])
+
+	// Hints are read from the tags
+	hints := builder.GenerateHints(p.Labels(instances["node1/redis1"]), "", p.config.Prefix)
+	assert.Equal(t, common.MapStr{"metrics": common.MapStr{"module": "redis"}}, hints)
+
+	// Requests without the token must fail
+	p = newTestProvider(t, bus.New("test"), server, map[string]interface{}{})
+	_, err = p.discover()
+	assert.Error(t, err)
+}
+
+func TestScan(t *testing.T) {
+	standIn := &consulStandIn{
+		services: map[string][]string{"web": {}},
+		health:   map[string]string{"web": webHealth},
+	}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	b := bus.New("test")
+	listener := b.Subscribe()
+	defer listener.Stop()
+
+	p := newTestProvider(t, b, server, map[string]interface{}{})
+
+	// New instances are started
+	p.Scan()
+	assertEvent(t, listener, "start", "10.0.0.1")
+
+	// Unchanged instances don't emit events
+	p.Scan()
+	assertNoEvent(t, listener)
+
+	// Instances that change their health are not restarted
+	standIn.Lock()
+	standIn.services["redis"] = []string{"primary"}
+	standIn.health["redis"] = redisHealth
+	standIn.health["web"] = `[{"Node": {"Node": "node1", "Address": "10.0.0.1", "Datacenter": "dc1"},
+		"Service": {"ID": "web", "Service": "web", "Address": "", "Port": 80},
+		"Checks": [{"CheckID": "serfHealth", "Status": "warning"}]}]`
+	standIn.Unlock()
+	p.Scan()
+	assertEvent(t, listener, "start", "10.0.0.1")
+	assertEvent(t, listener, "start", "10.0.1.2")
+	assertNoEvent(t, listener)
+
+	// Instances that change are restarted
+	standIn.Lock()
+	standIn.health["web"] = `[{"Node": {"Node": "node1", "Address": "10.0.0.1", "Datacenter": "dc1"},
+		"Service": {"ID": "web", "Service": "web", "Address": "10.0.0.5", "Port": 80},
+		"Checks": [{"CheckID": "serfHealth", "Status": "warning"}]}]`
+	standIn.Unlock()
+	p.Scan()
+	assertEvent(t, listener, "stop", "10.0.0.1")
+	assertEvent(t, listener, "start", "10.0.0.5")
+
+	// Instances are kept if the agent is not available
+	server.Close()
+	p.Scan()
+	assertNoEvent(t, listener)
+}
+
+func assertEvent(t *testing.T, listener bus.Listener, flag, host string) {
+	select {
+	case event := <-listener.Events():
+		assert.Equal(t, true, event[flag])
+		assert.Equal(t, host, event["host"])
+	case <-time.After(time.Second):
+		t.Fatalf("timeout waiting for %s event of %s", flag, host)
+	}
+}
+
+func assertNoEvent(t *testing.T, listener bus.Listener) {
+	select {
+	case event := <-listener.Events():
+		t.Fatalf("unexpected event %v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
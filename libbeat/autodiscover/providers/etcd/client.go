@@ -0,0 +1,166 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package etcd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+)
+
+// client queries the JSON gateway of the etcd v3 API
+type client struct {
+	http     *http.Client
+	url      string
+	username string
+	password string
+	token    string
+}
+
+// keyValue is a key with its value, keys and values are base64 encoded by the gateway
+type keyValue struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
+
+func newClient(config *Config) (*client, error) {
+	tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("fail to load the TLS config: %v", err)
+	}
+
+	dialer := transport.NetDialer(config.Timeout)
+	tlsDialer, err := transport.TLSDialer(dialer, tlsConfig, config.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client{
+		http: &http.Client{
+			Transport: &http.Transport{
+				Dial:    dialer.Dial,
+				DialTLS: tlsDialer.Dial,
+			},
+			Timeout: config.Timeout,
+		},
+		url:      strings.TrimSuffix(config.Address, "/") + "/" + strings.Trim(config.APIPath, "/"),
+		username: config.Username,
+		password: config.Password,
+	}, nil
+}
+
+// prefix returns all the keys with the given prefix
+func (c *client) prefix(prefix string) ([]keyValue, error) {
+	request := map[string]string{
+		"key":       base64.StdEncoding.EncodeToString([]byte(prefix)),
+		"range_end": base64.StdEncoding.EncodeToString(prefixEnd([]byte(prefix))),
+	}
+
+	var response struct {
+		KVs []keyValue `json:"kvs"`
+	}
+
+	if c.username != "" && c.token == "" {
+		if err := c.authenticate(); err != nil {
+			return nil, err
+		}
+	}
+
+	status, err := c.post("/kv/range", request, &response)
+	if status == http.StatusUnauthorized && c.username != "" {
+		// Token may be expired, authenticate again
+		if err = c.authenticate(); err != nil {
+			return nil, err
+		}
+		_, err = c.post("/kv/range", request, &response)
+	}
+	return response.KVs, err
+}
+
+// authenticate requests a new token for the user
+func (c *client) authenticate() error {
+	request := map[string]string{
+		"name":     c.username,
+		"password": c.password,
+	}
+
+	var response struct {
+		Token string `json:"token"`
+	}
+
+	c.token = ""
+	if _, err := c.post("/auth/authenticate", request, &response); err != nil {
+		return fmt.Errorf("authentication failed: %v", err)
+	}
+	c.token = response.Token
+	return nil
+}
+
+func (c *client) post(path string, request, response interface{}) (int, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequest("POST", c.url+path, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, fmt.Errorf("HTTP error %d in %s: %s", resp.StatusCode, path, strings.TrimSpace(string(body)))
+	}
+
+	return resp.StatusCode, json.Unmarshal(body, response)
+}
+
+// prefixEnd returns the end of the range of keys with the given prefix, that is the
+// prefix with its last byte incremented
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	// All bytes are 0xff, the range ends with the last key
+	return []byte{0}
+}
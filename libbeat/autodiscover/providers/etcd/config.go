@@ -0,0 +1,50 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package etcd
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/autodiscover/poller"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+)
+
+type Config struct {
+	poller.Config `config:",inline"`
+
+	// Address of the etcd server
+	Address string `config:"address" validate:"required"`
+	// Path of the JSON gateway of the v3 API
+	APIPath  string            `config:"api_path"`
+	Username string            `config:"username"`
+	Password string            `config:"password"`
+	TLS      *tlscommon.Config `config:"ssl"`
+	Timeout  time.Duration     `config:"timeout" validate:"positive,nonzero"`
+
+	// Prefix of the keys containing the service descriptors
+	KeyPrefix string `config:"key_prefix" validate:"required"`
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Config:  poller.DefaultConfig(),
+		Address: "http://localhost:2379",
+		APIPath: "/v3beta",
+		Timeout: 30 * time.Second,
+	}
+}
@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package etcd
+
+import (
+	"fmt"
+
+	"github.com/elastic/beats/libbeat/autodiscover"
+	"github.com/elastic/beats/libbeat/autodiscover/poller"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("etcd", AutodiscoverBuilder)
+}
+
+// Provider discovers the services described in the keys of etcd with a prefix
+type Provider struct {
+	*poller.Poller
+	config *Config
+	client *client
+}
+
+// AutodiscoverBuilder builds and returns an autodiscover provider
+func AutodiscoverBuilder(b bus.Bus, c *common.Config) (autodiscover.Provider, error) {
+	cfgwarn.Experimental("The etcd autodiscover is experimental")
+	config := defaultConfig()
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		config: config,
+		client: client,
+	}
+	p.Poller, err = poller.New("etcd", b, &config.Config, p)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Discover reads the services described in the keys with the configured prefix
+func (p *Provider) Discover() (map[string]bus.Event, error) {
+	kvs, err := p.client.prefix(p.config.KeyPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("reading keys with prefix %s from %s: %v", p.config.KeyPrefix, p.config.Address, err)
+	}
+
+	services := map[string]bus.Event{}
+	for _, kv := range kvs {
+		key := string(kv.Key)
+		d, err := poller.ReadDescriptor(kv.Value, key)
+		if err != nil {
+			// Services of invalid keys are stopped until they are fixed
+			logp.Err("Error reading service descriptor in key %s: %v", key, err)
+			continue
+		}
+
+		event := d.Event()
+		event["etcd"] = common.MapStr{
+			"key": key,
+		}
+		services[key] = event
+	}
+	return services, nil
+}
+
+// Labels returns the labels of the described service
+func (p *Provider) Labels(event bus.Event) common.MapStr {
+	return poller.DescriptorLabels(event)
+}
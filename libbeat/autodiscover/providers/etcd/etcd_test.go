@@ -0,0 +1,253 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package etcd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+)
+
+// etcdStandIn serves the range and authentication endpoints of the etcd v3 JSON gateway
+type etcdStandIn struct {
+	sync.Mutex
+	keys     map[string]string
+	password string
+	token    string
+}
+
+func (e *etcdStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.Lock()
+	defer e.Unlock()
+
+	switch r.URL.Path {
+	case "/v3beta/auth/authenticate":
+		var request struct {
+			Name     string `json:"name"`
+			Password string `json:"password"`
+		}
+		json.NewDecoder(r.Body).Decode(&request)
+		if request.Name != "beats" || request.Password != e.password {
+			http.Error(w, `{"error":"authentication failed"}`, http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"token": e.token})
+
+	case "/v3beta/kv/range":
+		if e.token != "" && r.Header.Get("Authorization") != e.token {
+			http.Error(w, `{"error":"invalid auth token"}`, http.StatusUnauthorized)
+			return
+		}
+		var request struct {
+			Key      []byte `json:"key"`
+			RangeEnd []byte `json:"range_end"`
+		}
+		json.NewDecoder(r.Body).Decode(&request)
+
+		var names []string
+		for k := range e.keys {
+			if k >= string(request.Key) && k < string(request.RangeEnd) {
+				names = append(names, k)
+			}
+		}
+		sort.Strings(names)
+
+		kvs := []keyValue{}
+		for _, k := range names {
+			kvs = append(kvs, keyValue{Key: []byte(k), Value: []byte(e.keys[k])})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"kvs": kvs})
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestProvider(t *testing.T, b bus.Bus, server *httptest.Server, settings map[string]interface{}) *Provider {
+	settings["address"] = server.URL
+	settings["key_prefix"] = "/services/"
+	provider, err := AutodiscoverBuilder(b, common.MustNewConfigFrom(settings))
+	require.NoError(t, err)
+	return provider.(*Provider)
+}
+
+func TestDiscover(t *testing.T) {
+	standIn := &etcdStandIn{
+		keys: map[string]string{
+			"/services/redis": `{"name": "redis", "host": "10.0.0.1", "port": 6379, "labels": {"co.elastic.metrics/module": "redis"}, "meta": {"environment": "production"}}`,
+			"/services/web":   "name: web\nhost: 10.0.0.2\n",
+			"/services/bad":   `{"name": "nohost"}`,
+			"/servicesX/db":   `{"name": "db", "host": "10.0.0.3"}`,
+			"/other/db":       `{"name": "db", "host": "10.0.0.4"}`,
+		},
+	}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	p := newTestProvider(t, bus.New("test"), server, map[string]interface{}{})
+	services, err := p.Discover()
+	require.NoError(t, err)
+	var keys []string
+	for key := range services {
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []string{"/services/redis", "/services/web"}, keys)
+
+	meta := common.MapStr{
+		"name": "redis",
+		"labels": common.MapStr{
+			"co": common.MapStr{
+				"elastic": common.MapStr{
+					"metrics/module": "redis",
+				},
+			},
+		},
+		"meta": common.MapStr{
+			"environment": "production",
+		},
+	}
+	assert.Equal(t, bus.Event{
+		"host":    "10.0.0.1",
+		"port":    6379,
+		"service": meta,
+		"etcd": common.MapStr{
+			"key": "/services/redis",
+		},
+		"meta": common.MapStr{
+			"service": meta,
+		},
+	}, services["/services/redis"])
+
+	// Services without port don't have port in their events
+	_, found := services["/services/web"]["port"]
+	assert.False(t, found)
+
+	// Hints are read from the labels
+	hints := builder.GenerateHints(p.Labels(services["/services/redis"]), "", p.config.Prefix)
+	assert.Equal(t, common.MapStr{"metrics": common.MapStr{"module": "redis"}}, hints)
+}
+
+func TestAuthentication(t *testing.T) {
+	standIn := &etcdStandIn{
+		keys: map[string]string{
+			"/services/web": `{"name": "web", "host": "10.0.0.2"}`,
+		},
+		password: "secret",
+		token:    "token1",
+	}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	p := newTestProvider(t, bus.New("test"), server, map[string]interface{}{})
+	_, err := p.Discover()
+	assert.Error(t, err, "requests without token must fail")
+
+	p = newTestProvider(t, bus.New("test"), server, map[string]interface{}{
+		"username": "beats",
+		"password": "secret",
+	})
+	services, err := p.Discover()
+	require.NoError(t, err)
+	assert.Len(t, services, 1)
+
+	// Expired tokens are renewed
+	standIn.Lock()
+	standIn.token = "token2"
+	standIn.Unlock()
+	services, err = p.Discover()
+	require.NoError(t, err)
+	assert.Len(t, services, 1)
+}
+
+func TestScan(t *testing.T) {
+	standIn := &etcdStandIn{
+		keys: map[string]string{
+			"/services/redis": `{"name": "redis", "host": "10.0.0.1", "port": 6379}`,
+			"/services/web":   `{"name": "web", "host": "10.0.0.2", "port": 80}`,
+		},
+	}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	b := bus.New("test")
+	listener := b.Subscribe()
+	defer listener.Stop()
+
+	p := newTestProvider(t, b, server, map[string]interface{}{})
+
+	// New services are started
+	p.Scan()
+	assertEvent(t, listener, "start", "10.0.0.1")
+	assertEvent(t, listener, "start", "10.0.0.2")
+
+	// Unchanged services don't emit events
+	p.Scan()
+	assertNoEvent(t, listener)
+
+	// Changed services are restarted, removed services are stopped
+	standIn.Lock()
+	standIn.keys["/services/redis"] = `{"name": "redis", "host": "10.0.0.3", "port": 6379}`
+	delete(standIn.keys, "/services/web")
+	standIn.Unlock()
+	p.Scan()
+	assertEvent(t, listener, "stop", "10.0.0.1")
+	assertEvent(t, listener, "stop", "10.0.0.2")
+	assertEvent(t, listener, "start", "10.0.0.3")
+
+	// Services are kept if the server is not available
+	server.Close()
+	p.Scan()
+	assertNoEvent(t, listener)
+}
+
+func TestPrefixEnd(t *testing.T) {
+	assert.Equal(t, []byte("/services0"), prefixEnd([]byte("/services/")))
+	assert.Equal(t, []byte("b"), prefixEnd([]byte("a\xff")))
+	assert.Equal(t, []byte{0}, prefixEnd([]byte("\xff")))
+}
+
+func assertEvent(t *testing.T, listener bus.Listener, flag, host string) {
+	select {
+	case event := <-listener.Events():
+		assert.Equal(t, true, event[flag])
+		assert.Equal(t, host, event["host"])
+	case <-time.After(time.Second):
+		t.Fatalf("timeout waiting for %s event of %s", flag, host)
+	}
+}
+
+func assertNoEvent(t *testing.T, listener bus.Listener) {
+	select {
+	case event := <-listener.Events():
+		t.Fatalf("unexpected event %v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,118 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package file
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/autodiscover"
+	"github.com/elastic/beats/libbeat/autodiscover/poller"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("file", AutodiscoverBuilder)
+}
+
+var extensions = []string{".yml", ".yaml", ".json"}
+
+// Provider discovers the services described in the files of a directory
+type Provider struct {
+	*poller.Poller
+	config *Config
+}
+
+// AutodiscoverBuilder builds and returns an autodiscover provider
+func AutodiscoverBuilder(b bus.Bus, c *common.Config) (autodiscover.Provider, error) {
+	cfgwarn.Experimental("The file autodiscover is experimental")
+	config := defaultConfig()
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{config: config}
+	p.Poller, err = poller.New("file", b, &config.Config, p)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Discover reads the services described in the directory
+func (p *Provider) Discover() (map[string]bus.Event, error) {
+	return readServices(p.config.Path)
+}
+
+// Labels returns the labels of the described service
+func (p *Provider) Labels(event bus.Event) common.MapStr {
+	return poller.DescriptorLabels(event)
+}
+
+func readServices(path string) (map[string]bus.Event, error) {
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading service descriptors from %s: %v", path, err)
+	}
+
+	services := map[string]bus.Event{}
+	for _, info := range files {
+		if !info.Mode().IsRegular() || !hasExtension(info.Name()) {
+			continue
+		}
+
+		file := filepath.Join(path, info.Name())
+		descriptors, err := readDescriptors(file)
+		if err != nil {
+			// Services of invalid files are stopped until they are fixed
+			logp.Err("Error reading service descriptor %s: %v", file, err)
+			continue
+		}
+
+		for i, d := range descriptors {
+			event := d.Event()
+			event["descriptor"] = file
+			services[fmt.Sprintf("%s#%d", file, i)] = event
+		}
+	}
+	return services, nil
+}
+
+func readDescriptors(file string) ([]poller.Descriptor, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return poller.ReadDescriptors(content, file)
+}
+
+func hasExtension(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	for _, e := range extensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package file
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+)
+
+func TestReadDescriptors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "autodiscover-file")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	write(t, dir, "redis.yml", `
+name: redis
+host: 10.0.0.1
+port: 6379
+labels:
+  co.elastic.metrics/module: redis
+  co.elastic.metrics/period: 10s
+  co.elastic.logs/disable: true
+meta:
+  environment: production
+`)
+	write(t, dir, "web.json", `[
+  {"name": "nginx", "host": "10.0.0.2", "port": 80},
+  {"name": "nginx", "host": "10.0.0.3", "port": 80}
+]`)
+	write(t, dir, "invalid.yml", `name: nohost`)
+	write(t, dir, "README.md", `name: ignored`)
+
+	services, err := readServices(dir)
+	require.NoError(t, err)
+	assert.Len(t, services, 3)
+
+	redisMeta := common.MapStr{
+		"name": "redis",
+		"labels": common.MapStr{
+			"co": common.MapStr{
+				"elastic": common.MapStr{
+					"metrics/module": "redis",
+					"metrics/period": "10s",
+					"logs/disable":   "true",
+				},
+			},
+		},
+		"meta": common.MapStr{
+			"environment": "production",
+		},
+	}
+	redis := filepath.Join(dir, "redis.yml")
+	assert.Equal(t, bus.Event{
+		"host":       "10.0.0.1",
+		"port":       6379,
+		"service":    redisMeta,
+		"descriptor": redis,
+		"meta": common.MapStr{
+			"service": redisMeta,
+		},
+	}, services[redis+"#0"])
+
+	web := filepath.Join(dir, "web.json")
+	assert.Equal(t, "10.0.0.2", services[web+"#0"]["host"])
+	assert.Equal(t, "10.0.0.3", services[web+"#1"]["host"])
+}
+
+func TestScan(t *testing.T) {
+	dir, err := ioutil.TempDir("", "autodiscover-file")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	b := bus.New("test")
+	listener := b.Subscribe()
+	defer listener.Stop()
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"path": dir,
+	})
+	provider, err := AutodiscoverBuilder(b, cfg)
+	require.NoError(t, err)
+	p := provider.(*Provider)
+
+	write(t, dir, "redis.yml", "name: redis\nhost: 10.0.0.1\nport: 6379\n")
+	write(t, dir, "nginx.yml", "name: nginx\nhost: 10.0.0.2\nport: 80\n")
+
+	// New descriptors start their services
+	p.Scan()
+	assertEvent(t, listener, "start", "10.0.0.2")
+	assertEvent(t, listener, "start", "10.0.0.1")
+
+	// Unchanged descriptors don't emit events
+	p.Scan()
+	assertNoEvent(t, listener)
+
+	// Changed descriptors restart their services
+	write(t, dir, "redis.yml", "name: redis\nhost: 10.0.0.4\nport: 6379\n")
+	p.Scan()
+	assertEvent(t, listener, "stop", "10.0.0.1")
+	assertEvent(t, listener, "start", "10.0.0.4")
+
+	// Removed descriptors stop their services
+	require.NoError(t, os.Remove(filepath.Join(dir, "nginx.yml")))
+	p.Scan()
+	assertEvent(t, listener, "stop", "10.0.0.2")
+	assertNoEvent(t, listener)
+}
+
+func write(t *testing.T, dir, name, content string) {
+	err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+	require.NoError(t, err)
+}
+
+func assertEvent(t *testing.T, listener bus.Listener, flag, host string) {
+	select {
+	case event := <-listener.Events():
+		assert.Equal(t, true, event[flag])
+		assert.Equal(t, host, event["host"])
+	case <-time.After(time.Second):
+		t.Fatalf("timeout waiting for %s event of %s", flag, host)
+	}
+}
+
+func assertNoEvent(t *testing.T, listener bus.Listener) {
+	select {
+	case event := <-listener.Events():
+		t.Fatalf("unexpected event %v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -75,7 +75,10 @@ import (
 	_ "github.com/elastic/beats/libbeat/processors/dissect"
 
 	// Register autodiscover providers
+	_ "github.com/elastic/beats/libbeat/autodiscover/providers/consul"
 	_ "github.com/elastic/beats/libbeat/autodiscover/providers/docker"
+	_ "github.com/elastic/beats/libbeat/autodiscover/providers/etcd"
+	_ "github.com/elastic/beats/libbeat/autodiscover/providers/file"
 	_ "github.com/elastic/beats/libbeat/autodiscover/providers/jolokia"
 	_ "github.com/elastic/beats/libbeat/autodiscover/providers/kubernetes"
 
@@ -0,0 +1,85 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pressure
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// Read reads a pressure stall information file, as the ones in /proc/pressure
+// or the *.pressure files of cgroup v2. The error can be checked with
+// os.IsNotExist if the file doesn't exist.
+func Read(path string) (common.MapStr, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Parse(f)
+}
+
+// Parse parses pressure stall information, with lines as
+// "some avg10=0.00 avg60=0.00 avg300=0.00 total=0" for the some and full
+// stalls. Averages are reported by the kernel as percentages, they are
+// returned as ratios between 0 and 1 under 10.pct, 60.pct and 300.pct. The
+// total stall time is returned under total.us.
+func Parse(r io.Reader) (common.MapStr, error) {
+	pressure := common.MapStr{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		stall := common.MapStr{}
+		for _, field := range fields[1:] {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) != 2 {
+				return nil, errors.Errorf("invalid field '%s'", field)
+			}
+
+			switch kv[0] {
+			case "avg10", "avg60", "avg300":
+				value, err := strconv.ParseFloat(kv[1], 64)
+				if err != nil {
+					return nil, errors.Wrapf(err, "invalid value for %s", kv[0])
+				}
+				stall.Put(strings.TrimPrefix(kv[0], "avg")+".pct", common.Round(value/100, common.DefaultDecimalPlacesCount))
+			case "total":
+				value, err := strconv.ParseUint(kv[1], 10, 64)
+				if err != nil {
+					return nil, errors.Wrapf(err, "invalid value for %s", kv[0])
+				}
+				stall.Put("total.us", value)
+			}
+		}
+		pressure[fields[0]] = stall
+	}
+
+	return pressure, scanner.Err()
+}
@@ -0,0 +1,60 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package pressure
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestParse(t *testing.T) {
+	pressure, err := Parse(strings.NewReader(
+		"some avg10=1.53 avg60=0.87 avg300=100.00 total=13428219\n" +
+			"full avg10=0.00 avg60=0.04 avg300=0.01 total=1020325\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, common.MapStr{
+		"some": common.MapStr{
+			"10":    common.MapStr{"pct": 0.0153},
+			"60":    common.MapStr{"pct": 0.0087},
+			"300":   common.MapStr{"pct": 1.0},
+			"total": common.MapStr{"us": uint64(13428219)},
+		},
+		"full": common.MapStr{
+			"10":    common.MapStr{"pct": 0.0},
+			"60":    common.MapStr{"pct": 0.0004},
+			"300":   common.MapStr{"pct": 0.0001},
+			"total": common.MapStr{"us": uint64(1020325)},
+		},
+	}, pressure)
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := Parse(strings.NewReader("some avg10=x avg60=0.00 avg300=0.00 total=0\n"))
+	assert.Error(t, err)
+
+	_, err = Parse(strings.NewReader("some avg10\n"))
+	assert.Error(t, err)
+}
@@ -279,6 +279,9 @@ func (p *Processor) object(f *common.Field) common.MapStr {
 	case "byte", "double", "float", "long", "short":
 		dynProperties["type"] = f.ObjectType
 		addDynamicTemplate(f, dynProperties, matchType(f.ObjectType))
+	case "nested":
+		dynProperties["type"] = f.ObjectType
+		addDynamicTemplate(f, dynProperties, matchType("object"))
 	}
 
 	properties := getDefaultProperties(f)
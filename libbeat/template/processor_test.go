@@ -367,6 +367,19 @@ func TestDynamicTemplate(t *testing.T) {
 				},
 			},
 		},
+		{
+			field: common.Field{
+				Type: "object", ObjectType: "nested",
+				Name: "*.bucket",
+			},
+			expected: common.MapStr{
+				"*.bucket": common.MapStr{
+					"mapping":            common.MapStr{"type": "nested"},
+					"match_mapping_type": "object",
+					"path_match":         "*.bucket",
+				},
+			},
+		},
 	}
 
 	for _, numericType := range []string{"byte", "double", "float", "long", "short"} {
@@ -128,6 +128,13 @@ func (h *HTTP) SetHeader(key, value string) {
 	h.headers[key] = value
 }
 
+// SetHeaderDefault sets an HTTP header to use in requests, unless it is already configured
+func (h *HTTP) SetHeaderDefault(key, value string) {
+	if _, found := h.headers[key]; !found {
+		h.headers[key] = value
+	}
+}
+
 // SetMethod sets HTTP method to use in requests
 func (h *HTTP) SetMethod(method string) {
 	h.method = method
@@ -0,0 +1,390 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	// openMetricsType is the content type of the OpenMetrics text format
+	openMetricsType = "application/openmetrics-text"
+
+	// acceptHeader prefers the OpenMetrics text format, falling back to the Prometheus formats
+	acceptHeader = `application/openmetrics-text; version=0.0.1,text/plain;version=0.0.4;q=0.5,*/*;q=0.1`
+)
+
+// openMetricsFamily collects the samples of a metric family while parsing
+type openMetricsFamily struct {
+	name    string
+	typ     string
+	help    string
+	family  *dto.MetricFamily
+	metrics map[string]*dto.Metric
+}
+
+// suffixes of the sample names of each OpenMetrics type
+var openMetricsSuffixes = map[string][]string{
+	"counter":        {"_total", "_created"},
+	"gauge":          {""},
+	"unknown":        {""},
+	"info":           {"_info"},
+	"stateset":       {""},
+	"histogram":      {"_bucket", "_count", "_sum", "_created"},
+	"gaugehistogram": {"_bucket", "_gcount", "_gsum"},
+	"summary":        {"", "_count", "_sum", "_created"},
+}
+
+// parseOpenMetrics parses metric families in the OpenMetrics text format. Families are
+// converted to their equivalent in the Prometheus format: counters and info metrics are
+// named after their samples, gauge histograms are reported as histograms, states of
+// state sets as gauges and unknown metrics as untyped.
+func parseOpenMetrics(r io.Reader) ([]*dto.MetricFamily, error) {
+	var families []*openMetricsFamily
+	var current *openMetricsFamily
+
+	familyFor := func(name string) *openMetricsFamily {
+		if current == nil || current.name != name {
+			current = &openMetricsFamily{name: name, typ: "unknown", metrics: map[string]*dto.Metric{}}
+			families = append(families, current)
+		}
+		return current
+	}
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			parts := strings.SplitN(line, " ", 4)
+			if len(parts) >= 2 && parts[1] == "EOF" {
+				break
+			}
+			if len(parts) < 3 {
+				continue
+			}
+			switch parts[1] {
+			case "TYPE":
+				if len(parts) < 4 {
+					return nil, fmt.Errorf("line %d: missing type of metric %s", lineNumber, parts[2])
+				}
+				if _, ok := openMetricsSuffixes[parts[3]]; !ok {
+					return nil, fmt.Errorf("line %d: unknown type %s of metric %s", lineNumber, parts[3], parts[2])
+				}
+				familyFor(parts[2]).typ = parts[3]
+			case "HELP":
+				if len(parts) == 4 {
+					familyFor(parts[2]).help = unescapeOpenMetrics(parts[3])
+				}
+			}
+			continue
+		}
+
+		name, labels, value, timestamp, err := parseOpenMetricsSample(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNumber, err)
+		}
+
+		f := current
+		suffix, ok := "", false
+		if f != nil {
+			suffix, ok = f.matchSuffix(name)
+		}
+		if !ok {
+			f = familyFor(name)
+			suffix = ""
+		}
+		if err := f.add(suffix, labels, value, timestamp); err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNumber, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.MetricFamily, 0, len(families))
+	for _, f := range families {
+		if f.family != nil && len(f.family.Metric) > 0 {
+			result = append(result, f.family)
+		}
+	}
+	return result, nil
+}
+
+func (f *openMetricsFamily) dtoFamily() *dto.MetricFamily {
+	if f.family != nil {
+		return f.family
+	}
+
+	name := f.name
+	var typ dto.MetricType
+	switch f.typ {
+	case "counter":
+		name += "_total"
+		typ = dto.MetricType_COUNTER
+	case "gauge", "stateset":
+		typ = dto.MetricType_GAUGE
+	case "info":
+		name += "_info"
+		typ = dto.MetricType_GAUGE
+	case "histogram", "gaugehistogram":
+		typ = dto.MetricType_HISTOGRAM
+	case "summary":
+		typ = dto.MetricType_SUMMARY
+	default:
+		typ = dto.MetricType_UNTYPED
+	}
+
+	f.family = &dto.MetricFamily{
+		Name: proto.String(name),
+		Type: typ.Enum(),
+	}
+	if f.help != "" {
+		f.family.Help = proto.String(f.help)
+	}
+	return f.family
+}
+
+// matchSuffix returns the suffix of a sample name belonging to the family
+func (f *openMetricsFamily) matchSuffix(name string) (string, bool) {
+	if !strings.HasPrefix(name, f.name) {
+		return "", false
+	}
+	suffix := name[len(f.name):]
+	for _, s := range openMetricsSuffixes[f.typ] {
+		if s == suffix {
+			return suffix, true
+		}
+	}
+	return "", false
+}
+
+// add adds a sample to the metric of the family with the same labels
+func (f *openMetricsFamily) add(suffix string, labels []*dto.LabelPair, value float64, timestamp *int64) error {
+	family := f.dtoFamily()
+
+	if suffix == "_created" {
+		return nil
+	}
+
+	// Bucket bounds and quantiles are part of the metric, not of its labels
+	var bound *float64
+	if (f.typ == "histogram" || f.typ == "gaugehistogram") && suffix == "_bucket" ||
+		f.typ == "summary" && suffix == "" {
+		boundLabel := "le"
+		if f.typ == "summary" {
+			boundLabel = "quantile"
+		}
+		var rest []*dto.LabelPair
+		for _, l := range labels {
+			if l.GetName() == boundLabel {
+				v, err := strconv.ParseFloat(l.GetValue(), 64)
+				if err != nil {
+					return fmt.Errorf("invalid %s label of metric %s: %v", boundLabel, f.name, err)
+				}
+				bound = &v
+				continue
+			}
+			rest = append(rest, l)
+		}
+		if bound == nil {
+			return fmt.Errorf("missing %s label of metric %s", boundLabel, f.name)
+		}
+		labels = rest
+	}
+
+	signature := labelsSignature(labels)
+	metric, found := f.metrics[signature]
+	if !found {
+		metric = &dto.Metric{Label: labels}
+		f.metrics[signature] = metric
+		family.Metric = append(family.Metric, metric)
+	}
+	if timestamp != nil {
+		metric.TimestampMs = timestamp
+	}
+
+	switch f.typ {
+	case "counter":
+		metric.Counter = &dto.Counter{Value: proto.Float64(value)}
+	case "gauge", "info", "stateset":
+		metric.Gauge = &dto.Gauge{Value: proto.Float64(value)}
+	case "histogram", "gaugehistogram":
+		if metric.Histogram == nil {
+			metric.Histogram = &dto.Histogram{}
+		}
+		switch suffix {
+		case "_bucket":
+			metric.Histogram.Bucket = append(metric.Histogram.Bucket, &dto.Bucket{
+				UpperBound:      bound,
+				CumulativeCount: proto.Uint64(uint64(value)),
+			})
+		case "_count", "_gcount":
+			metric.Histogram.SampleCount = proto.Uint64(uint64(value))
+		case "_sum", "_gsum":
+			metric.Histogram.SampleSum = proto.Float64(value)
+		}
+	case "summary":
+		if metric.Summary == nil {
+			metric.Summary = &dto.Summary{}
+		}
+		switch suffix {
+		case "":
+			metric.Summary.Quantile = append(metric.Summary.Quantile, &dto.Quantile{
+				Quantile: bound,
+				Value:    proto.Float64(value),
+			})
+		case "_count":
+			metric.Summary.SampleCount = proto.Uint64(uint64(value))
+		case "_sum":
+			metric.Summary.SampleSum = proto.Float64(value)
+		}
+	default:
+		metric.Untyped = &dto.Untyped{Value: proto.Float64(value)}
+	}
+	return nil
+}
+
+// labelsSignature returns a string identifying a set of labels
+func labelsSignature(labels []*dto.LabelPair) string {
+	pairs := make([]string, 0, len(labels))
+	for _, l := range labels {
+		pairs = append(pairs, l.GetName()+"\xff"+l.GetValue())
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "\xfe")
+}
+
+// parseOpenMetricsSample parses a sample line, exemplars are ignored
+func parseOpenMetricsSample(line string) (string, []*dto.LabelPair, float64, *int64, error) {
+	end := strings.IndexAny(line, "{ ")
+	if end <= 0 {
+		return "", nil, 0, nil, fmt.Errorf("invalid sample %q", line)
+	}
+	name := line[:end]
+	rest := line[end:]
+
+	var labels []*dto.LabelPair
+	if rest[0] == '{' {
+		var err error
+		labels, rest, err = parseOpenMetricsLabels(rest[1:])
+		if err != nil {
+			return "", nil, 0, nil, fmt.Errorf("invalid labels of sample %s: %v", name, err)
+		}
+	}
+
+	if i := strings.Index(rest, " # "); i >= 0 {
+		rest = rest[:i]
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 || len(fields) > 2 {
+		return "", nil, 0, nil, fmt.Errorf("invalid value of sample %s", name)
+	}
+
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return "", nil, 0, nil, fmt.Errorf("invalid value of sample %s: %v", name, err)
+	}
+
+	var timestamp *int64
+	if len(fields) == 2 {
+		seconds, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil || math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+			return "", nil, 0, nil, fmt.Errorf("invalid timestamp of sample %s", name)
+		}
+		timestamp = proto.Int64(int64(math.Round(seconds * 1000)))
+	}
+
+	return name, labels, value, timestamp, nil
+}
+
+// parseOpenMetricsLabels parses the labels following an opening brace, and returns
+// the rest of the line after the closing brace
+func parseOpenMetricsLabels(s string) ([]*dto.LabelPair, string, error) {
+	var labels []*dto.LabelPair
+	for {
+		s = strings.TrimLeft(s, " ")
+		if strings.HasPrefix(s, "}") {
+			return labels, s[1:], nil
+		}
+
+		eq := strings.Index(s, "=\"")
+		if eq <= 0 {
+			return nil, "", fmt.Errorf("invalid label in %q", s)
+		}
+		name := strings.TrimSpace(s[:eq])
+		s = s[eq+2:]
+
+		var value strings.Builder
+		closed := false
+		for i := 0; i < len(s); i++ {
+			c := s[i]
+			if c == '\\' && i+1 < len(s) {
+				i++
+				switch s[i] {
+				case 'n':
+					value.WriteByte('\n')
+				default:
+					value.WriteByte(s[i])
+				}
+				continue
+			}
+			if c == '"' {
+				s = s[i+1:]
+				closed = true
+				break
+			}
+			value.WriteByte(c)
+		}
+		if !closed {
+			return nil, "", fmt.Errorf("unterminated value of label %s", name)
+		}
+
+		labels = append(labels, &dto.LabelPair{
+			Name:  proto.String(name),
+			Value: proto.String(value.String()),
+		})
+
+		s = strings.TrimLeft(s, " ")
+		if strings.HasPrefix(s, ",") {
+			s = s[1:]
+		}
+	}
+}
+
+// unescapeOpenMetrics unescapes the text of HELP lines
+func unescapeOpenMetrics(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+	return strings.NewReplacer(`\\`, `\`, `\n`, "\n", `\"`, `"`).Replace(s)
+}
@@ -0,0 +1,170 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package prometheus
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const openMetrics = `# TYPE process_cpu_seconds counter
+# UNIT process_cpu_seconds seconds
+# HELP process_cpu_seconds Total user and system CPU time spent in seconds.
+process_cpu_seconds_total 4.2
+process_cpu_seconds_created 1.5379952e+09
+# TYPE temperature gauge
+temperature{room="a b",path="C:\\data"} 21.5 1537995200.123
+temperature{room="kitchen"} 19 # {trace_id="abc"} 1.0
+# TYPE build info
+build_info{version="1.0.0"} 1
+# TYPE state stateset
+state{state="up"} 1
+state{state="down"} 0
+# TYPE request_duration histogram
+request_duration_bucket{path="/",le="0.1"} 2
+request_duration_bucket{path="/",le="+Inf"} 3
+request_duration_count{path="/"} 3
+request_duration_sum{path="/"} 0.42
+request_duration_created{path="/"} 1.5379952e+09
+# TYPE queue_size gaugehistogram
+queue_size_bucket{le="10"} 4
+queue_size_bucket{le="+Inf"} 5
+queue_size_gcount 5
+queue_size_gsum 23
+# TYPE rpc_latency summary
+rpc_latency{quantile="0.5"} 0.05
+rpc_latency{quantile="0.99"} 0.3
+rpc_latency_count 10
+rpc_latency_sum 0.9
+untyped_metric 7
+# EOF
+ignored_metric 1
+`
+
+func familyByName(families []*dto.MetricFamily, name string) *dto.MetricFamily {
+	for _, f := range families {
+		if f.GetName() == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func TestParseOpenMetrics(t *testing.T) {
+	families, err := parseOpenMetrics(strings.NewReader(openMetrics))
+	require.NoError(t, err)
+	require.Len(t, families, 8)
+
+	counter := familyByName(families, "process_cpu_seconds_total")
+	require.NotNil(t, counter)
+	assert.Equal(t, dto.MetricType_COUNTER, counter.GetType())
+	assert.Equal(t, "Total user and system CPU time spent in seconds.", counter.GetHelp())
+	require.Len(t, counter.Metric, 1)
+	assert.Equal(t, 4.2, counter.Metric[0].GetCounter().GetValue())
+
+	gauge := familyByName(families, "temperature")
+	require.NotNil(t, gauge)
+	require.Len(t, gauge.Metric, 2)
+	assert.Equal(t, []*dto.LabelPair{
+		{Name: proto.String("room"), Value: proto.String("a b")},
+		{Name: proto.String("path"), Value: proto.String(`C:\data`)},
+	}, gauge.Metric[0].Label)
+	assert.Equal(t, int64(1537995200123), gauge.Metric[0].GetTimestampMs())
+	assert.Equal(t, 19.0, gauge.Metric[1].GetGauge().GetValue())
+
+	info := familyByName(families, "build_info")
+	require.NotNil(t, info)
+	assert.Equal(t, dto.MetricType_GAUGE, info.GetType())
+
+	state := familyByName(families, "state")
+	require.NotNil(t, state)
+	assert.Len(t, state.Metric, 2)
+
+	histogram := familyByName(families, "request_duration")
+	require.NotNil(t, histogram)
+	require.Len(t, histogram.Metric, 1)
+	h := histogram.Metric[0].GetHistogram()
+	assert.Equal(t, uint64(3), h.GetSampleCount())
+	assert.Equal(t, 0.42, h.GetSampleSum())
+	require.Len(t, h.Bucket, 2)
+	assert.Equal(t, 0.1, h.Bucket[0].GetUpperBound())
+	assert.Equal(t, uint64(2), h.Bucket[0].GetCumulativeCount())
+	assert.Equal(t, []*dto.LabelPair{{Name: proto.String("path"), Value: proto.String("/")}}, histogram.Metric[0].Label)
+
+	gaugeHistogram := familyByName(families, "queue_size")
+	require.NotNil(t, gaugeHistogram)
+	assert.Equal(t, dto.MetricType_HISTOGRAM, gaugeHistogram.GetType())
+	assert.Equal(t, uint64(5), gaugeHistogram.Metric[0].GetHistogram().GetSampleCount())
+	assert.Equal(t, 23.0, gaugeHistogram.Metric[0].GetHistogram().GetSampleSum())
+
+	summary := familyByName(families, "rpc_latency")
+	require.NotNil(t, summary)
+	s := summary.Metric[0].GetSummary()
+	assert.Equal(t, uint64(10), s.GetSampleCount())
+	require.Len(t, s.Quantile, 2)
+	assert.Equal(t, 0.99, s.Quantile[1].GetQuantile())
+	assert.Equal(t, 0.3, s.Quantile[1].GetValue())
+
+	untyped := familyByName(families, "untyped_metric")
+	require.NotNil(t, untyped)
+	assert.Equal(t, dto.MetricType_UNTYPED, untyped.GetType())
+	assert.Equal(t, 7.0, untyped.Metric[0].GetUntyped().GetValue())
+
+	assert.Nil(t, familyByName(families, "ignored_metric"))
+}
+
+func TestParseOpenMetricsErrors(t *testing.T) {
+	for _, data := range []string{
+		"# TYPE foo fancy\nfoo 1\n",
+		"foo{bar=\"baz} 1\n",
+		"foo not_a_number\n",
+		"foo 1 not_a_timestamp\n",
+		"# TYPE foo histogram\nfoo_bucket 1\n",
+	} {
+		_, err := parseOpenMetrics(strings.NewReader(data))
+		assert.Error(t, err, data)
+	}
+}
+
+type openMetricsFetcher struct{}
+
+func (openMetricsFetcher) FetchResponse() (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/openmetrics-text; version=0.0.1; charset=utf-8")
+	return &http.Response{
+		Header: header,
+		Body:   ioutil.NopCloser(bytes.NewReader([]byte(openMetrics))),
+	}, nil
+}
+
+func TestGetFamiliesOpenMetrics(t *testing.T) {
+	p := &prometheus{openMetricsFetcher{}}
+	families, err := p.GetFamilies()
+	require.NoError(t, err)
+	assert.Len(t, families, 8)
+}
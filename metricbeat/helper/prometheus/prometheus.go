@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	dto "github.com/prometheus/client_model/go"
 	"github.com/prometheus/common/expfmt"
@@ -57,6 +58,17 @@ func NewPrometheusClient(base mb.BaseMetricSet) (Prometheus, error) {
 	return &prometheus{http}, nil
 }
 
+// NewOpenMetricsClient creates new prometheus helper requesting the OpenMetrics
+// text format, the Prometheus formats are used with endpoints not supporting it
+func NewOpenMetricsClient(base mb.BaseMetricSet) (Prometheus, error) {
+	http, err := helper.NewHTTP(base)
+	if err != nil {
+		return nil, err
+	}
+	http.SetHeaderDefault("Accept", acceptHeader)
+	return &prometheus{http}, nil
+}
+
 // GetFamilies requests metric families from prometheus endpoint and returns them
 func (p *prometheus) GetFamilies() ([]*dto.MetricFamily, error) {
 	resp, err := p.FetchResponse()
@@ -65,6 +77,10 @@ func (p *prometheus) GetFamilies() ([]*dto.MetricFamily, error) {
 	}
 	defer resp.Body.Close()
 
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), openMetricsType) {
+		return parseOpenMetrics(resp.Body)
+	}
+
 	format := expfmt.ResponseFormat(resp.Header)
 	if format == "" {
 		return nil, fmt.Errorf("Invalid format for response of response")
@@ -19,6 +19,7 @@ package http
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -29,12 +30,20 @@ import (
 	"github.com/elastic/beats/metricbeat/mb"
 )
 
+// HandlerFunc handles the body of a POST request before the response is sent,
+// so the client is notified of its errors. It returns the data to queue in an
+// event, if not nil, and the status code of the response. On error, the status
+// code is sent with the error in the body, data returned with an error is
+// still queued.
+type HandlerFunc func(req *http.Request, body []byte) (data interface{}, status int, err error)
+
 type HttpServer struct {
 	server     *http.Server
 	ctx        context.Context
 	stop       context.CancelFunc
 	done       chan struct{}
 	eventQueue chan server.Event
+	handler    HandlerFunc
 }
 
 type HttpEvent struct {
@@ -51,6 +60,12 @@ func (h *HttpEvent) GetMeta() server.Meta {
 }
 
 func NewHttpServer(mb mb.BaseMetricSet) (server.Server, error) {
+	return NewHttpServerWithHandler(mb, acceptHandler)
+}
+
+// NewHttpServerWithHandler creates a server that handles the body of POST
+// requests with the given handler.
+func NewHttpServerWithHandler(mb mb.BaseMetricSet, handler HandlerFunc) (server.Server, error) {
 	config := defaultHttpConfig()
 	err := mb.Module().UnpackConfig(&config)
 	if err != nil {
@@ -63,6 +78,7 @@ func NewHttpServer(mb mb.BaseMetricSet) (server.Server, error) {
 		eventQueue: make(chan server.Event),
 		ctx:        ctx,
 		stop:       cancel,
+		handler:    handler,
 	}
 
 	httpServer := &http.Server{
@@ -117,19 +133,42 @@ func (h *HttpServer) handleFunc(writer http.ResponseWriter, req *http.Request) {
 			return
 		}
 
-		payload := common.MapStr{
-			server.EventDataKey: body,
+		data, status, err := h.handler(req, body)
+		if data != nil {
+			event := &HttpEvent{
+				event: common.MapStr{
+					server.EventDataKey: data,
+				},
+				meta: meta,
+			}
+			select {
+			case <-h.done:
+				http.Error(writer, "Server is stopping", http.StatusServiceUnavailable)
+				return
+			case h.eventQueue <- event:
+			}
 		}
 
-		event := &HttpEvent{
-			event: payload,
-			meta:  meta,
+		if err != nil {
+			writeError(writer, status, err)
+			return
 		}
-		h.eventQueue <- event
-		writer.WriteHeader(http.StatusAccepted)
+		writer.WriteHeader(status)
 
 	case "GET":
 		writer.WriteHeader(http.StatusOK)
 		writer.Write([]byte("HTTP Server accepts data via POST"))
 	}
 }
+
+// acceptHandler queues the body of the requests as is.
+func acceptHandler(req *http.Request, body []byte) (interface{}, int, error) {
+	return body, http.StatusAccepted, nil
+}
+
+// writeError responds with the status code and the error as JSON.
+func writeError(writer http.ResponseWriter, status int, err error) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	json.NewEncoder(writer).Encode(map[string]string{"error": err.Error()})
+}
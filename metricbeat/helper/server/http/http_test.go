@@ -22,8 +22,10 @@ package http
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -39,6 +41,7 @@ func GetHttpServer(host string, port int) (server.Server, error) {
 		eventQueue: make(chan server.Event, 1),
 		ctx:        ctx,
 		stop:       cancel,
+		handler:    acceptHandler,
 	}
 
 	httpServer := &http.Server{
@@ -89,3 +92,27 @@ func writeToServer(t *testing.T, message, host string, port int) {
 	defer resp.Body.Close()
 
 }
+
+func TestHttpServerHandler(t *testing.T) {
+	svc, err := GetHttpServer("127.0.0.1", 40051)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := svc.(*HttpServer)
+	h.handler = func(req *http.Request, body []byte) (interface{}, int, error) {
+		if string(body) == "invalid" {
+			return nil, http.StatusBadRequest, errors.New("invalid body")
+		}
+		return nil, http.StatusNoContent, nil
+	}
+
+	recorder := httptest.NewRecorder()
+	h.handleFunc(recorder, httptest.NewRequest("POST", "/", bytes.NewBufferString("valid")))
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	h.handleFunc(recorder, httptest.NewRequest("POST", "/", bytes.NewBufferString("invalid")))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"error": "invalid body"}`, recorder.Body.String())
+}
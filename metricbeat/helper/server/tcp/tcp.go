@@ -18,8 +18,12 @@
 package tcp
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"io"
 	"net"
+	"sync"
 
 	"github.com/pkg/errors"
 
@@ -29,12 +33,19 @@ import (
 	"github.com/elastic/beats/metricbeat/mb"
 )
 
+var errLineTooLong = errors.New("line is longer than the receive buffer")
+
 type TcpServer struct {
 	tcpAddr           *net.TCPAddr
 	listener          *net.TCPListener
 	receiveBufferSize int
 	done              chan struct{}
 	eventQueue        chan server.Event
+
+	// open connections, closed on stop
+	connections map[net.Conn]struct{}
+	mutex       sync.Mutex
+	wg          sync.WaitGroup
 }
 
 type TcpEvent struct {
@@ -67,6 +78,7 @@ func NewTcpServer(base mb.BaseMetricSet) (server.Server, error) {
 		receiveBufferSize: config.ReceiveBufferSize,
 		done:              make(chan struct{}),
 		eventQueue:        make(chan server.Event),
+		connections:       map[net.Conn]struct{}{},
 	}, nil
 }
 
@@ -83,7 +95,6 @@ func (g *TcpServer) Start() error {
 }
 
 func (g *TcpServer) watchMetrics() {
-	buffer := make([]byte, g.receiveBufferSize)
 	for {
 		select {
 		case <-g.done:
@@ -93,26 +104,111 @@ func (g *TcpServer) watchMetrics() {
 
 		conn, err := g.listener.Accept()
 		if err != nil {
+			select {
+			case <-g.done:
+				return
+			default:
+			}
 			logp.Err("Unable to accept connection due to error: %v", err)
 			continue
 		}
-		defer func() {
-			if conn != nil {
-				conn.Close()
-			}
-		}()
 
-		length, err := conn.Read(buffer)
+		if !g.addConnection(conn) {
+			conn.Close()
+			return
+		}
+		go g.handleConnection(conn)
+	}
+}
+
+// addConnection tracks a connection so it can be closed on stop, it returns
+// false if the server is already stopped.
+func (g *TcpServer) addConnection(conn net.Conn) bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	select {
+	case <-g.done:
+		return false
+	default:
+	}
+
+	g.connections[conn] = struct{}{}
+	g.wg.Add(1)
+	return true
+}
+
+func (g *TcpServer) removeConnection(conn net.Conn) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	delete(g.connections, conn)
+	conn.Close()
+}
+
+// handleConnection reads newline separated messages from a client connection
+// until it is closed, sending one event per message. Messages longer than the
+// receive buffer are dropped.
+func (g *TcpServer) handleConnection(conn net.Conn) {
+	defer g.wg.Done()
+	defer g.removeConnection(conn)
+
+	reader := bufio.NewReaderSize(conn, g.receiveBufferSize)
+	for {
+		line, err := readLine(reader)
+		if err == errLineTooLong {
+			logp.Warn("Dropping message longer than the receive_buffer_size of %d bytes", g.receiveBufferSize)
+			continue
+		}
 		if err != nil {
-			logp.Err("Error reading from buffer: %v", err.Error())
+			select {
+			case <-g.done:
+			default:
+				if err != io.EOF {
+					logp.Err("Error reading from connection: %v", err.Error())
+				}
+			}
+			return
+		}
+		if len(line) == 0 {
 			continue
 		}
-		g.eventQueue <- &TcpEvent{
+
+		// The reader reuses its buffer, hand out a copy.
+		data := append([]byte(nil), line...)
+
+		select {
+		case <-g.done:
+			return
+		case g.eventQueue <- &TcpEvent{
 			event: common.MapStr{
-				server.EventDataKey: buffer[:length],
+				server.EventDataKey: data,
 			},
+		}:
+		}
+	}
+}
+
+// readLine reads a line without its line ending. Lines that don't fit in the
+// buffer of the reader are discarded and errLineTooLong is returned.
+func readLine(reader *bufio.Reader) ([]byte, error) {
+	line, err := reader.ReadSlice('\n')
+	if err == bufio.ErrBufferFull {
+		for err == bufio.ErrBufferFull {
+			_, err = reader.ReadSlice('\n')
+		}
+		if err == nil {
+			err = errLineTooLong
 		}
+		return nil, err
+	}
+	// The last line can end without a line ending.
+	if err != nil && (err != io.EOF || len(line) == 0) {
+		return nil, err
 	}
+
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	return bytes.TrimSuffix(line, []byte("\r")), nil
 }
 
 func (g *TcpServer) GetEvents() chan server.Event {
@@ -122,5 +218,14 @@ func (g *TcpServer) GetEvents() chan server.Event {
 func (g *TcpServer) Stop() {
 	close(g.done)
 	g.listener.Close()
+
+	g.mutex.Lock()
+	for conn := range g.connections {
+		conn.Close()
+	}
+	g.mutex.Unlock()
+
+	// Handlers must be finished before closing the queue they send to.
+	g.wg.Wait()
 	close(g.eventQueue)
 }
@@ -22,7 +22,9 @@ package tcp
 import (
 	"fmt"
 	"net"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -43,6 +45,7 @@ func GetTestTcpServer(host string, port int) (server.Server, error) {
 		receiveBufferSize: 1024,
 		done:              make(chan struct{}),
 		eventQueue:        make(chan server.Event),
+		connections:       map[net.Conn]struct{}{},
 	}, nil
 }
 
@@ -94,3 +97,90 @@ func writeToServer(t *testing.T, message, host string, port int) {
 		t.FailNow()
 	}
 }
+
+func TestTcpServerMultipleMessages(t *testing.T) {
+	host := "127.0.0.1"
+	port := 2004
+	svc, err := GetTestTcpServer(host, port)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = svc.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer svc.Stop()
+	writeToServer(t, "test1\ntest2\n", host, port)
+
+	for _, expected := range []string{"test1", "test2"} {
+		msg := <-svc.GetEvents()
+		bytes, _ := msg.GetEvent()["data"].([]byte)
+		assert.Equal(t, expected, string(bytes))
+	}
+}
+
+func TestTcpServerDropsLongMessages(t *testing.T) {
+	host := "127.0.0.1"
+	port := 2005
+	svc, err := GetTestTcpServer(host, port)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = svc.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer svc.Stop()
+	writeToServer(t, strings.Repeat("a", 2048)+"\ntest2\n", host, port)
+
+	msg := <-svc.GetEvents()
+	bytes, _ := msg.GetEvent()["data"].([]byte)
+	assert.Equal(t, "test2", string(bytes))
+}
+
+func TestTcpServerStopClosesConnections(t *testing.T) {
+	host := "127.0.0.1"
+	port := 2006
+	svc, err := GetTestTcpServer(host, port)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = svc.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcpAddr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("%s:%d", host, port))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := net.DialTCP("tcp", nil, tcpAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("test1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-svc.GetEvents()
+
+	svc.Stop()
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = conn.Read(make([]byte, 1))
+	assert.Error(t, err)
+	if netErr, ok := err.(net.Error); ok {
+		assert.False(t, netErr.Timeout(), "connection not closed by the server")
+	}
+
+	_, open := <-svc.GetEvents()
+	assert.False(t, open)
+}
@@ -99,9 +99,13 @@ func (g *UdpServer) watchMetrics() {
 			continue
 		}
 
+		// The buffer is reused for the next packet, hand out a copy.
+		data := make([]byte, length)
+		copy(data, buffer[:length])
+
 		g.eventQueue <- &UdpEvent{
 			event: common.MapStr{
-				server.EventDataKey: buffer[:length],
+				server.EventDataKey: data,
 			},
 			meta: server.Meta{
 				"client_ip": addr.IP.String(),
@@ -80,6 +80,8 @@ import (
 	_ "github.com/elastic/beats/metricbeat/module/http"
 	_ "github.com/elastic/beats/metricbeat/module/http/json"
 	_ "github.com/elastic/beats/metricbeat/module/http/server"
+	_ "github.com/elastic/beats/metricbeat/module/influxdb"
+	_ "github.com/elastic/beats/metricbeat/module/influxdb/server"
 	_ "github.com/elastic/beats/metricbeat/module/jolokia"
 	_ "github.com/elastic/beats/metricbeat/module/jolokia/jmx"
 	_ "github.com/elastic/beats/metricbeat/module/kafka"
@@ -105,6 +107,11 @@ import (
 	_ "github.com/elastic/beats/metricbeat/module/kubernetes/volume"
 	_ "github.com/elastic/beats/metricbeat/module/kvm"
 	_ "github.com/elastic/beats/metricbeat/module/kvm/dommemstat"
+	_ "github.com/elastic/beats/metricbeat/module/linux"
+	_ "github.com/elastic/beats/metricbeat/module/linux/netstat"
+	_ "github.com/elastic/beats/metricbeat/module/linux/pressure"
+	_ "github.com/elastic/beats/metricbeat/module/linux/sockstat"
+	_ "github.com/elastic/beats/metricbeat/module/linux/vmstat"
 	_ "github.com/elastic/beats/metricbeat/module/logstash"
 	_ "github.com/elastic/beats/metricbeat/module/logstash/node"
 	_ "github.com/elastic/beats/metricbeat/module/logstash/node_stats"
@@ -130,6 +137,7 @@ import (
 	_ "github.com/elastic/beats/metricbeat/module/postgresql/statement"
 	_ "github.com/elastic/beats/metricbeat/module/prometheus"
 	_ "github.com/elastic/beats/metricbeat/module/prometheus/collector"
+	_ "github.com/elastic/beats/metricbeat/module/prometheus/remote_write"
 	_ "github.com/elastic/beats/metricbeat/module/prometheus/stats"
 	_ "github.com/elastic/beats/metricbeat/module/rabbitmq"
 	_ "github.com/elastic/beats/metricbeat/module/rabbitmq/connection"
@@ -139,6 +147,10 @@ import (
 	_ "github.com/elastic/beats/metricbeat/module/redis"
 	_ "github.com/elastic/beats/metricbeat/module/redis/info"
 	_ "github.com/elastic/beats/metricbeat/module/redis/keyspace"
+	_ "github.com/elastic/beats/metricbeat/module/sql"
+	_ "github.com/elastic/beats/metricbeat/module/sql/query"
+	_ "github.com/elastic/beats/metricbeat/module/statsd"
+	_ "github.com/elastic/beats/metricbeat/module/statsd/server"
 	_ "github.com/elastic/beats/metricbeat/module/system"
 	_ "github.com/elastic/beats/metricbeat/module/system/core"
 	_ "github.com/elastic/beats/metricbeat/module/system/cpu"
@@ -152,6 +164,7 @@ import (
 	_ "github.com/elastic/beats/metricbeat/module/system/process_summary"
 	_ "github.com/elastic/beats/metricbeat/module/system/raid"
 	_ "github.com/elastic/beats/metricbeat/module/system/socket"
+	_ "github.com/elastic/beats/metricbeat/module/system/socket_summary"
 	_ "github.com/elastic/beats/metricbeat/module/system/uptime"
 	_ "github.com/elastic/beats/metricbeat/module/traefik"
 	_ "github.com/elastic/beats/metricbeat/module/traefik/health"
@@ -0,0 +1,240 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mb
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// CounterMode selects the values derived from the counters of a MetricSet.
+type CounterMode uint8
+
+// Values that can be derived from counters. They can be combined.
+const (
+	// CounterRate adds the per second increase of each counter under
+	// rate.<field>.
+	CounterRate CounterMode = 1 << iota
+	// CounterDelta adds the increase of each counter since the previous
+	// sample under delta.<field>.
+	CounterDelta
+)
+
+// Counters declares the monotonic counters reported by a MetricSet, for which
+// Metricbeat keeps the previous samples to derive rates or deltas.
+type Counters struct {
+	// KeyFields are the fields that identify the entity measured by each
+	// event, for example the name of a network interface. Samples of events
+	// with different values in these fields are tracked independently.
+	KeyFields []string
+
+	// Fields are the paths of the counters in the MetricSet fields.
+	Fields []string
+
+	// Mode selects the derived values to add. CounterRate is used if not set.
+	Mode CounterMode
+
+	// Max is the value after which the counters wrap around to zero, for
+	// example math.MaxUint32 for 32 bits counters. If zero, a decreasing
+	// counter is always considered to have been reset.
+	Max uint64
+
+	// TTL is the time after which the samples of an entity that is not
+	// reported anymore are removed. It defaults to three times the period of
+	// the MetricSet.
+	TTL time.Duration
+}
+
+// CounterTracker keeps the previous samples of the counters of a MetricSet and
+// adds the derived values to new samples. It is safe for concurrent use.
+type CounterTracker struct {
+	config Counters
+
+	mutex   sync.Mutex
+	samples map[string]*counterSample
+}
+
+type counterSample struct {
+	timestamp time.Time
+	values    map[string]interface{} // uint64 or float64 values by field.
+}
+
+// NewCounterTracker returns a CounterTracker for the given counters. The TTL
+// of the samples defaults to three times the given period.
+func NewCounterTracker(counters Counters, period time.Duration) *CounterTracker {
+	if counters.Mode == 0 {
+		counters.Mode = CounterRate
+	}
+	if counters.TTL <= 0 {
+		counters.TTL = 3 * period
+	}
+	return &CounterTracker{
+		config:  counters,
+		samples: map[string]*counterSample{},
+	}
+}
+
+// Apply stores the counters found in fields as the last sample of their
+// entity, and adds their rate or delta since the previous sample of the same
+// entity. Nothing is added for the first sample of an entity, or if the
+// timestamp of the sample is not after the previous one. Samples of entities
+// not updated within the TTL are removed.
+func (t *CounterTracker) Apply(fields common.MapStr, timestamp time.Time) {
+	if fields == nil {
+		return
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.expire(timestamp)
+
+	key := t.key(fields)
+	previous := t.samples[key]
+	current := &counterSample{
+		timestamp: timestamp,
+		values:    make(map[string]interface{}, len(t.config.Fields)),
+	}
+
+	for _, field := range t.config.Fields {
+		v, err := fields.GetValue(field)
+		if err != nil {
+			continue
+		}
+		value, ok := counterValue(v)
+		if !ok {
+			continue
+		}
+		current.values[field] = value
+
+		if previous == nil || !timestamp.After(previous.timestamp) {
+			continue
+		}
+		prevValue, found := previous.values[field]
+		if !found {
+			continue
+		}
+
+		delta, ok := t.delta(prevValue, value)
+		if !ok {
+			continue
+		}
+
+		if t.config.Mode&CounterDelta != 0 {
+			fields.Put("delta."+field, delta)
+		}
+		if t.config.Mode&CounterRate != 0 {
+			seconds := timestamp.Sub(previous.timestamp).Seconds()
+			fields.Put("rate."+field, toFloat(delta)/seconds)
+		}
+	}
+
+	t.samples[key] = current
+}
+
+// key returns the identifier of the entity of an event.
+func (t *CounterTracker) key(fields common.MapStr) string {
+	parts := make([]string, len(t.config.KeyFields))
+	for i, field := range t.config.KeyFields {
+		if v, err := fields.GetValue(field); err == nil {
+			parts[i] = fmt.Sprint(v)
+		}
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// expire removes the samples older than the TTL.
+func (t *CounterTracker) expire(now time.Time) {
+	for key, sample := range t.samples {
+		if now.Sub(sample.timestamp) > t.config.TTL {
+			delete(t.samples, key)
+		}
+	}
+}
+
+// delta returns the increase of a counter between two samples. Counters that
+// decrease are considered to have wrapped around if they are close to the
+// maximum value, or to have been reset to zero otherwise.
+func (t *CounterTracker) delta(previous, current interface{}) (interface{}, bool) {
+	switch cur := current.(type) {
+	case uint64:
+		prev, ok := previous.(uint64)
+		if !ok {
+			return nil, false
+		}
+		if cur >= prev {
+			return cur - prev, true
+		}
+		if max := t.config.Max; max > 0 && prev <= max && prev > max/2 && cur < max/2 {
+			return max - prev + cur + 1, true
+		}
+		return cur, true
+	case float64:
+		prev, ok := previous.(float64)
+		if !ok {
+			return nil, false
+		}
+		if cur >= prev {
+			return cur - prev, true
+		}
+		return cur, true
+	}
+	return nil, false
+}
+
+// counterValue normalizes the value of a counter to uint64 for integers and
+// float64 for floating point numbers. Negative values are not valid counters.
+func counterValue(v interface{}) (interface{}, bool) {
+	switch n := v.(type) {
+	case uint64:
+		return n, true
+	case uint32:
+		return uint64(n), true
+	case uint16:
+		return uint64(n), true
+	case uint8:
+		return uint64(n), true
+	case uint:
+		return uint64(n), true
+	case int64:
+		return uint64(n), n >= 0
+	case int32:
+		return uint64(n), n >= 0
+	case int:
+		return uint64(n), n >= 0
+	case float64:
+		return n, n >= 0
+	case float32:
+		return float64(n), n >= 0
+	}
+	return nil, false
+}
+
+func toFloat(v interface{}) float64 {
+	switch n := v.(type) {
+	case uint64:
+		return float64(n)
+	case float64:
+		return n
+	}
+	return 0
+}
@@ -0,0 +1,144 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package mb
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestCounterTrackerRate(t *testing.T) {
+	tracker := NewCounterTracker(Counters{
+		KeyFields: []string{"name"},
+		Fields:    []string{"in.bytes", "out.bytes"},
+	}, 10*time.Second)
+
+	start := time.Now()
+
+	first := common.MapStr{"name": "eth0", "in": common.MapStr{"bytes": uint64(1000)}, "out": common.MapStr{"bytes": 500}}
+	tracker.Apply(first, start)
+	assert.NotContains(t, first, "rate")
+
+	other := common.MapStr{"name": "lo", "in": common.MapStr{"bytes": uint64(10)}}
+	tracker.Apply(other, start)
+	assert.NotContains(t, other, "rate")
+
+	second := common.MapStr{"name": "eth0", "in": common.MapStr{"bytes": uint64(3000)}, "out": common.MapStr{"bytes": 1500}}
+	tracker.Apply(second, start.Add(10*time.Second))
+	assert.Equal(t, common.MapStr{
+		"in":  common.MapStr{"bytes": 200.0},
+		"out": common.MapStr{"bytes": 100.0},
+	}, second["rate"])
+	assert.NotContains(t, second, "delta")
+}
+
+func TestCounterTrackerDelta(t *testing.T) {
+	tracker := NewCounterTracker(Counters{
+		Fields: []string{"count", "sum"},
+		Mode:   CounterDelta | CounterRate,
+	}, 10*time.Second)
+
+	start := time.Now()
+	tracker.Apply(common.MapStr{"count": 5, "sum": 1.5}, start)
+
+	second := common.MapStr{"count": 8, "sum": 2.0}
+	tracker.Apply(second, start.Add(2*time.Second))
+	assert.Equal(t, common.MapStr{"count": uint64(3), "sum": 0.5}, second["delta"])
+	assert.Equal(t, common.MapStr{"count": 1.5, "sum": 0.25}, second["rate"])
+
+	// Samples not after the previous one do not add values.
+	third := common.MapStr{"count": 10, "sum": 2.0}
+	tracker.Apply(third, start.Add(2*time.Second))
+	assert.NotContains(t, third, "delta")
+	assert.NotContains(t, third, "rate")
+}
+
+func TestCounterTrackerReset(t *testing.T) {
+	tracker := NewCounterTracker(Counters{
+		Fields: []string{"count"},
+		Mode:   CounterDelta,
+	}, 10*time.Second)
+
+	start := time.Now()
+	tracker.Apply(common.MapStr{"count": uint64(1000)}, start)
+
+	event := common.MapStr{"count": uint64(40)}
+	tracker.Apply(event, start.Add(10*time.Second))
+	assert.Equal(t, common.MapStr{"count": uint64(40)}, event["delta"])
+}
+
+func TestCounterTrackerWrapAround(t *testing.T) {
+	tracker := NewCounterTracker(Counters{
+		Fields: []string{"count"},
+		Mode:   CounterDelta,
+		Max:    math.MaxUint32,
+	}, 10*time.Second)
+
+	start := time.Now()
+	tracker.Apply(common.MapStr{"count": uint32(math.MaxUint32 - 9)}, start)
+
+	event := common.MapStr{"count": uint32(20)}
+	tracker.Apply(event, start.Add(10*time.Second))
+	assert.Equal(t, common.MapStr{"count": uint64(30)}, event["delta"])
+
+	// Small previous values are a reset, not a wrap around.
+	event = common.MapStr{"count": uint32(5)}
+	tracker.Apply(event, start.Add(20*time.Second))
+	assert.Equal(t, common.MapStr{"count": uint64(5)}, event["delta"])
+}
+
+func TestCounterTrackerExpire(t *testing.T) {
+	tracker := NewCounterTracker(Counters{
+		KeyFields: []string{"name"},
+		Fields:    []string{"count"},
+	}, 10*time.Second)
+
+	start := time.Now()
+	tracker.Apply(common.MapStr{"name": "a", "count": 1}, start)
+	tracker.Apply(common.MapStr{"name": "b", "count": 1}, start)
+	assert.Len(t, tracker.samples, 2)
+
+	event := common.MapStr{"name": "a", "count": 2}
+	tracker.Apply(event, start.Add(31*time.Second))
+	assert.Len(t, tracker.samples, 1)
+	assert.NotContains(t, event, "rate")
+}
+
+func TestCounterTrackerInvalidValues(t *testing.T) {
+	tracker := NewCounterTracker(Counters{
+		Fields: []string{"count", "missing"},
+	}, 10*time.Second)
+
+	start := time.Now()
+	tracker.Apply(common.MapStr{"count": 1}, start)
+
+	for _, value := range []interface{}{-1, "2", 2.5} {
+		event := common.MapStr{"count": value}
+		tracker.Apply(event, start.Add(time.Second))
+		assert.NotContains(t, event, "rate", "%v", value)
+	}
+
+	tracker.Apply(nil, start)
+}
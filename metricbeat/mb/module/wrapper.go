@@ -62,8 +62,9 @@ type Wrapper struct {
 // running the MetricSet. It contains a pointer to the parent Module.
 type metricSetWrapper struct {
 	mb.MetricSet
-	module *Wrapper // Parent Module.
-	stats  *stats   // stats for this MetricSet.
+	module   *Wrapper           // Parent Module.
+	stats    *stats             // stats for this MetricSet.
+	counters *mb.CounterTracker // Previous samples of the counters of this MetricSet.
 }
 
 // stats bundles common metricset stats.
@@ -97,6 +98,9 @@ func NewWrapper(config *common.Config, r *mb.Register, options ...Option) (*Wrap
 			module:    wrapper,
 			stats:     getMetricSetStats(wrapper.Name(), ms.Name()),
 		}
+		if counters := ms.Registration().Counters; counters != nil {
+			wrapper.metricSets[i].counters = mb.NewCounterTracker(*counters, module.Config().Period)
+		}
 	}
 
 	return wrapper, nil
@@ -347,6 +351,9 @@ func (r reporterV2) Event(event mb.Event) bool {
 	}
 
 	if event.Error == nil {
+		if r.msw.counters != nil {
+			r.msw.counters.Apply(event.MetricSetFields, event.Timestamp)
+		}
 		r.msw.stats.success.Add(1)
 	} else {
 		r.msw.stats.failures.Add(1)
@@ -35,6 +35,7 @@ const (
 	eventFetcherName     = "EventFetcher"
 	reportingFetcherName = "ReportingFetcher"
 	pushMetricSetName    = "PushMetricSet"
+	countersName         = "Counters"
 )
 
 // fakeMetricSet
@@ -102,6 +103,22 @@ func newFakePushMetricSet(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	return &fakePushMetricSet{BaseMetricSet: base}, nil
 }
 
+// Counters
+
+type fakeCountersMetricSet struct {
+	mb.BaseMetricSet
+	count uint64
+}
+
+func (ms *fakeCountersMetricSet) Fetch(r mb.ReporterV2) {
+	ms.count += 10
+	r.Event(mb.Event{MetricSetFields: common.MapStr{"name": "a", "count": ms.count}})
+}
+
+func newFakeCountersMetricSet(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	return &fakeCountersMetricSet{BaseMetricSet: base}, nil
+}
+
 // test utilities
 
 func newTestRegistry(t testing.TB) *mb.Register {
@@ -116,6 +133,13 @@ func newTestRegistry(t testing.TB) *mb.Register {
 	if err := r.AddMetricSet(moduleName, pushMetricSetName, newFakePushMetricSet); err != nil {
 		t.Fatal(err)
 	}
+	r.MustAddMetricSet(moduleName, countersName, newFakeCountersMetricSet,
+		mb.WithCounters(mb.Counters{
+			KeyFields: []string{"name"},
+			Fields:    []string{"count"},
+			Mode:      mb.CounterDelta,
+		}),
+	)
 
 	return r
 }
@@ -227,3 +251,31 @@ func TestWrapperOfPushMetricSet(t *testing.T) {
 		}
 	}
 }
+
+func TestWrapperWithCounters(t *testing.T) {
+	c := newConfig(t, map[string]interface{}{
+		"module":     moduleName,
+		"metricsets": []string{countersName},
+		"period":     "10ms",
+	})
+
+	m, err := module.NewWrapper(c, newTestRegistry(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	output := m.Start(done)
+
+	first := <-output
+	second := <-output
+	close(done)
+
+	_, err = first.Fields.GetValue("fake.counters.delta")
+	assert.Error(t, err)
+
+	delta, err := second.Fields.GetValue("fake.counters.delta.count")
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint64(10), delta)
+	}
+}
@@ -65,6 +65,7 @@ type MetricSetRegistration struct {
 	IsDefault  bool
 	HostParser HostParser
 	Namespace  string
+	Counters   *Counters
 }
 
 // MetricSetOption sets an option for a MetricSetFactory that is being
@@ -96,6 +97,14 @@ func WithNamespace(namespace string) MetricSetOption {
 	}
 }
 
+// WithCounters declares the monotonic counters of the MetricSet. Metricbeat
+// adds their rate or delta to the events reported by the MetricSet.
+func WithCounters(counters Counters) MetricSetOption {
+	return func(r *MetricSetRegistration) {
+		r.Counters = &counters
+	}
+}
+
 // Register contains the factory functions for creating new Modules and new
 // MetricSets. Registers are thread safe for concurrent usage.
 type Register struct {
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/metricbeat/module/docker"
@@ -162,3 +163,18 @@ func TestCPUService_UsageInUsermode(t *testing.T) {
 func equalEvent(expectedEvent common.MapStr, event common.MapStr) bool {
 	return reflect.DeepEqual(expectedEvent, event)
 }
+
+func TestCPUService_CPUsCgroupV2(t *testing.T) {
+	var stats types.StatsJSON
+	stats.CPUStats.OnlineCPUs = 4
+	stats.PreCPUStats.CPUUsage.TotalUsage = 100
+	stats.CPUStats.CPUUsage.TotalUsage = 250000100
+
+	usage := cpuUsage{
+		Stat:        &docker.Stat{Stats: stats},
+		systemDelta: 1000000000,
+	}
+	assert.Equal(t, 4, usage.CPUs())
+	assert.Equal(t, float64(1), usage.Total())
+	assert.Len(t, usage.PerCPU(), 0)
+}
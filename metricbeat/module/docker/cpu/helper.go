@@ -84,6 +84,10 @@ func (u *cpuUsage) CPUs() int {
 	if u.cpus == 0 {
 		u.cpus = len(u.Stats.CPUStats.CPUUsage.PercpuUsage)
 	}
+	if u.cpus == 0 {
+		// Per CPU usage is not reported with cgroup v2
+		u.cpus = int(u.Stats.CPUStats.OnlineCPUs)
+	}
 	return u.cpus
 }
 
@@ -271,3 +271,35 @@ func TestGetBlkioStats(t *testing.T) {
 		BlkioRaw{Time: later, reads: 1500, writes: 3000, totals: 4500},
 		stats.servicedBytes)
 }
+
+func TestGetBlkioStatsCgroupV2(t *testing.T) {
+	blkioService := NewBlkioService()
+
+	dockerStats := &docker.Stat{
+		Container: &types.Container{
+			ID:    "cebada",
+			Names: []string{"test"},
+		},
+		Stats: types.StatsJSON{Stats: types.Stats{
+			Read: time.Now(),
+			BlkioStats: types.BlkioStats{
+				IoServicedRecursive: []types.BlkioStatEntry{
+					{Major: 1, Minor: 1, Op: "read", Value: 100},
+					{Major: 1, Minor: 1, Op: "write", Value: 200},
+				},
+				IoServiceBytesRecursive: []types.BlkioStatEntry{
+					{Major: 1, Minor: 1, Op: "read", Value: 1000},
+					{Major: 1, Minor: 1, Op: "write", Value: 2000},
+				},
+			},
+		}},
+	}
+
+	stats := blkioService.getBlkioStats(dockerStats, true)
+	assert.Equal(t,
+		BlkioRaw{Time: dockerStats.Stats.Read, reads: 100, writes: 200, totals: 300},
+		stats.serviced)
+	assert.Equal(t,
+		BlkioRaw{Time: dockerStats.Stats.Read, reads: 1000, writes: 2000, totals: 3000},
+		stats.servicedBytes)
+}
@@ -18,6 +18,7 @@
 package diskio
 
 import (
+	"strings"
 	"time"
 
 	"github.com/docker/docker/api/types"
@@ -99,16 +100,24 @@ func (io *BlkioService) getNewStats(time time.Time, blkioEntry []types.BlkioStat
 		totals: 0,
 	}
 
+	hasTotals := false
 	for _, myEntry := range blkioEntry {
-		switch myEntry.Op {
-		case "Write":
+		// Operations are lowercase with cgroup v2
+		switch strings.ToLower(myEntry.Op) {
+		case "write":
 			stats.writes += myEntry.Value
-		case "Read":
+		case "read":
 			stats.reads += myEntry.Value
-		case "Total":
+		case "total":
 			stats.totals += myEntry.Value
+			hasTotals = true
 		}
 	}
+
+	// Totals are not reported with cgroup v2
+	if !hasTotals {
+		stats.totals = stats.reads + stats.writes
+	}
 	return stats
 }
 
@@ -46,7 +46,11 @@ func (s *MemoryService) getMemoryStatsList(rawStats []docker.Stat, dedot bool) [
 }
 
 func (s *MemoryService) getMemoryStats(myRawStat docker.Stat, dedot bool) MemoryData {
-	totalRSS := myRawStat.Stats.MemoryStats.Stats["total_rss"]
+	totalRSS, found := myRawStat.Stats.MemoryStats.Stats["total_rss"]
+	if !found {
+		// With cgroup v2 the anonymous memory is reported as anon
+		totalRSS = myRawStat.Stats.MemoryStats.Stats["anon"]
+	}
 	return MemoryData{
 		Time:      common.Time(myRawStat.Stats.Read),
 		Container: docker.NewContainer(myRawStat.Container, dedot),
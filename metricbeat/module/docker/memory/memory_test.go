@@ -94,6 +94,21 @@ func TestMemoryService_GetMemoryStats(t *testing.T) {
 	t.Logf(" returned : %v", event)
 }
 
+func TestMemoryService_GetMemoryStatsCgroupV2(t *testing.T) {
+	memorystats := getMemoryStats(time.Now(), 1)
+	delete(memorystats.MemoryStats.Stats, "total_rss")
+	memorystats.MemoryStats.Stats["anon"] = 3
+
+	memoryRawStats := docker.Stat{
+		Container: &types.Container{ID: "containerID", Names: []string{"/name1"}},
+		Stats:     memorystats,
+	}
+
+	rawStats := (&MemoryService{}).getMemoryStats(memoryRawStats, false)
+	assert.Equal(t, uint64(3), rawStats.TotalRss)
+	assert.Equal(t, 0.75, rawStats.TotalRssP)
+}
+
 func getMemoryStats(read time.Time, number uint64) types.StatsJSON {
 
 	myMemoryStats := types.StatsJSON{
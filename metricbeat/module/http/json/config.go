@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package json
+
+import (
+	"errors"
+	"fmt"
+)
+
+type config struct {
+	Namespace       string            `config:"namespace" validate:"required"`
+	Method          string            `config:"method"`
+	Body            string            `config:"body"`
+	RequestEnabled  bool              `config:"request.enabled"`
+	ResponseEnabled bool              `config:"response.enabled"`
+	JSONIsArray     bool              `config:"json.is_array"`
+	JSONSplit       string            `config:"json.split"`
+	JSONFields      []string          `config:"json.fields"`
+	JSONSchema      map[string]string `config:"json.schema"`
+	DeDotEnabled    bool              `config:"dedot.enabled"`
+	Pagination      paginationConfig  `config:"pagination"`
+}
+
+type paginationConfig struct {
+	// NextLink is the path in the response of the URL of the next page
+	NextLink string `config:"next_link"`
+	// Cursor sets the path in the response of the cursor of the next page, and
+	// the query parameter used to send it
+	Cursor struct {
+		Field string `config:"field"`
+		Param string `config:"param"`
+	} `config:"cursor"`
+	MaxPages int `config:"max_pages" validate:"min=1"`
+}
+
+func defaultConfig() config {
+	c := config{
+		Method: "GET",
+	}
+	c.Pagination.MaxPages = 10
+	return c
+}
+
+func (c *config) Validate() error {
+	for field, typ := range c.JSONSchema {
+		if _, found := schemaConverters[typ]; !found {
+			return fmt.Errorf("unknown type '%s' for field '%s' in json.schema", typ, field)
+		}
+	}
+
+	cursor := c.Pagination.Cursor
+	if (cursor.Field == "") != (cursor.Param == "") {
+		return errors.New("pagination.cursor requires both field and param")
+	}
+	if c.Pagination.NextLink != "" && cursor.Field != "" {
+		return errors.New("pagination.next_link and pagination.cursor cannot be used together")
+	}
+	return nil
+}
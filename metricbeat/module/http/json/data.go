@@ -0,0 +1,123 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package json
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/joeshaw/multierror"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/schema"
+	"github.com/elastic/beats/libbeat/common/schema/mapstriface"
+	"github.com/elastic/beats/libbeat/common/schema/mapstrstr"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+type convBuilder func(key string, opts ...schema.SchemaOption) schema.Conv
+
+// schemaConverters contains the conversions available in json.schema. Values
+// are converted from their JSON type, or parsed if they are sent as strings.
+var schemaConverters = map[string][]convBuilder{
+	"string":  {mapstriface.Str, mapstriface.StrFromNum},
+	"integer": {mapstriface.Int, mapstrstr.Int},
+	"long":    {mapstriface.Int, mapstrstr.Int},
+	"float":   {mapstriface.Float, mapstrstr.Float},
+	"double":  {mapstriface.Float, mapstrstr.Float},
+	"boolean": {mapstriface.Bool, mapstrstr.Bool},
+}
+
+// splitPath converts a dot separated path, optionally in JSONPath notation
+// as in `$.items[*].nodes`, to its keys
+func splitPath(path string) []string {
+	path = strings.TrimPrefix(path, "$")
+	path = strings.Replace(path, "[*]", "", -1)
+
+	var keys []string
+	for _, key := range strings.Split(path, ".") {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// splitObjects returns the objects found following the keys from the value,
+// iterating over all the elements of the arrays found on the way
+func splitObjects(value interface{}, keys []string) []common.MapStr {
+	if array, ok := value.([]interface{}); ok {
+		var objects []common.MapStr
+		for _, element := range array {
+			objects = append(objects, splitObjects(element, keys)...)
+		}
+		return objects
+	}
+
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		if len(keys) == 0 {
+			logp.Debug("http", "Ignoring %T value, only objects are reported as events", value)
+		}
+		return nil
+	}
+
+	if len(keys) == 0 {
+		return []common.MapStr{object}
+	}
+	return splitObjects(object[keys[0]], keys[1:])
+}
+
+// selectFields returns a new event with the given paths of the event
+func selectFields(event common.MapStr, paths []string) common.MapStr {
+	selected := common.MapStr{}
+	for _, path := range paths {
+		value, err := event.GetValue(path)
+		if err != nil {
+			continue
+		}
+		selected.Put(path, value)
+	}
+	return selected
+}
+
+// applySchema converts the fields of the event to the types of json.schema.
+// Missing fields are ignored.
+func applySchema(event common.MapStr, types map[string]string) error {
+	var errs multierror.Errors
+	for path, typ := range types {
+		if found, _ := event.HasKey(path); !found {
+			continue
+		}
+
+		var err error
+		for _, builder := range schemaConverters[typ] {
+			conv := builder(path)
+			var value interface{}
+			value, err = conv.Func(conv.Key, event)
+			if err == nil {
+				event.Put(path, value)
+				break
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("converting field '%s' to %s: %v", path, typ, err))
+		}
+	}
+	return errs.Err()
+}
@@ -19,11 +19,16 @@ package json
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
+	"github.com/joeshaw/multierror"
+	"github.com/pkg/errors"
+
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/metricbeat/helper"
 	"github.com/elastic/beats/metricbeat/mb"
@@ -67,31 +72,18 @@ type MetricSet struct {
 	requestEnabled  bool
 	responseEnabled bool
 	jsonIsArray     bool
+	jsonSplit       []string
+	jsonFields      []string
+	jsonSchema      map[string]string
 	deDotEnabled    bool
+	pagination      paginationConfig
 }
 
 // New create a new instance of the MetricSet
 // Part of new is also setting up the configuration by processing additional
 // configuration entries if needed.
 func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
-
-	config := struct {
-		Namespace       string `config:"namespace" validate:"required"`
-		Method          string `config:"method"`
-		Body            string `config:"body"`
-		RequestEnabled  bool   `config:"request.enabled"`
-		ResponseEnabled bool   `config:"response.enabled"`
-		JSONIsArray     bool   `config:"json.is_array"`
-		DeDotEnabled    bool   `config:"dedot.enabled"`
-	}{
-		Method:          "GET",
-		Body:            "",
-		RequestEnabled:  false,
-		ResponseEnabled: false,
-		JSONIsArray:     false,
-		DeDotEnabled:    false,
-	}
-
+	config := defaultConfig()
 	if err := base.Module().UnpackConfig(&config); err != nil {
 		return nil, err
 	}
@@ -112,17 +104,27 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		requestEnabled:  config.RequestEnabled,
 		responseEnabled: config.ResponseEnabled,
 		jsonIsArray:     config.JSONIsArray,
+		jsonSplit:       splitPath(config.JSONSplit),
+		jsonFields:      config.JSONFields,
+		jsonSchema:      config.JSONSchema,
 		deDotEnabled:    config.DeDotEnabled,
+		pagination:      config.Pagination,
 	}, nil
 }
 
-func (m *MetricSet) processBody(response *http.Response, jsonBody interface{}) common.MapStr {
+func (m *MetricSet) processBody(response *http.Response, jsonBody common.MapStr) (common.MapStr, error) {
 	var event common.MapStr
 
+	if len(m.jsonFields) > 0 {
+		jsonBody = selectFields(jsonBody, m.jsonFields)
+	}
+
+	err := applySchema(jsonBody, m.jsonSchema)
+
 	if m.deDotEnabled {
 		event = common.DeDotJSON(jsonBody).(common.MapStr)
 	} else {
-		event = jsonBody.(common.MapStr)
+		event = jsonBody
 	}
 
 	if m.requestEnabled {
@@ -149,49 +151,133 @@ func (m *MetricSet) processBody(response *http.Response, jsonBody interface{}) c
 	// Set dynamic namespace
 	event["_namespace"] = m.namespace
 
-	return event
+	return event, err
 }
 
 // Fetch methods implements the data gathering and data conversion to the right format
 // It returns the event which is then forward to the output. In case of an error, a
 // descriptive error must be returned.
 func (m *MetricSet) Fetch() ([]common.MapStr, error) {
+	uri := m.http.GetURI()
+	defer m.http.SetURI(uri)
+
+	var events []common.MapStr
+	for page := 0; page < m.pagination.MaxPages; page++ {
+		current := m.http.GetURI()
+		pageEvents, jsonBody, err := m.fetchPage()
+		events = append(events, pageEvents...)
+		if err != nil {
+			return events, err
+		}
+
+		next, err := m.nextURI(current, jsonBody)
+		if err != nil {
+			return events, err
+		}
+		if next == "" || next == current {
+			break
+		}
+		m.http.SetURI(next)
+	}
+
+	return events, nil
+}
+
+// fetchPage requests the current URI and returns its events and its decoded body
+func (m *MetricSet) fetchPage() ([]common.MapStr, interface{}, error) {
 	response, err := m.http.FetchResponse()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer response.Body.Close()
 
-	var jsonBody common.MapStr
-	var jsonBodyArr []common.MapStr
-	var events []common.MapStr
-
 	body, err := ioutil.ReadAll(response.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if m.jsonIsArray {
-		err = json.Unmarshal(body, &jsonBodyArr)
-		if err != nil {
-			return nil, err
-		}
+	var jsonBody interface{}
+	if err = json.Unmarshal(body, &jsonBody); err != nil {
+		return nil, nil, err
+	}
 
-		for _, obj := range jsonBodyArr {
-			event := m.processBody(response, obj)
-			events = append(events, event)
-		}
+	var objects []common.MapStr
+	if m.jsonIsArray || len(m.jsonSplit) > 0 {
+		objects = splitObjects(jsonBody, m.jsonSplit)
 	} else {
-		err = json.Unmarshal(body, &jsonBody)
-		if err != nil {
-			return nil, err
+		object, ok := jsonBody.(map[string]interface{})
+		if !ok {
+			return nil, nil, fmt.Errorf("expected a JSON object, found %T", jsonBody)
 		}
+		objects = []common.MapStr{object}
+	}
 
-		event := m.processBody(response, jsonBody)
+	var events []common.MapStr
+	var errs multierror.Errors
+	for _, obj := range objects {
+		event, err := m.processBody(response, obj)
+		if err != nil {
+			errs = append(errs, err)
+		}
 		events = append(events, event)
 	}
 
-	return events, nil
+	return events, jsonBody, errs.Err()
+}
+
+// nextURI returns the URI of the next page, built from the next link or the
+// cursor found in the body of the current page. It returns an empty string
+// if there are no more pages.
+func (m *MetricSet) nextURI(current string, jsonBody interface{}) (string, error) {
+	var path string
+	switch {
+	case m.pagination.NextLink != "":
+		path = m.pagination.NextLink
+	case m.pagination.Cursor.Field != "":
+		path = m.pagination.Cursor.Field
+	default:
+		return "", nil
+	}
+
+	object, ok := jsonBody.(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
+	value, err := common.MapStr(object).GetValue(path)
+	if err != nil || value == nil {
+		return "", nil
+	}
+
+	var next string
+	switch v := value.(type) {
+	case string:
+		next = v
+	case float64:
+		next = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return "", fmt.Errorf("unexpected %T value for pagination in '%s'", value, path)
+	}
+	if next == "" {
+		return "", nil
+	}
+
+	base, err := url.Parse(current)
+	if err != nil {
+		return "", err
+	}
+
+	if m.pagination.NextLink != "" {
+		link, err := url.Parse(next)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid next link '%s'", next)
+		}
+		return base.ResolveReference(link).String(), nil
+	}
+
+	query := base.Query()
+	query.Set(m.pagination.Cursor.Param, next)
+	base.RawQuery = query.Encode()
+	return base.String(), nil
 }
 
 func (m *MetricSet) getHeaders(header http.Header) map[string]string {
@@ -0,0 +1,211 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package json
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestSplitPath(t *testing.T) {
+	assert.Equal(t, []string{"items", "nodes"}, splitPath("$.items[*].nodes"))
+	assert.Equal(t, []string{"items", "nodes"}, splitPath("items.nodes"))
+	assert.Nil(t, splitPath("$"))
+}
+
+func TestSplitObjects(t *testing.T) {
+	data := map[string]interface{}{
+		"clusters": []interface{}{
+			map[string]interface{}{
+				"nodes": []interface{}{
+					map[string]interface{}{"name": "a"},
+					map[string]interface{}{"name": "b"},
+				},
+			},
+			map[string]interface{}{
+				"nodes": []interface{}{
+					map[string]interface{}{"name": "c"},
+					"ignored",
+				},
+			},
+			map[string]interface{}{},
+		},
+	}
+
+	assert.Equal(t, []common.MapStr{
+		{"name": "a"},
+		{"name": "b"},
+		{"name": "c"},
+	}, splitObjects(data, splitPath("clusters[*].nodes")))
+	assert.Nil(t, splitObjects(data, splitPath("missing")))
+}
+
+func TestSelectFields(t *testing.T) {
+	event := common.MapStr{
+		"status": "green",
+		"metrics": map[string]interface{}{
+			"cpu":    0.5,
+			"memory": 1024.0,
+		},
+		"ignored": true,
+	}
+
+	assert.Equal(t, common.MapStr{
+		"status":  "green",
+		"metrics": common.MapStr{"cpu": 0.5},
+	}, selectFields(event, []string{"status", "metrics.cpu", "missing"}))
+}
+
+func TestApplySchema(t *testing.T) {
+	event := common.MapStr{
+		"count":   "42",
+		"size":    1024.0,
+		"ratio":   "0.5",
+		"enabled": "true",
+		"id":      12.0,
+		"nested": map[string]interface{}{
+			"value": 3.0,
+		},
+		"invalid": "abc",
+	}
+
+	err := applySchema(event, map[string]string{
+		"count":        "integer",
+		"size":         "long",
+		"ratio":        "float",
+		"enabled":      "boolean",
+		"id":           "string",
+		"nested.value": "integer",
+		"missing":      "integer",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.MapStr{
+		"count":   int64(42),
+		"size":    int64(1024),
+		"ratio":   0.5,
+		"enabled": true,
+		"id":      "12",
+		"nested": map[string]interface{}{
+			"value": int64(3),
+		},
+		"invalid": "abc",
+	}, event)
+
+	err = applySchema(event, map[string]string{"invalid": "integer"})
+	assert.Error(t, err)
+}
+
+func TestConfigValidate(t *testing.T) {
+	c := defaultConfig()
+	c.JSONSchema = map[string]string{"count": "number"}
+	assert.Error(t, c.Validate())
+
+	c = defaultConfig()
+	c.Pagination.Cursor.Field = "next"
+	assert.Error(t, c.Validate())
+
+	c.Pagination.Cursor.Param = "cursor"
+	assert.NoError(t, c.Validate())
+
+	c.Pagination.NextLink = "links.next"
+	assert.Error(t, c.Validate())
+}
+
+func TestFetchSplitWithNextLink(t *testing.T) {
+	pages := map[string]string{
+		"/items":        `{"items": [{"id": "1", "load": "0.5"}, {"id": "2", "load": "1.5"}], "links": {"next": "/items?page=2"}}`,
+		"/items?page=2": `{"items": [{"id": "3", "load": "2.5"}], "links": {"next": null}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, pages[r.URL.RequestURI()])
+	}))
+	defer server.Close()
+
+	f := mbtest.NewEventsFetcher(t, map[string]interface{}{
+		"module":               "http",
+		"metricsets":           []string{"json"},
+		"hosts":                []string{server.URL},
+		"path":                 "/items",
+		"namespace":            "items",
+		"json.split":           "$.items[*]",
+		"json.fields":          []string{"load"},
+		"json.schema":          map[string]string{"load": "float"},
+		"pagination.next_link": "links.next",
+		"pagination.max_pages": 5,
+	})
+
+	for i := 0; i < 2; i++ {
+		events, err := f.Fetch()
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		var loads []interface{}
+		for _, e := range events {
+			loads = append(loads, e["load"])
+			assert.Equal(t, "items", e["_namespace"])
+			assert.Nil(t, e["id"])
+		}
+		assert.Equal(t, []interface{}{0.5, 1.5, 2.5}, loads)
+	}
+}
+
+func TestFetchCursor(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "json", r.URL.Query().Get("format"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			fmt.Fprint(w, `{"data": [{"id": 1}], "meta": {"cursor": "abc"}}`)
+		default:
+			fmt.Fprint(w, `{"data": [{"id": 2}], "meta": {"cursor": "abc"}}`)
+		}
+	}))
+	defer server.Close()
+
+	f := mbtest.NewEventsFetcher(t, map[string]interface{}{
+		"module":               "http",
+		"metricsets":           []string{"json"},
+		"hosts":                []string{server.URL + "/status?format=json"},
+		"namespace":            "status",
+		"json.split":           "data",
+		"pagination.cursor":    map[string]interface{}{"field": "meta.cursor", "param": "cursor"},
+		"pagination.max_pages": 3,
+	})
+
+	events, err := f.Fetch()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// The same cursor is returned by the second page, stopping the pagination
+	assert.Equal(t, 2, requests)
+	assert.Len(t, events, 2)
+}
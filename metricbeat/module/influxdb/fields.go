@@ -0,0 +1,35 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by beats/dev-tools/cmd/asset/asset.go - DO NOT EDIT.
+
+package influxdb
+
+import (
+	"github.com/elastic/beats/libbeat/asset"
+)
+
+func init() {
+	if err := asset.SetFields("metricbeat", "influxdb", Asset); err != nil {
+		panic(err)
+	}
+}
+
+// Asset returns asset data
+func Asset() string {
+	return "eJyckLFurDAQRXu+4mr7fR/g4hVRFClFuvSRwRfWWWOj8bAb/j6ChQQStEWkKdCdwXPOHHHmYOBjHfoPVxaAeg00ODxP0ePDoQAccyW+U5+iwf8CAJY22uT6wAIQBtpMg5JqC6D2DC6bafqIaFtu9oyxDh0NGkl9Nyc7m8Z6oYqvMoQV/YUOV68n6InfHMFHopOkqUrh3/znmmHNkSkXyle8R3KH5k9EeJpYYIXImoRu814fHWUyGgmR6vHbC1ra3AtbRl2kgN/HBvaF19Jqm02+WKfynZX+aN3Ct9vEmcM1yRb47nXGerVNnj22Fp8DANGps2E="
+}
@@ -0,0 +1,64 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"path"
+)
+
+// InfluxdbServerConfig holds the configuration of the influxdb server metricset
+type InfluxdbServerConfig struct {
+	Protocol  string           `config:"protocol"`
+	Precision string           `config:"precision"`
+	Templates []TemplateConfig `config:"templates"`
+}
+
+// TemplateConfig defines how the measurements matching a filter are mapped
+// to metric names and tags
+type TemplateConfig struct {
+	Filter    string            `config:"filter"`
+	Template  string            `config:"template"`
+	Namespace string            `config:"namespace"`
+	Delimiter string            `config:"delimiter"`
+	Tags      map[string]string `config:"tags"`
+}
+
+func defaultConfig() InfluxdbServerConfig {
+	return InfluxdbServerConfig{
+		Protocol:  "http",
+		Precision: "ns",
+	}
+}
+
+// Validate checks the protocol, the precision and the template filters
+func (c InfluxdbServerConfig) Validate() error {
+	if c.Protocol != "http" && c.Protocol != "udp" {
+		return errors.New("`protocol` can only be http or udp")
+	}
+	if _, err := precisionMultiplier(c.Precision); err != nil {
+		return err
+	}
+	for _, t := range c.Templates {
+		if _, err := path.Match(t.Filter, ""); err != nil {
+			return fmt.Errorf("invalid template filter '%s': %v", t.Filter, err)
+		}
+	}
+	return nil
+}
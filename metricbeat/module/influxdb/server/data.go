@@ -0,0 +1,144 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+const defaultDelimiter = "."
+
+type template struct {
+	Filter    string
+	Namespace string
+	Delimiter string
+	Parts     []string
+	Tags      map[string]string
+}
+
+// pointProcessor converts points into events, applying the first template
+// whose filter matches the measurement
+type pointProcessor struct {
+	templates []template
+}
+
+func newPointProcessor(configs []TemplateConfig) *pointProcessor {
+	templates := make([]template, 0, len(configs))
+	for _, c := range configs {
+		delimiter := c.Delimiter
+		if delimiter == "" {
+			delimiter = defaultDelimiter
+		}
+		templates = append(templates, template{
+			Filter:    c.Filter,
+			Namespace: c.Namespace,
+			Delimiter: delimiter,
+			Parts:     strings.Split(c.Template, "."),
+			Tags:      c.Tags,
+		})
+	}
+	return &pointProcessor{templates: templates}
+}
+
+func (p *pointProcessor) findTemplate(measurement string) *template {
+	for i, t := range p.templates {
+		if matched, _ := path.Match(t.Filter, measurement); matched {
+			return &p.templates[i]
+		}
+	}
+	return nil
+}
+
+// Event builds the event of a point. Fields are stored under the name of the
+// measurement, and tags under `tag`. Points without timestamp get now.
+func (p *pointProcessor) Event(pt point, now time.Time) mb.Event {
+	name := pt.measurement
+	tags := common.MapStr{}
+	var namespace string
+
+	if t := p.findTemplate(pt.measurement); t != nil {
+		name, tags = t.Apply(pt.measurement)
+		namespace = t.Namespace
+	}
+	for k, v := range pt.tags {
+		tags[k] = v
+	}
+
+	fields := common.MapStr{}
+	for k, v := range pt.fields {
+		if _, err := fields.Put(name+"."+k, v); err != nil {
+			logp.Debug("influxdb", "Dropping field %s of measurement %s: %v", k, pt.measurement, err)
+		}
+	}
+	if len(tags) > 0 {
+		fields["tag"] = tags
+	}
+
+	timestamp := pt.timestamp
+	if timestamp.IsZero() {
+		timestamp = now
+	}
+
+	return mb.Event{
+		Timestamp:       timestamp,
+		Namespace:       namespace,
+		MetricSetFields: fields,
+	}
+}
+
+// Apply splits a measurement in dot separated parts and maps them to the
+// metric name and tags following the parts of the template. Parts named
+// `measurement` are joined with the delimiter to build the name,
+// `measurement*` takes all the remaining parts, empty parts are ignored and
+// any other part is used as tag name.
+func (t *template) Apply(measurement string) (string, common.MapStr) {
+	tags := common.MapStr{}
+	for k, v := range t.Tags {
+		tags[k] = v
+	}
+
+	parts := strings.Split(measurement, ".")
+	var name []string
+	tagParts := map[string][]string{}
+	for i := 0; i < len(t.Parts) && i < len(parts); i++ {
+		switch t.Parts[i] {
+		case "measurement":
+			name = append(name, parts[i])
+		case "measurement*":
+			name = append(name, parts[i:]...)
+		case "":
+		default:
+			tagParts[t.Parts[i]] = append(tagParts[t.Parts[i]], parts[i])
+		}
+	}
+
+	for k, v := range tagParts {
+		tags[k] = strings.Join(v, t.Delimiter)
+	}
+
+	if len(name) == 0 {
+		return measurement, tags
+	}
+	return strings.Join(name, t.Delimiter), tags
+}
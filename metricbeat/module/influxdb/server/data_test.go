@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestPointProcessorEvent(t *testing.T) {
+	processor := newPointProcessor([]TemplateConfig{
+		{
+			Filter:    "app.*.*",
+			Template:  ".service.measurement*",
+			Namespace: "app",
+			Delimiter: "_",
+			Tags:      map[string]string{"env": "prod"},
+		},
+	})
+	now := time.Unix(1538395200, 0)
+
+	event := processor.Event(point{
+		measurement: "cpu",
+		tags:        map[string]string{"host": "server01"},
+		fields:      map[string]interface{}{"usage_idle": 98.5},
+	}, now)
+	assert.Equal(t, now, event.Timestamp)
+	assert.Equal(t, "", event.Namespace)
+	assert.Equal(t, common.MapStr{
+		"cpu": common.MapStr{"usage_idle": 98.5},
+		"tag": common.MapStr{"host": "server01"},
+	}, event.MetricSetFields)
+
+	ts := time.Unix(1538395100, 0)
+	event = processor.Event(point{
+		measurement: "app.checkout.http.requests",
+		fields:      map[string]interface{}{"count": int64(3)},
+		timestamp:   ts,
+	}, now)
+	assert.Equal(t, ts, event.Timestamp)
+	assert.Equal(t, "app", event.Namespace)
+	assert.Equal(t, common.MapStr{
+		"http_requests": common.MapStr{"count": int64(3)},
+		"tag":           common.MapStr{"env": "prod", "service": "checkout"},
+	}, event.MetricSetFields)
+}
+
+func TestTemplateApply(t *testing.T) {
+	tmpl := template{
+		Delimiter: ".",
+		Parts:     []string{"host", "measurement", "field"},
+	}
+
+	name, tags := tmpl.Apply("server01.cpu.idle.extra")
+	assert.Equal(t, "cpu", name)
+	assert.Equal(t, common.MapStr{"host": "server01", "field": "idle"}, tags)
+
+	// Measurements without parts mapped to the name are kept as is
+	name, tags = tmpl.Apply("server01")
+	assert.Equal(t, "server01", name)
+	assert.Equal(t, common.MapStr{"host": "server01"}, tags)
+}
+
+func TestConfigValidate(t *testing.T) {
+	config := defaultConfig()
+	assert.NoError(t, config.Validate())
+
+	config.Protocol = "tcp"
+	assert.Error(t, config.Validate())
+
+	config = defaultConfig()
+	config.Precision = "d"
+	assert.Error(t, config.Validate())
+
+	config = defaultConfig()
+	config.Templates = []TemplateConfig{{Filter: "[cpu"}}
+	assert.Error(t, config.Validate())
+}
@@ -0,0 +1,211 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joeshaw/multierror"
+)
+
+// point is a single line of the InfluxDB line protocol
+type point struct {
+	measurement string
+	tags        map[string]string
+	fields      map[string]interface{}
+	// timestamp is zero when the line has no timestamp
+	timestamp time.Time
+}
+
+var (
+	keyUnescaper    = strings.NewReplacer(`\,`, ",", `\=`, "=", `\ `, " ")
+	stringUnescaper = strings.NewReplacer(`\"`, `"`, `\\`, `\`)
+)
+
+// precisionMultiplier returns the duration of a timestamp unit
+func precisionMultiplier(precision string) (int64, error) {
+	switch precision {
+	case "n", "ns", "":
+		return int64(time.Nanosecond), nil
+	case "u", "us", "µ":
+		return int64(time.Microsecond), nil
+	case "ms":
+		return int64(time.Millisecond), nil
+	case "s":
+		return int64(time.Second), nil
+	case "m":
+		return int64(time.Minute), nil
+	case "h":
+		return int64(time.Hour), nil
+	}
+	return 0, fmt.Errorf("unknown precision '%s'", precision)
+}
+
+// parseLines parses all the lines of a write request. Valid lines are
+// returned even if some other lines could not be parsed.
+func parseLines(data []byte, precision string) ([]point, error) {
+	multiplier, err := precisionMultiplier(precision)
+	if err != nil {
+		return nil, err
+	}
+
+	var points []point
+	var errs multierror.Errors
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		p, err := parseLine(string(line), multiplier)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		points = append(points, p)
+	}
+	return points, errs.Err()
+}
+
+// parseLine parses a line in the format
+// `<measurement>[,<tag>=<value>...] <field>=<value>[,<field>=<value>...] [timestamp]`
+func parseLine(line string, multiplier int64) (point, error) {
+	keyEnd := indexUnescaped(line, ' ')
+	if keyEnd < 0 {
+		return point{}, fmt.Errorf("invalid line '%s': missing fields", line)
+	}
+
+	key := split(line[:keyEnd], ',', false)
+	p := point{
+		measurement: keyUnescaper.Replace(key[0]),
+		fields:      map[string]interface{}{},
+	}
+	if p.measurement == "" {
+		return point{}, fmt.Errorf("invalid line '%s': missing measurement", line)
+	}
+
+	if len(key) > 1 {
+		p.tags = map[string]string{}
+		for _, tag := range key[1:] {
+			k, v, err := splitKeyValue(tag)
+			if err != nil {
+				return point{}, fmt.Errorf("invalid tag in line '%s': %v", line, err)
+			}
+			p.tags[keyUnescaper.Replace(k)] = keyUnescaper.Replace(v)
+		}
+	}
+
+	sections := split(line[keyEnd+1:], ' ', true)
+	if len(sections) > 2 {
+		return point{}, fmt.Errorf("invalid line '%s': unexpected content after timestamp", line)
+	}
+
+	for _, field := range split(sections[0], ',', true) {
+		k, v, err := splitKeyValue(field)
+		if err != nil {
+			return point{}, fmt.Errorf("invalid field in line '%s': %v", line, err)
+		}
+		value, err := parseFieldValue(v)
+		if err != nil {
+			return point{}, fmt.Errorf("invalid field in line '%s': %v", line, err)
+		}
+		p.fields[keyUnescaper.Replace(k)] = value
+	}
+
+	if len(sections) == 2 {
+		ts, err := strconv.ParseInt(sections[1], 10, 64)
+		if err != nil {
+			return point{}, fmt.Errorf("invalid timestamp in line '%s': %v", line, err)
+		}
+		p.timestamp = time.Unix(0, ts*multiplier).UTC()
+	}
+
+	return p, nil
+}
+
+// parseFieldValue parses floats, integers (`1i`), unsigned integers (`1u`),
+// quoted strings and booleans
+func parseFieldValue(s string) (interface{}, error) {
+	switch s {
+	case "t", "T", "true", "True", "TRUE":
+		return true, nil
+	case "f", "F", "false", "False", "FALSE":
+		return false, nil
+	}
+
+	if strings.HasPrefix(s, `"`) {
+		if len(s) < 2 || !strings.HasSuffix(s, `"`) {
+			return nil, fmt.Errorf("unterminated string %s", s)
+		}
+		return stringUnescaper.Replace(s[1 : len(s)-1]), nil
+	}
+
+	if strings.HasSuffix(s, "i") {
+		return strconv.ParseInt(s[:len(s)-1], 10, 64)
+	}
+	if strings.HasSuffix(s, "u") {
+		return strconv.ParseUint(s[:len(s)-1], 10, 64)
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+func splitKeyValue(s string) (string, string, error) {
+	i := indexUnescaped(s, '=')
+	if i <= 0 || i == len(s)-1 {
+		return "", "", fmt.Errorf("'%s' is not in key=value format", s)
+	}
+	return s[:i], s[i+1:], nil
+}
+
+// indexUnescaped returns the index of the first occurrence of sep not
+// escaped with a backslash, or -1 if there is none
+func indexUnescaped(s string, sep byte) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case sep:
+			return i
+		}
+	}
+	return -1
+}
+
+// split splits s on the occurrences of sep not escaped with a backslash.
+// When quoted is set, separators between double quotes are ignored too.
+func split(s string, sep byte, quoted bool) []string {
+	var parts []string
+	inQuotes := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			i++
+		case quoted && s[i] == '"':
+			inQuotes = !inQuotes
+		case s[i] == sep && !inQuotes:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
@@ -0,0 +1,106 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLines(t *testing.T) {
+	data := []byte(`# comment
+cpu,host=server\ 01,region=us-west usage_idle=98.5,usage_user=1i,cores=8u 1538395200000000000
+weather,location=us\,midwest temperature=82,raining=t,description="hot, \"sunny\" day"
+
+disk\ io reads=3i
+`)
+
+	points, err := parseLines(data, "ns")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []point{
+		{
+			measurement: "cpu",
+			tags:        map[string]string{"host": "server 01", "region": "us-west"},
+			fields: map[string]interface{}{
+				"usage_idle": 98.5,
+				"usage_user": int64(1),
+				"cores":      uint64(8),
+			},
+			timestamp: time.Unix(1538395200, 0).UTC(),
+		},
+		{
+			measurement: "weather",
+			tags:        map[string]string{"location": "us,midwest"},
+			fields: map[string]interface{}{
+				"temperature": 82.0,
+				"raining":     true,
+				"description": `hot, "sunny" day`,
+			},
+		},
+		{
+			measurement: "disk io",
+			fields:      map[string]interface{}{"reads": int64(3)},
+		},
+	}, points)
+}
+
+func TestParseLinesPrecision(t *testing.T) {
+	for precision, ts := range map[string]string{
+		"ns": "1538395200000000000",
+		"u":  "1538395200000000",
+		"ms": "1538395200000",
+		"s":  "1538395200",
+		"m":  "25639920",
+		"h":  "427332",
+	} {
+		points, err := parseLines([]byte("cpu value=1 "+ts), precision)
+		if assert.NoError(t, err, precision) && assert.Len(t, points, 1) {
+			assert.Equal(t, time.Unix(1538395200, 0).UTC(), points[0].timestamp, precision)
+		}
+	}
+
+	_, err := parseLines([]byte("cpu value=1"), "d")
+	assert.Error(t, err)
+}
+
+func TestParseLinesErrors(t *testing.T) {
+	for _, line := range []string{
+		"cpu",
+		",host=a value=1",
+		"cpu,host value=1",
+		"cpu value",
+		"cpu value=abc",
+		`cpu value="unterminated`,
+		"cpu value=1 now",
+		"cpu value=1 1 2",
+	} {
+		_, err := parseLine(line, 1)
+		assert.Error(t, err, line)
+	}
+
+	points, err := parseLines([]byte("cpu value\ncpu value=1"), "ns")
+	assert.Error(t, err)
+	assert.Len(t, points, 1)
+}
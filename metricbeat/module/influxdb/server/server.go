@@ -0,0 +1,160 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	nethttp "net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	serverhelper "github.com/elastic/beats/metricbeat/helper/server"
+	"github.com/elastic/beats/metricbeat/helper/server/http"
+	"github.com/elastic/beats/metricbeat/helper/server/udp"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+const writePath = "/write"
+
+// init registers the MetricSet with the central registry.
+func init() {
+	mb.Registry.MustAddMetricSet("influxdb", "server", New,
+		mb.DefaultMetricSet(),
+	)
+}
+
+// MetricSet receives metrics sent with the InfluxDB line protocol
+type MetricSet struct {
+	mb.BaseMetricSet
+	server    serverhelper.Server
+	processor *pointProcessor
+	precision string
+}
+
+// New creates a new influxdb server metricset listening with the configured protocol
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The influxdb server metricset is beta")
+
+	config := defaultConfig()
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	m := &MetricSet{
+		BaseMetricSet: base,
+		processor:     newPointProcessor(config.Templates),
+		precision:     config.Precision,
+	}
+
+	var err error
+	if config.Protocol == "udp" {
+		m.server, err = udp.NewUdpServer(base)
+	} else {
+		m.server, err = http.NewHttpServerWithHandler(base, m.handleWrite)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Run starts the server and reports an event for each line received
+func (m *MetricSet) Run(reporter mb.PushReporterV2) {
+	if err := m.server.Start(); err != nil {
+		err = errors.Wrap(err, "failed to start influxdb server")
+		logp.Err("%v", err)
+		reporter.Error(err)
+		return
+	}
+
+	for {
+		select {
+		case <-reporter.Done():
+			m.server.Stop()
+			return
+		case msg := <-m.server.GetEvents():
+			var points []point
+			switch data := msg.GetEvent()[serverhelper.EventDataKey].(type) {
+			case []point:
+				// Lines received over HTTP are parsed by handleWrite
+				points = data
+			case []byte:
+				var err error
+				points, err = parseLines(data, m.precision)
+				if err != nil {
+					reporter.Error(err)
+				}
+			}
+
+			now := time.Now()
+			for _, p := range points {
+				reporter.Event(m.processor.Event(p, now))
+			}
+		}
+	}
+}
+
+// handleWrite parses the lines of the requests sent to the write endpoint, as
+// InfluxDB does it responds with 204 if all the lines are parsed, or 400 with
+// the error if some of them cannot be parsed. The valid lines are reported
+// in any case. The precision of the timestamps can be set with the
+// `precision` query parameter.
+func (m *MetricSet) handleWrite(req *nethttp.Request, body []byte) (interface{}, int, error) {
+	if req.URL.Path != writePath {
+		return nil, nethttp.StatusNotFound, fmt.Errorf("unexpected request to '%s', metrics must be sent to '%s'", req.URL.Path, writePath)
+	}
+
+	switch encoding := req.Header.Get("Content-Encoding"); encoding {
+	case "", "identity":
+	case "gzip":
+		reader, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, nethttp.StatusBadRequest, errors.Wrap(err, "invalid gzip body")
+		}
+		body, err = ioutil.ReadAll(reader)
+		if err != nil {
+			return nil, nethttp.StatusBadRequest, errors.Wrap(err, "invalid gzip body")
+		}
+	default:
+		return nil, nethttp.StatusUnsupportedMediaType, fmt.Errorf("unsupported content encoding '%s'", encoding)
+	}
+
+	precision := m.precision
+	if p := req.URL.Query().Get("precision"); p != "" {
+		precision = p
+	}
+
+	var data interface{}
+	points, err := parseLines(body, precision)
+	if len(points) > 0 {
+		data = points
+	}
+	if err != nil {
+		logp.Debug("influxdb", "Error parsing write request: %v", err)
+		return data, nethttp.StatusBadRequest, err
+	}
+	return data, nethttp.StatusNoContent, nil
+}
@@ -0,0 +1,153 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gzipped(t *testing.T, data string) string {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.String()
+}
+
+func TestHandleWrite(t *testing.T) {
+	m := &MetricSet{
+		processor: newPointProcessor(nil),
+		precision: "ns",
+	}
+
+	cases := []struct {
+		title    string
+		path     string
+		encoding string
+		body     string
+		status   int
+		points   int
+	}{
+		{
+			title:  "valid lines",
+			path:   "/write?db=telegraf",
+			body:   "cpu,host=a usage=1\nmem,host=a used=2i\n",
+			status: http.StatusNoContent,
+			points: 2,
+		},
+		{
+			title:  "empty body",
+			path:   "/write",
+			status: http.StatusNoContent,
+		},
+		{
+			title:  "invalid lines",
+			path:   "/write",
+			body:   "cpu,host=a usage=1\ncpu usage\n",
+			status: http.StatusBadRequest,
+			points: 1,
+		},
+		{
+			title:  "invalid precision",
+			path:   "/write?precision=d",
+			body:   "cpu usage=1\n",
+			status: http.StatusBadRequest,
+		},
+		{
+			title:  "other path",
+			path:   "/query?q=CREATE+DATABASE+telegraf",
+			status: http.StatusNotFound,
+		},
+		{
+			title:    "gzip body",
+			path:     "/write",
+			encoding: "gzip",
+			body:     gzipped(t, "cpu usage=1\n"),
+			status:   http.StatusNoContent,
+			points:   1,
+		},
+		{
+			title:    "invalid gzip body",
+			path:     "/write",
+			encoding: "gzip",
+			body:     "cpu usage=1\n",
+			status:   http.StatusBadRequest,
+		},
+		{
+			title:    "unsupported encoding",
+			path:     "/write",
+			encoding: "deflate",
+			body:     "cpu usage=1\n",
+			status:   http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.title, func(t *testing.T) {
+			req := httptest.NewRequest("POST", c.path, bytes.NewBufferString(c.body))
+			if c.encoding != "" {
+				req.Header.Set("Content-Encoding", c.encoding)
+			}
+
+			data, status, err := m.handleWrite(req, []byte(c.body))
+			assert.Equal(t, c.status, status)
+			if c.status == http.StatusNoContent {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+
+			if c.points == 0 {
+				assert.Nil(t, data)
+				return
+			}
+			points, ok := data.([]point)
+			if assert.True(t, ok) {
+				assert.Len(t, points, c.points)
+			}
+		})
+	}
+}
+
+func TestHandleWritePrecision(t *testing.T) {
+	m := &MetricSet{
+		processor: newPointProcessor(nil),
+		precision: "ns",
+	}
+
+	req := httptest.NewRequest("POST", "/write?precision=s", nil)
+	data, status, err := m.handleWrite(req, []byte("cpu usage=1 1538395200\n"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+
+	points, ok := data.([]point)
+	require.True(t, ok)
+	require.Len(t, points, 1)
+	assert.Equal(t, time.Unix(1538395200, 0).UTC(), points[0].timestamp)
+}
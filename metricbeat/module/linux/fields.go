@@ -0,0 +1,35 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by beats/dev-tools/cmd/asset/asset.go - DO NOT EDIT.
+
+package linux
+
+import (
+	"github.com/elastic/beats/libbeat/asset"
+)
+
+func init() {
+	if err := asset.SetFields("metricbeat", "linux", Asset); err != nil {
+		panic(err)
+	}
+}
+
+// Asset returns asset data
+func Asset() string {
+	return "eJzcms1y2kgQx+88RZePKaw4lS0OHLZqC/tAbZxQCd49wiA1MGG+dqbHmLffmkFgMELIARGbQhdGcvfv3+5WD2pdwwwXbRBc+acGAHES2IarL+H7VQMgQ5dabohr1YY/GwAA8RxInXmBDQCLApnDNoyQWANgzFFkrh0vvQbFJD6bDx9aGGzDxGpv8pUCH+H4G61CAVNkgqaQaq8IrQM9zgmm2pFL8ss3vW56VkiOGK3Xi/yXMITjK9Jc2xkYq0mnWmyh0BQjSBMssgzGVkv4aKxOPyqkj05JA0xlW/aeT+dwKw0Au9EEKNa3qZGb5MPWiZVIPfqJ6ab28FkuDpZXCK0mL86XRCIc3d5af1JMk8qz8nTuqxANpJuckaqTA4VsjYQSnWMTdDBaRLfFpJSeM3T9znPkmuB8OgXmYPgdyTLlfuDEDZsw7Ko7a90QtIVhx1t754iNhsX8Pjsn/8NtIX8E1jbCf08fR36cf48SvuqetuT2CxgITnheFddfOOFaSjEZNwN8ojNy3T0RqgyzwxVP6W9j25PCX7gjVN8e0Y6FnsdMWC7dWm1iLu+YHPY7vT6XqP3e5JDm/ErvvSBuGE23qjVpvGQzFp3zFo9pdL3cBjhiQgBXY20lC2TlnW7l+9g+lhqfOC0x+XSTmJ1ALuWMhd7q5uFYYrbBoE1R0esC3Fv+EZtgFMklAldRrGCO4NMNOEy1ylxYnk95OoXACMTczMEct0K+PGL4MIM548TVBMbaQqf3kJSLbr0d0a2zif5883ZUf745m2zSxETi3Qtzv3qT6Adza0GSp1YfEvISfMdmqRCJUtvFxRZrLu+g9Ass2arSL7Jwq4p/J+VbQc7YC/HWKzjsRZRW1zwTeQh2rBaF5FUheOOVfI4QvPmKPkcQfkNl78rakrFjs6osri+2P3e/lUu+wL58SPJF9uNDot9JHy6Rcen995D0C+67h6RfdL89JP6d9dlNOSspTqezY0dLP3Q6QwIfRgMBhLgjnlYZLOXOS4ZLq0taxz6WC4aQXOIdZif6f331coQ2yMyNh0r0DpNCgDAV4co7PJH3bu/xj/g4tapzbc2UqRN533SsNAEjYukUMyANTC3CmDFFt/9Re0LzGki4gn73/m7w71/dfszEknAwIXRaA0O0ywiz/a4lyhM5vo+//sDEoVxI7TCZ26ApZvBZDan4cLv2WpqKPqs9AA+3hwOwHJDVEYXlHKxKKCybn57Asnkl52PLJqf3HqxKVBTu986hHIkF/OfRYwWY5e/AE9HkibFKiX1wzYA1WhDuyRRuHltJDbfu1tY9oyw0kaCGim3Bw+3rCGopmdbrSiZGo4a6aVWum0hQR/G0frV4nolqrqBW1QpakT3KY/eW/3BLnon8GdF6dFy+t1y6PXbHePXhqjCSdb9ps6mhCXNO07iNDlDPLS6szOKrZc2wt98xiE9MGoEwNBPJfo6ZFxTeHzBubrgKrw7AUGs5mHEhhknj/wEAordzSw=="
+}
@@ -0,0 +1,63 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"path/filepath"
+
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/module/system"
+)
+
+func init() {
+	// Register the ModuleFactory function for the "linux" module.
+	if err := mb.Registry.AddModule("linux", NewModule); err != nil {
+		panic(err)
+	}
+}
+
+// Module is the linux module, it holds the root of the filesystem used to
+// read the proc files of the monitored host.
+type Module struct {
+	mb.BaseModule
+	HostFS string // Mountpoint of the host's filesystem for use in monitoring inside a container.
+}
+
+// NewModule creates a new linux module. The hostfs setting defaults to the
+// value of the -system.hostfs flag, or to / when the flag is not set.
+func NewModule(base mb.BaseModule) (mb.Module, error) {
+	config := struct {
+		HostFS string `config:"hostfs"`
+	}{
+		HostFS: *system.HostFS,
+	}
+	if err := base.UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	if config.HostFS == "" {
+		config.HostFS = "/"
+	}
+
+	return &Module{BaseModule: base, HostFS: config.HostFS}, nil
+}
+
+// ProcPath returns the path of a file in the proc filesystem of the host.
+func (m *Module) ProcPath(elem ...string) string {
+	return filepath.Join(append([]string{m.HostFS, "proc"}, elem...)...)
+}
@@ -0,0 +1,148 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package netstat
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/beats/metricbeat/module/linux"
+)
+
+// files are the files in /proc/net with the protocol counters.
+var files = []string{"snmp", "netstat"}
+
+// sections maps the sections of the files to the name used in the events.
+// Unknown sections are reported with their name in lower case.
+var sections = map[string]string{
+	"Ip":       "ip",
+	"Icmp":     "icmp",
+	"IcmpMsg":  "icmp_msg",
+	"Tcp":      "tcp",
+	"Udp":      "udp",
+	"UdpLite":  "udp_lite",
+	"IpExt":    "ip_ext",
+	"TcpExt":   "tcp_ext",
+	"MPTcpExt": "mptcp_ext",
+}
+
+func init() {
+	mb.Registry.MustAddMetricSet("linux", "netstat", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+		mb.DefaultMetricSet(),
+	)
+}
+
+// MetricSet reads the network protocol counters of the host.
+type MetricSet struct {
+	mb.BaseMetricSet
+	mod *linux.Module
+}
+
+// New creates a new instance of the netstat metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The linux netstat metricset is beta.")
+
+	mod, ok := base.Module().(*linux.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	return &MetricSet{BaseMetricSet: base, mod: mod}, nil
+}
+
+// Fetch reports one event with the counters of /proc/net/snmp and
+// /proc/net/netstat.
+func (m *MetricSet) Fetch(report mb.ReporterV2) {
+	event := common.MapStr{}
+	for _, file := range files {
+		path := m.mod.ProcPath("net", file)
+		counters, err := readCounters(path)
+		if err != nil {
+			report.Error(errors.Wrapf(err, "failed reading %s", path))
+			return
+		}
+		event.DeepUpdate(counters)
+	}
+
+	report.Event(mb.Event{MetricSetFields: event})
+}
+
+func readCounters(path string) (common.MapStr, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return parseCounters(f)
+}
+
+// parseCounters parses files with pairs of lines for each section, the first
+// one with the names of the counters and the second one with their values, as
+// in "Tcp: RtoAlgorithm RtoMin" followed by "Tcp: 1 200".
+func parseCounters(r io.Reader) (common.MapStr, error) {
+	counters := common.MapStr{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		names := strings.Fields(scanner.Text())
+		if len(names) == 0 {
+			continue
+		}
+		if !scanner.Scan() {
+			return nil, errors.Errorf("missing values for section %s", names[0])
+		}
+		values := strings.Fields(scanner.Text())
+		if len(values) == 0 || values[0] != names[0] {
+			return nil, errors.Errorf("missing values for section %s", names[0])
+		}
+		if len(values) != len(names) {
+			return nil, errors.Errorf("section %s has %d names and %d values",
+				names[0], len(names)-1, len(values)-1)
+		}
+
+		section := strings.TrimSuffix(names[0], ":")
+		name, found := sections[section]
+		if !found {
+			name = strings.ToLower(section)
+		}
+
+		group := common.MapStr{}
+		for i := 1; i < len(names); i++ {
+			value, err := strconv.ParseInt(values[i], 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid value for %s %s", section, names[i])
+			}
+			group[names[i]] = value
+		}
+		counters[name] = group
+	}
+
+	return counters, scanner.Err()
+}
@@ -0,0 +1,93 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package netstat
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestData(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig())
+
+	if err := mbtest.WriteEventsReporterV2(f, t, ""); err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig())
+	events, errs := mbtest.ReportingFetchV2(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 1)
+
+	fields := events[0].MetricSetFields
+	for _, section := range []string{"ip", "icmp", "icmp_msg", "tcp", "udp", "udp_lite", "ip_ext", "tcp_ext", "mptcp_ext"} {
+		assert.Contains(t, fields, section)
+	}
+
+	assertValue(t, fields, "tcp.RetransSegs", int64(317))
+	assertValue(t, fields, "tcp.InErrs", int64(4))
+	assertValue(t, fields, "tcp.MaxConn", int64(-1))
+	assertValue(t, fields, "icmp_msg.InType3", int64(42))
+	assertValue(t, fields, "udp.InDatagrams", int64(138))
+	assertValue(t, fields, "tcp_ext.ListenOverflows", int64(12))
+	assertValue(t, fields, "tcp_ext.ListenDrops", int64(12))
+}
+
+func TestParseCounters(t *testing.T) {
+	counters, err := parseCounters(strings.NewReader("Udp: InDatagrams NoPorts\nUdp: 5 3\nFoo: Bar\nFoo: 1\n"))
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{
+		"udp": common.MapStr{"InDatagrams": int64(5), "NoPorts": int64(3)},
+		"foo": common.MapStr{"Bar": int64(1)},
+	}, counters)
+
+	for _, content := range []string{
+		"Udp: InDatagrams NoPorts\n",
+		"Udp: InDatagrams NoPorts\nTcp: 5 3\n",
+		"Udp: InDatagrams NoPorts\nUdp: 5\n",
+		"Udp: InDatagrams NoPorts\nUdp: 5 x\n",
+	} {
+		_, err := parseCounters(strings.NewReader(content))
+		assert.Error(t, err, content)
+	}
+}
+
+func assertValue(t *testing.T, fields common.MapStr, key string, expected interface{}) {
+	value, err := fields.GetValue(key)
+	if assert.NoError(t, err, key) {
+		assert.Equal(t, expected, value, key)
+	}
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "linux",
+		"metricsets": []string{"netstat"},
+		"hostfs":     "./_meta/testdata",
+	}
+}
@@ -0,0 +1,86 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package pressure
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/metric/system/pressure"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/beats/metricbeat/module/linux"
+)
+
+// resources are the files available in /proc/pressure.
+var resources = []string{"cpu", "memory", "io"}
+
+func init() {
+	mb.Registry.MustAddMetricSet("linux", "pressure", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+	)
+}
+
+// MetricSet reads the pressure stall information of the host.
+type MetricSet struct {
+	mb.BaseMetricSet
+	mod *linux.Module
+}
+
+// New creates a new instance of the pressure metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The linux pressure metricset is beta.")
+
+	mod, ok := base.Module().(*linux.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	return &MetricSet{BaseMetricSet: base, mod: mod}, nil
+}
+
+// Fetch reports one event with the pressure of every resource. An error is
+// reported if the kernel does not expose pressure stall information.
+func (m *MetricSet) Fetch(report mb.ReporterV2) {
+	event := common.MapStr{}
+	for _, resource := range resources {
+		path := m.mod.ProcPath("pressure", resource)
+		stalls, err := pressure.Read(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			report.Error(errors.Wrapf(err, "failed reading %s", path))
+			return
+		}
+		event[resource] = stalls
+	}
+
+	if len(event) == 0 {
+		report.Error(errors.Errorf("no pressure stall information found in %s, "+
+			"it requires a kernel 4.20 or newer with PSI enabled", m.mod.ProcPath("pressure")))
+		return
+	}
+
+	report.Event(mb.Event{MetricSetFields: event})
+}
@@ -0,0 +1,78 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package pressure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestData(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig("./_meta/testdata"))
+
+	if err := mbtest.WriteEventsReporterV2(f, t, ""); err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig("./_meta/testdata"))
+	events, errs := mbtest.ReportingFetchV2(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 1)
+
+	fields := events[0].MetricSetFields
+	assertValue(t, fields, "cpu.some.10.pct", 0.0153)
+	assertValue(t, fields, "cpu.some.total.us", uint64(13428219))
+	assertValue(t, fields, "memory.full.300.pct", 0.0001)
+	assertValue(t, fields, "io.full.60.pct", 0.023)
+	assertValue(t, fields, "io.some.total.us", uint64(64215590))
+
+	_, err := fields.GetValue("cpu.full")
+	assert.Error(t, err)
+}
+
+func TestFetchNoPressure(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig("./_meta"))
+	events, errs := mbtest.ReportingFetchV2(f)
+	assert.Empty(t, events)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "no pressure stall information")
+}
+
+func assertValue(t *testing.T, fields common.MapStr, key string, expected interface{}) {
+	value, err := fields.GetValue(key)
+	if assert.NoError(t, err, key) {
+		assert.Equal(t, expected, value, key)
+	}
+}
+
+func getConfig(hostfs string) map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "linux",
+		"metricsets": []string{"pressure"},
+		"hostfs":     hostfs,
+	}
+}
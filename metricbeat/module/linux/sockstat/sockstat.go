@@ -0,0 +1,129 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package sockstat
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/beats/metricbeat/module/linux"
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("linux", "sockstat", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+		mb.DefaultMetricSet(),
+	)
+}
+
+// MetricSet reads the socket usage statistics of the host.
+type MetricSet struct {
+	mb.BaseMetricSet
+	mod *linux.Module
+}
+
+// New creates a new instance of the sockstat metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The linux sockstat metricset is beta.")
+
+	mod, ok := base.Module().(*linux.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	return &MetricSet{BaseMetricSet: base, mod: mod}, nil
+}
+
+// Fetch reports one event with the statistics of /proc/net/sockstat, and of
+// /proc/net/sockstat6 under ipv6 if IPv6 is enabled in the host.
+func (m *MetricSet) Fetch(report mb.ReporterV2) {
+	path := m.mod.ProcPath("net", "sockstat")
+	event, err := readSockstat(path)
+	if err != nil {
+		report.Error(errors.Wrapf(err, "failed reading %s", path))
+		return
+	}
+
+	path = m.mod.ProcPath("net", "sockstat6")
+	ipv6, err := readSockstat(path)
+	switch {
+	case err == nil:
+		event["ipv6"] = ipv6
+	case !os.IsNotExist(err):
+		report.Error(errors.Wrapf(err, "failed reading %s", path))
+		return
+	}
+
+	report.Event(mb.Event{MetricSetFields: event})
+}
+
+func readSockstat(path string) (common.MapStr, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return parseSockstat(f)
+}
+
+// parseSockstat parses the content of a sockstat file, with a line for each
+// protocol as in "TCP: inuse 27 orphan 2 tw 45 alloc 31 mem 9". Protocol
+// names are reported in lower case and without the 6 suffix of sockstat6.
+func parseSockstat(r io.Reader) (common.MapStr, error) {
+	sockstat := common.MapStr{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields)%2 != 1 {
+			return nil, errors.Errorf("invalid line '%s'", scanner.Text())
+		}
+
+		protocol := strings.ToLower(strings.TrimSuffix(fields[0], ":"))
+		protocol = strings.TrimSuffix(protocol, "6")
+		if protocol == "udplite" {
+			protocol = "udp_lite"
+		}
+
+		stats := common.MapStr{}
+		for i := 1; i < len(fields); i += 2 {
+			value, err := strconv.ParseInt(fields[i+1], 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid value for %s %s", protocol, fields[i])
+			}
+			stats[fields[i]] = value
+		}
+		sockstat[protocol] = stats
+	}
+
+	return sockstat, scanner.Err()
+}
@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package sockstat
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestData(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig("./_meta/testdata"))
+
+	if err := mbtest.WriteEventsReporterV2(f, t, ""); err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig("./_meta/testdata"))
+	events, errs := mbtest.ReportingFetchV2(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 1)
+
+	assert.Equal(t, common.MapStr{
+		"sockets":  common.MapStr{"used": int64(1063)},
+		"tcp":      common.MapStr{"inuse": int64(27), "orphan": int64(2), "tw": int64(45), "alloc": int64(31), "mem": int64(9)},
+		"udp":      common.MapStr{"inuse": int64(8), "mem": int64(4)},
+		"udp_lite": common.MapStr{"inuse": int64(0)},
+		"raw":      common.MapStr{"inuse": int64(1)},
+		"frag":     common.MapStr{"inuse": int64(0), "memory": int64(0)},
+		"ipv6": common.MapStr{
+			"tcp":      common.MapStr{"inuse": int64(6)},
+			"udp":      common.MapStr{"inuse": int64(3)},
+			"udp_lite": common.MapStr{"inuse": int64(0)},
+			"raw":      common.MapStr{"inuse": int64(1)},
+			"frag":     common.MapStr{"inuse": int64(0), "memory": int64(0)},
+		},
+	}, events[0].MetricSetFields)
+}
+
+func TestFetchWithoutIPv6(t *testing.T) {
+	hostfs, err := ioutil.TempDir("", "sockstat")
+	require.NoError(t, err)
+	defer os.RemoveAll(hostfs)
+
+	content, err := ioutil.ReadFile("./_meta/testdata/proc/net/sockstat")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(hostfs, "proc", "net"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(hostfs, "proc", "net", "sockstat"), content, 0644))
+
+	f := mbtest.NewReportingMetricSetV2(t, getConfig(hostfs))
+	events, errs := mbtest.ReportingFetchV2(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 1)
+	assert.NotContains(t, events[0].MetricSetFields, "ipv6")
+	assert.Contains(t, events[0].MetricSetFields, "tcp")
+}
+
+func TestParseSockstat(t *testing.T) {
+	_, err := parseSockstat(strings.NewReader("TCP: inuse\n"))
+	assert.Error(t, err)
+
+	_, err = parseSockstat(strings.NewReader("TCP: inuse x\n"))
+	assert.Error(t, err)
+}
+
+func getConfig(hostfs string) map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "linux",
+		"metricsets": []string{"sockstat"},
+		"hostfs":     hostfs,
+	}
+}
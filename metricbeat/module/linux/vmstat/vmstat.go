@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package vmstat
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/beats/metricbeat/module/linux"
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("linux", "vmstat", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+		mb.DefaultMetricSet(),
+	)
+}
+
+// MetricSet reads the virtual memory counters of the host.
+type MetricSet struct {
+	mb.BaseMetricSet
+	mod *linux.Module
+}
+
+// New creates a new instance of the vmstat metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The linux vmstat metricset is beta.")
+
+	mod, ok := base.Module().(*linux.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	return &MetricSet{BaseMetricSet: base, mod: mod}, nil
+}
+
+// Fetch reports one event with all the counters found in /proc/vmstat.
+func (m *MetricSet) Fetch(report mb.ReporterV2) {
+	path := m.mod.ProcPath("vmstat")
+	f, err := os.Open(path)
+	if err != nil {
+		report.Error(errors.Wrap(err, "failed to open vmstat"))
+		return
+	}
+	defer f.Close()
+
+	vmstat, err := parseVMStat(f)
+	if err != nil {
+		report.Error(errors.Wrapf(err, "failed to parse %s", path))
+		return
+	}
+
+	report.Event(mb.Event{MetricSetFields: vmstat})
+}
+
+// parseVMStat parses the content of /proc/vmstat, with a "name value" counter
+// per line.
+func parseVMStat(r io.Reader) (common.MapStr, error) {
+	vmstat := common.MapStr{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, errors.Errorf("invalid line '%s'", scanner.Text())
+		}
+
+		value, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for %s", fields[0])
+		}
+		vmstat[fields[0]] = value
+	}
+
+	return vmstat, scanner.Err()
+}
@@ -0,0 +1,73 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package vmstat
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestData(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig())
+
+	if err := mbtest.WriteEventsReporterV2(f, t, ""); err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig())
+	events, errs := mbtest.ReportingFetchV2(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 1)
+
+	fields := events[0].MetricSetFields
+	assert.Len(t, fields, 49)
+	assert.Equal(t, int64(9421364), fields["pgpgin"])
+	assert.Equal(t, int64(4871), fields["pswpout"])
+	assert.Equal(t, int64(28364), fields["pgmajfault"])
+	assert.Equal(t, int64(2), fields["oom_kill"])
+}
+
+func TestParseVMStat(t *testing.T) {
+	vmstat, err := parseVMStat(strings.NewReader("pgpgin 10\n\npgpgout 20\n"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), vmstat["pgpgin"])
+	assert.Equal(t, int64(20), vmstat["pgpgout"])
+
+	_, err = parseVMStat(strings.NewReader("pgpgin\n"))
+	assert.Error(t, err)
+
+	_, err = parseVMStat(strings.NewReader("pgpgin ten\n"))
+	assert.Error(t, err)
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "linux",
+		"metricsets": []string{"vmstat"},
+		"hostfs":     "./_meta/testdata",
+	}
+}
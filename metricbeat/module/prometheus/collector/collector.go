@@ -19,6 +19,9 @@ package collector
 
 import (
 	"fmt"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
 
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/cfgwarn"
@@ -50,21 +53,31 @@ func init() {
 type MetricSet struct {
 	mb.BaseMetricSet
 	prometheus p.Prometheus
-	namespace  string
+	config     config
+
+	// counters keeps the last value of each counter to calculate rates
+	counters map[string]counterSample
+}
+
+type counterSample struct {
+	value float64
+	time  time.Time
 }
 
 func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	cfgwarn.Beta("The prometheus collector metricset is beta")
 
-	config := struct {
-		Namespace string `config:"namespace" validate:"required"`
-	}{}
+	config := defaultConfig
 	err := base.Module().UnpackConfig(&config)
 	if err != nil {
 		return nil, err
 	}
 
-	prometheus, err := p.NewPrometheusClient(base)
+	newClient := p.NewPrometheusClient
+	if config.OpenMetrics {
+		newClient = p.NewOpenMetricsClient
+	}
+	prometheus, err := newClient(base)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +85,8 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	return &MetricSet{
 		BaseMetricSet: base,
 		prometheus:    prometheus,
-		namespace:     config.Namespace,
+		config:        config,
+		counters:      map[string]counterSample{},
 	}, nil
 }
 
@@ -83,31 +97,99 @@ func (m *MetricSet) Fetch() ([]common.MapStr, error) {
 		return nil, fmt.Errorf("Unable to decode response from prometheus endpoint")
 	}
 
+	return m.eventsFromFamilies(families, time.Now()), nil
+}
+
+// eventsFromFamilies groups the metrics of the families passing the filters by labels
+func (m *MetricSet) eventsFromFamilies(families []*dto.MetricFamily, now time.Time) []common.MapStr {
 	eventList := map[string]common.MapStr{}
+	counters := map[string]counterSample{}
 
 	for _, family := range families {
+		if !m.config.MetricsFilters.matches(family.GetName()) {
+			continue
+		}
+
 		promEvents := GetPromEventsFromMetricFamily(family)
 
 		for _, promEvent := range promEvents {
-			if _, ok := eventList[promEvent.labelHash]; !ok {
-				eventList[promEvent.labelHash] = common.MapStr{}
+			labels := m.targetLabels(promEvent.labels)
+			labelHash := labels.String()
+
+			if _, ok := eventList[labelHash]; !ok {
+				eventList[labelHash] = common.MapStr{}
 
 				// Add labels
-				if len(promEvent.labels) > 0 {
-					eventList[promEvent.labelHash]["label"] = promEvent.labels
+				if len(labels) > 0 {
+					eventList[labelHash]["label"] = labels
 				}
 			}
 
-			eventList[promEvent.labelHash][promEvent.key] = promEvent.value
+			if m.config.RateCounters && promEvent.counter != nil {
+				key := promEvent.key + labelHash
+				current := counterSample{value: *promEvent.counter, time: now}
+				counters[key] = current
+				if rate, ok := counterRate(m.counters[key], current); ok {
+					promEvent.value["rate"] = rate
+				}
+			}
+
+			eventList[labelHash][promEvent.key] = promEvent.value
 		}
 	}
 
+	// Only keep the counters still exposed
+	m.counters = counters
+
 	// Converts hash list to slice
 	events := []common.MapStr{}
 	for _, e := range eventList {
-		e[mb.NamespaceKey] = m.namespace
+		e[mb.NamespaceKey] = m.config.Namespace
 		events = append(events, e)
 	}
 
-	return events, err
+	return events
+}
+
+// targetLabels adds the instance and job labels of the target to the labels of a metric
+// when honor_labels is set. If a metric has labels with the same names, they are kept
+// when honor_labels is enabled, otherwise they are renamed with an exported_ prefix.
+func (m *MetricSet) targetLabels(labels common.MapStr) common.MapStr {
+	if m.config.HonorLabels == nil {
+		return labels
+	}
+
+	result := common.MapStr{}
+	for k, v := range labels {
+		result[k] = v
+	}
+
+	target := common.MapStr{
+		"instance": m.Host(),
+		"job":      m.config.Namespace,
+	}
+	for k, v := range target {
+		existing, found := result[k]
+		if found {
+			if *m.config.HonorLabels {
+				continue
+			}
+			result["exported_"+k] = existing
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// counterRate returns the per second rate between two samples of a counter. No rate
+// is returned on the first sample or when the counter was reset.
+func counterRate(previous, current counterSample) (float64, bool) {
+	if previous.time.IsZero() || current.value < previous.value {
+		return 0, false
+	}
+	elapsed := current.time.Sub(previous.time).Seconds()
+	if elapsed <= 0 {
+		return 0, false
+	}
+	return (current.value - previous.value) / elapsed, true
 }
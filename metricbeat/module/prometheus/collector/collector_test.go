@@ -20,13 +20,20 @@
 package collector
 
 import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
 
 	"github.com/golang/protobuf/proto"
 	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetPromEventsFromMetricFamily(t *testing.T) {
@@ -63,6 +70,7 @@ func TestGetPromEventsFromMetricFamily(t *testing.T) {
 				},
 				labelHash: labels.String(),
 				labels:    labels,
+				counter:   proto.Float64(10),
 			},
 		},
 		{
@@ -111,8 +119,8 @@ func TestGetPromEventsFromMetricFamily(t *testing.T) {
 				value: common.MapStr{
 					"count": uint64(10),
 					"sum":   float64(10),
-					"percentile": common.MapStr{
-						"99": float64(10),
+					"quantile": []common.MapStr{
+						{"quantile": float64(0.99), "value": float64(10)},
 					},
 				},
 				labelHash: "#",
@@ -143,8 +151,8 @@ func TestGetPromEventsFromMetricFamily(t *testing.T) {
 				value: common.MapStr{
 					"count": uint64(10),
 					"sum":   float64(10),
-					"bucket": common.MapStr{
-						"0.99": uint64(10),
+					"bucket": []common.MapStr{
+						{"le": float64(0.99), "count": uint64(10)},
 					},
 				},
 				labelHash: "#",
@@ -158,3 +166,162 @@ func TestGetPromEventsFromMetricFamily(t *testing.T) {
 		assert.Equal(t, event[0], test.Event)
 	}
 }
+
+func TestGetPromEventsFromHistogramAndUntyped(t *testing.T) {
+	events := GetPromEventsFromMetricFamily(&dto.MetricFamily{
+		Name: proto.String("request_duration_seconds"),
+		Type: dto.MetricType_HISTOGRAM.Enum(),
+		Metric: []*dto.Metric{
+			{
+				Histogram: &dto.Histogram{
+					SampleCount: proto.Uint64(3),
+					SampleSum:   proto.Float64(0.5),
+					Bucket: []*dto.Bucket{
+						{UpperBound: proto.Float64(0.1), CumulativeCount: proto.Uint64(1)},
+						{UpperBound: proto.Float64(1), CumulativeCount: proto.Uint64(3)},
+						{UpperBound: proto.Float64(math.Inf(1)), CumulativeCount: proto.Uint64(3)},
+					},
+				},
+			},
+		},
+	})
+	require.Len(t, events, 1)
+	assert.Equal(t, []common.MapStr{
+		{"le": 0.1, "count": uint64(1)},
+		{"le": float64(1), "count": uint64(3)},
+	}, events[0].value["bucket"])
+
+	events = GetPromEventsFromMetricFamily(&dto.MetricFamily{
+		Name: proto.String("untyped_metric"),
+		Type: dto.MetricType_UNTYPED.Enum(),
+		Metric: []*dto.Metric{
+			{Untyped: &dto.Untyped{Value: proto.Float64(7)}},
+		},
+	})
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{"value": float64(7)}, events[0].value)
+}
+
+func newTestMetricSet(t *testing.T, config map[string]interface{}) *MetricSet {
+	config["module"] = "prometheus"
+	config["metricsets"] = []string{"collector"}
+	config["hosts"] = []string{"localhost:9090"}
+	config["namespace"] = "test"
+	return mbtest.NewEventsFetcher(t, config).(*MetricSet)
+}
+
+func counterFamily(name string, value float64, labels ...*dto.LabelPair) *dto.MetricFamily {
+	return &dto.MetricFamily{
+		Name: proto.String(name),
+		Type: dto.MetricType_COUNTER.Enum(),
+		Metric: []*dto.Metric{
+			{
+				Label:   labels,
+				Counter: &dto.Counter{Value: proto.Float64(value)},
+			},
+		},
+	}
+}
+
+func TestMetricsFilters(t *testing.T) {
+	m := newTestMetricSet(t, map[string]interface{}{
+		"metrics_filters.include": []string{"http_*", "process_cpu_seconds_total"},
+		"metrics_filters.exclude": []string{"http_*_bucket"},
+	})
+
+	events := m.eventsFromFamilies([]*dto.MetricFamily{
+		counterFamily("http_requests_total", 1),
+		counterFamily("http_request_duration_bucket", 2),
+		counterFamily("process_cpu_seconds_total", 3),
+		counterFamily("go_goroutines", 4),
+	}, time.Now())
+
+	require.Len(t, events, 1)
+	assert.Contains(t, events[0], "http_requests_total")
+	assert.Contains(t, events[0], "process_cpu_seconds_total")
+	assert.NotContains(t, events[0], "http_request_duration_bucket")
+	assert.NotContains(t, events[0], "go_goroutines")
+}
+
+func TestMetricsFiltersValidation(t *testing.T) {
+	c := config{MetricsFilters: metricsFilters{Include: []string{"[a-"}}}
+	assert.Error(t, c.Validate())
+}
+
+func TestRateCounters(t *testing.T) {
+	m := newTestMetricSet(t, map[string]interface{}{
+		"rate_counters": true,
+	})
+
+	now := time.Now()
+	events := m.eventsFromFamilies([]*dto.MetricFamily{counterFamily("requests_total", 100)}, now)
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{"value": int64(100)}, events[0]["requests_total"])
+
+	events = m.eventsFromFamilies([]*dto.MetricFamily{counterFamily("requests_total", 150)}, now.Add(10*time.Second))
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{"value": int64(150), "rate": float64(5)}, events[0]["requests_total"])
+
+	// Counter reset
+	events = m.eventsFromFamilies([]*dto.MetricFamily{counterFamily("requests_total", 20)}, now.Add(20*time.Second))
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{"value": int64(20)}, events[0]["requests_total"])
+}
+
+func TestHonorLabels(t *testing.T) {
+	family := counterFamily("requests_total", 1,
+		&dto.LabelPair{Name: proto.String("instance"), Value: proto.String("exported:9100")},
+		&dto.LabelPair{Name: proto.String("code"), Value: proto.String("200")},
+	)
+
+	// Target labels are not added by default
+	m := newTestMetricSet(t, map[string]interface{}{})
+	events := m.eventsFromFamilies([]*dto.MetricFamily{family}, time.Now())
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"instance": "exported:9100",
+		"code":     "200",
+	}, events[0]["label"])
+
+	m = newTestMetricSet(t, map[string]interface{}{"honor_labels": true})
+	events = m.eventsFromFamilies([]*dto.MetricFamily{family}, time.Now())
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"instance": "exported:9100",
+		"job":      "test",
+		"code":     "200",
+	}, events[0]["label"])
+
+	m = newTestMetricSet(t, map[string]interface{}{"honor_labels": false})
+	events = m.eventsFromFamilies([]*dto.MetricFamily{family}, time.Now())
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"instance":          "localhost:9090",
+		"exported_instance": "exported:9100",
+		"job":               "test",
+		"code":              "200",
+	}, events[0]["label"])
+}
+
+func TestOpenMetricsAcceptHeader(t *testing.T) {
+	var accept string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accept = r.Header.Get("Accept")
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		w.Write([]byte("# TYPE up gauge\nup 1\n"))
+	}))
+	defer server.Close()
+
+	for _, openMetrics := range []bool{false, true} {
+		f := mbtest.NewEventsFetcher(t, map[string]interface{}{
+			"module":      "prometheus",
+			"metricsets":  []string{"collector"},
+			"hosts":       []string{server.URL},
+			"namespace":   "test",
+			"openmetrics": openMetrics,
+		})
+		_, err := f.Fetch()
+		require.NoError(t, err)
+		assert.Equal(t, openMetrics, strings.Contains(accept, "application/openmetrics-text"), accept)
+	}
+}
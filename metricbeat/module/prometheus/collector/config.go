@@ -0,0 +1,68 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package collector
+
+import (
+	"fmt"
+	"path"
+)
+
+type metricsFilters struct {
+	Include []string `config:"include"`
+	Exclude []string `config:"exclude"`
+}
+
+type config struct {
+	Namespace      string         `config:"namespace" validate:"required"`
+	MetricsFilters metricsFilters `config:"metrics_filters"`
+	RateCounters   bool           `config:"rate_counters"`
+	OpenMetrics    bool           `config:"openmetrics"`
+
+	// HonorLabels decides how the target labels are added, they are only
+	// added when it is set
+	HonorLabels *bool `config:"honor_labels"`
+}
+
+var defaultConfig = config{}
+
+// Validate checks that the metrics filters are valid glob patterns
+func (c *config) Validate() error {
+	for _, pattern := range append(c.MetricsFilters.Include, c.MetricsFilters.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid metrics filter %q: %v", pattern, err)
+		}
+	}
+	return nil
+}
+
+// matches returns true if a metric passes the include and exclude filters
+func (f *metricsFilters) matches(name string) bool {
+	if len(f.Include) > 0 && !matchAny(f.Include, name) {
+		return false
+	}
+	return !matchAny(f.Exclude, name)
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -19,7 +19,6 @@ package collector
 
 import (
 	"math"
-	"strconv"
 
 	"github.com/elastic/beats/libbeat/common"
 
@@ -31,6 +30,9 @@ type PromEvent struct {
 	value     common.MapStr
 	labels    common.MapStr
 	labelHash string
+
+	// counter holds the raw value of counters, used to calculate rates
+	counter *float64
 }
 
 func GetPromEventsFromMetricFamily(mf *dto.MetricFamily) []PromEvent {
@@ -61,6 +63,7 @@ func GetPromEventsFromMetricFamily(mf *dto.MetricFamily) []PromEvent {
 		counter := metric.GetCounter()
 		if counter != nil {
 			value["value"] = int64(counter.GetValue())
+			event.counter = counter.Value
 		}
 
 		gauge := metric.GetGauge()
@@ -68,25 +71,29 @@ func GetPromEventsFromMetricFamily(mf *dto.MetricFamily) []PromEvent {
 			value["value"] = gauge.GetValue()
 		}
 
+		untyped := metric.GetUntyped()
+		if untyped != nil {
+			value["value"] = untyped.GetValue()
+		}
+
 		summary := metric.GetSummary()
 		if summary != nil {
 			value["sum"] = summary.GetSampleSum()
 			value["count"] = summary.GetSampleCount()
 
-			quantiles := summary.GetQuantile()
-
-			percentileMap := common.MapStr{}
-			for _, quantile := range quantiles {
-				key := strconv.FormatFloat((100 * quantile.GetQuantile()), 'f', -1, 64)
-
-				if math.IsNaN(quantile.GetValue()) == false {
-					percentileMap[key] = quantile.GetValue()
+			var quantiles []common.MapStr
+			for _, quantile := range summary.GetQuantile() {
+				if math.IsNaN(quantile.GetValue()) {
+					continue
 				}
-
+				quantiles = append(quantiles, common.MapStr{
+					"quantile": quantile.GetQuantile(),
+					"value":    quantile.GetValue(),
+				})
 			}
 
-			if len(percentileMap) != 0 {
-				value["percentile"] = percentileMap
+			if len(quantiles) != 0 {
+				value["quantile"] = quantiles
 			}
 		}
 
@@ -94,14 +101,22 @@ func GetPromEventsFromMetricFamily(mf *dto.MetricFamily) []PromEvent {
 		if histogram != nil {
 			value["sum"] = histogram.GetSampleSum()
 			value["count"] = histogram.GetSampleCount()
-			buckets := histogram.GetBucket()
-			bucketMap := common.MapStr{}
-			for _, bucket := range buckets {
-				key := strconv.FormatFloat(bucket.GetUpperBound(), 'f', -1, 64)
-				bucketMap[key] = bucket.GetCumulativeCount()
+
+			// The +Inf bucket is left out, its count is the count of the histogram
+			var buckets []common.MapStr
+			for _, bucket := range histogram.GetBucket() {
+				if math.IsInf(bucket.GetUpperBound(), 0) {
+					continue
+				}
+				buckets = append(buckets, common.MapStr{
+					"le":    bucket.GetUpperBound(),
+					"count": bucket.GetCumulativeCount(),
+				})
 			}
 
-			value["bucket"] = bucketMap
+			if len(buckets) != 0 {
+				value["bucket"] = buckets
+			}
 		}
 
 		event.value = value
@@ -31,5 +31,5 @@ func init() {
 
 // Asset returns asset data
 func Asset() string {
-	return "eJy0ksGumzAQRfd8xVX2jw9g0U33T5W6rCrkwAWsGA/1DE/K31cuCY9QomzaWQ7jew5jv+HCa4UpyUgbOGsBmLfACqdva/NUAC21SX4yL7HClwIAvpszRSMhsDG26JKM+DxVFoAOkqxuJHa+r9C5oCyAxECnrNC7PEMzH3ut8OOkGk4/C6DzDK1WfzBviG7kTjKXXaeckWSebp0DycesJe+Rf+8uFM0/tXaPIE9BSy1bcWeZDTZwsxAo0wdTuRlfTc40t+nvnbeGUcx3vnEZrg8Tz2xfGOd634QuOyh3M0dKW61fM2fWgbG34a+hu1mQ2B98fCGX6+ucEqMtGCyYveKnTJtkmtj+B4/3eTwzQbo742bED8b90jYvt6EqtZSJse7a42s7kHp1a6tMDkbnA9cjkp7YqElyPctmmONFa5N6YlKv9s+lRo6SrlhAsMEZXGJ+wLjScMOyhQlar5ey+B0AAP//Qe07WA=="
+	return "eJy8lMFu4zYQhu96ioEvAYJED+BDD+21CFoE6KUoZEr6JbGmOAxn6KzffiFLtuXYjr2LYAGdZqiZ7/9nyGdaY7ukELmHdkiSEalVhyUt/joEFxlRDamiDWrZL+m3jIjoVY0KVewcKkVNTeSejn/lGZF0HLWo2De2XVJjnCAjinAwgiW1ZjgDVetbWdK/CxG3+C8jaixcLctdm2fypscHyCGh2zDUiJzCFLkAeVprXu/hMX/My1StoQ+H5L4ql/+j0ll4DBRj1kMU9Sx7qojoE5zx+33XV4gb6qwot9H0cy/LLWmHfYAj9dBoK4E+kREy5KwocXNSdGQUerfa7X5PISBSycnXtHJYkfH1LlGlPjmjdgNaVZy8rgaSITMakh8MuWzbWzJercOvN+7vqfPOOkl9b6LF1zu32iscPbMqtNoYl7DKzxyJ6FlRvEermFU9X88TwSX0Tsmvpg8OQhEV7Ob8opEgbhAn+jnNkfX8GswVOFPCnWSujvNspGts3znOZ3pDzvD9OTSU/c4Jhhk+Eb5VCLoLjeu+W7n8IvI01Z+ErjmVDtdPFL0Jwfp2Or54XPyYvn+GVTnqGwf4ROX2srC9KBle1Jsr9Env8Uk2JafRxrMlye9Yxc82xbPaxlZmuJqXzf9Ie4dbL7OiowdzzGtIc6y3hITCwbfanR3akzn27YXkDbjh+yPFCK9jGxrb5Fdh6sghnLxyX8XxkvoScVirqcdEhA38R9P2NCFyBRFIzgG+aGrJ7oS6AXSEGQpTYx0OGjheoRHlaFrkVZf8WgrlIiCKFf1yqB49xy2NjUg7o2QiyLPSFkpTW9SkTLWVdZ59HwB4E4Hi"
 }
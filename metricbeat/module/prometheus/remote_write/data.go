@@ -0,0 +1,103 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package remote_write
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+// metricNameLabel is the label holding the name of the metric of a series
+const metricNameLabel = "__name__"
+
+// decodeWriteRequest decodes a snappy compressed protobuf WriteRequest
+func decodeWriteRequest(data []byte) (*writeRequest, error) {
+	decoded, err := snappy.Decode(nil, data)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing write request: %v", err)
+	}
+
+	var req writeRequest
+	if err := proto.Unmarshal(decoded, &req); err != nil {
+		return nil, fmt.Errorf("error decoding write request: %v", err)
+	}
+	return &req, nil
+}
+
+// eventsFromWriteRequest converts the samples of a write request to events. Samples
+// with the same labels and timestamp are grouped in a single event, in the order
+// they were received. Values are stored under metrics, apart from the labels so
+// metric names cannot collide with them.
+func eventsFromWriteRequest(req *writeRequest) []mb.Event {
+	var events []mb.Event
+	index := map[string]int{}
+
+	for _, series := range req.Timeseries {
+		name := ""
+		labels := common.MapStr{}
+		for _, l := range series.Labels {
+			if l.Name == metricNameLabel {
+				name = l.Value
+				continue
+			}
+			if l.Name != "" && l.Value != "" {
+				labels[l.Name] = l.Value
+			}
+		}
+		if name == "" {
+			continue
+		}
+		labelHash := labels.String()
+
+		for _, s := range series.Samples {
+			// Stale markers and infinite values cannot be stored
+			if math.IsNaN(s.Value) || math.IsInf(s.Value, 0) {
+				continue
+			}
+
+			key := labelHash + "@" + strconv.FormatInt(s.Timestamp, 10)
+			i, found := index[key]
+			if !found {
+				event := mb.Event{
+					Timestamp: time.Unix(0, s.Timestamp*int64(time.Millisecond)).UTC(),
+					MetricSetFields: common.MapStr{
+						"metrics": common.MapStr{},
+					},
+				}
+				if len(labels) > 0 {
+					event.MetricSetFields["label"] = labels.Clone()
+				}
+				i = len(events)
+				index[key] = i
+				events = append(events, event)
+			}
+
+			events[i].MetricSetFields["metrics"].(common.MapStr)[name] = s.Value
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,175 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package remote_write
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func encodeWriteRequest(t *testing.T, req *writeRequest) []byte {
+	data, err := proto.Marshal(req)
+	require.NoError(t, err)
+	return snappy.Encode(nil, data)
+}
+
+func TestDecodeWriteRequest(t *testing.T) {
+	req := &writeRequest{
+		Timeseries: []*timeSeries{
+			{
+				Labels: []*label{
+					{Name: "__name__", Value: "up"},
+					{Name: "job", Value: "node"},
+				},
+				Samples: []*sample{{Value: 1, Timestamp: 1538395200000}},
+			},
+		},
+	}
+
+	decoded, err := decodeWriteRequest(encodeWriteRequest(t, req))
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(req, decoded))
+
+	_, err = decodeWriteRequest([]byte("not snappy"))
+	assert.Error(t, err)
+
+	_, err = decodeWriteRequest(snappy.Encode(nil, []byte{0xff, 0xff}))
+	assert.Error(t, err)
+}
+
+func TestEventsFromWriteRequest(t *testing.T) {
+	ts := int64(1538395200000)
+	req := &writeRequest{
+		Timeseries: []*timeSeries{
+			{
+				Labels: []*label{
+					{Name: "__name__", Value: "node_load1"},
+					{Name: "instance", Value: "host1:9100"},
+					{Name: "job", Value: "node"},
+				},
+				Samples: []*sample{
+					{Value: 0.5, Timestamp: ts},
+					{Value: 0.7, Timestamp: ts + 15000},
+				},
+			},
+			{
+				Labels: []*label{
+					{Name: "__name__", Value: "node_load5"},
+					{Name: "job", Value: "node"},
+					{Name: "instance", Value: "host1:9100"},
+				},
+				Samples: []*sample{
+					{Value: 0.3, Timestamp: ts},
+					{Value: math.NaN(), Timestamp: ts + 15000},
+				},
+			},
+			{
+				Labels: []*label{
+					{Name: "__name__", Value: "node_load1"},
+					{Name: "instance", Value: "host2:9100"},
+					{Name: "job", Value: "node"},
+				},
+				Samples: []*sample{{Value: 1.5, Timestamp: ts}},
+			},
+			{
+				Labels: []*label{
+					{Name: "__name__", Value: "scrape_samples_scraped"},
+				},
+				Samples: []*sample{{Value: 42, Timestamp: ts}},
+			},
+			{
+				Labels: []*label{
+					{Name: "job", Value: "no_name"},
+				},
+				Samples: []*sample{{Value: 1, Timestamp: ts}},
+			},
+		},
+	}
+
+	events := eventsFromWriteRequest(req)
+	require.Len(t, events, 4)
+
+	host1 := common.MapStr{"instance": "host1:9100", "job": "node"}
+
+	assert.Equal(t, time.Unix(1538395200, 0).UTC(), events[0].Timestamp)
+	assert.Equal(t, common.MapStr{
+		"label": host1,
+		"metrics": common.MapStr{
+			"node_load1": 0.5,
+			"node_load5": 0.3,
+		},
+	}, events[0].MetricSetFields)
+
+	assert.Equal(t, time.Unix(1538395215, 0).UTC(), events[1].Timestamp)
+	assert.Equal(t, common.MapStr{
+		"label":   host1,
+		"metrics": common.MapStr{"node_load1": 0.7},
+	}, events[1].MetricSetFields)
+
+	assert.Equal(t, common.MapStr{
+		"label":   common.MapStr{"instance": "host2:9100", "job": "node"},
+		"metrics": common.MapStr{"node_load1": 1.5},
+	}, events[2].MetricSetFields)
+
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{"scrape_samples_scraped": float64(42)},
+	}, events[3].MetricSetFields)
+}
+
+func TestEventsFromWriteRequestLabelMetric(t *testing.T) {
+	ts := int64(1538395200000)
+	req := &writeRequest{
+		Timeseries: []*timeSeries{
+			{
+				Labels: []*label{
+					{Name: "__name__", Value: "label"},
+					{Name: "job", Value: "node"},
+				},
+				Samples: []*sample{{Value: 1, Timestamp: ts}},
+			},
+			{
+				Labels: []*label{
+					{Name: "__name__", Value: "metrics"},
+					{Name: "job", Value: "node"},
+				},
+				Samples: []*sample{{Value: 2, Timestamp: ts}},
+			},
+		},
+	}
+
+	events := eventsFromWriteRequest(req)
+	require.Len(t, events, 1)
+
+	assert.Equal(t, common.MapStr{
+		"label": common.MapStr{"job": "node"},
+		"metrics": common.MapStr{
+			"label":   float64(1),
+			"metrics": float64(2),
+		},
+	}, events[0].MetricSetFields)
+}
@@ -0,0 +1,81 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package remote_write
+
+import (
+	"fmt"
+
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	serverhelper "github.com/elastic/beats/metricbeat/helper/server"
+	"github.com/elastic/beats/metricbeat/helper/server/http"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("prometheus", "remote_write", New)
+}
+
+// MetricSet receives the samples pushed by Prometheus servers with remote_write
+type MetricSet struct {
+	mb.BaseMetricSet
+	server serverhelper.Server
+}
+
+// New creates a new remote_write metricset listening on the configured host and port
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The prometheus remote_write metricset is beta")
+
+	svc, err := http.NewHttpServer(base)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		server:        svc,
+	}, nil
+}
+
+// Run starts the HTTP server and reports the samples of each write request received
+func (m *MetricSet) Run(reporter mb.PushReporterV2) {
+	m.server.Start()
+
+	for {
+		select {
+		case <-reporter.Done():
+			m.server.Stop()
+			return
+		case msg := <-m.server.GetEvents():
+			data, ok := msg.GetEvent()[serverhelper.EventDataKey].([]byte)
+			if !ok {
+				reporter.Error(fmt.Errorf("write request without data"))
+				continue
+			}
+
+			req, err := decodeWriteRequest(data)
+			if err != nil {
+				reporter.Error(err)
+				continue
+			}
+
+			for _, event := range eventsFromWriteRequest(req) {
+				reporter.Event(event)
+			}
+		}
+	}
+}
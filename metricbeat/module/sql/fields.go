@@ -0,0 +1,35 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by beats/dev-tools/cmd/asset/asset.go - DO NOT EDIT.
+
+package sql
+
+import (
+	"github.com/elastic/beats/libbeat/asset"
+)
+
+func init() {
+	if err := asset.SetFields("metricbeat", "sql", Asset); err != nil {
+		panic(err)
+	}
+}
+
+// Asset returns asset data
+func Asset() string {
+	return "eJykkk9v1DAQxe/5FE89IlrBBaEcOKBekCrQstyRHb/tmjp2Oh4v5NujJA1d0qh/topPM5P3fvPsc9ywr5FvQwWo18AaZ9vN1VkFOOZGfKc+xRqfKgDYbq7QJlcCK0AYaDJrWKqpgJ1ncLkeB88RTctZePi071jjWlLp7ior+sP5zlyCZqQdbgvFM0PT6OyMGmsy88Xd8LHjsevwX/+vuub9iP+CwYwU/WwJPFwcWMc5RnLiD5T/WjPXDfvfSdyi9wjdcC5HPZRMN8QjJUL3XLKuoizTeSXJZpCD7o1CqEXiQLQnWqr4Jj8BM0/F0lJ8c/FmFSzZX2x00ZqKPyd0l4odX+ULyL9OnjiYUJjv6W1/n+Vb2B5NCqWNSIKDEW9s4BjlM1fLKj5en7zZSZfyg3/09L1waZT5gagRQtglUTr4iC/bbx8/vHuPXZLW6DPTsCkFmviSOJ7Y9fOk+Ipr/DsA9BNZ2w=="
+}
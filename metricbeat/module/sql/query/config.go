@@ -0,0 +1,116 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	tableFormat     = "table"
+	variablesFormat = "variables"
+)
+
+// driverAliases are the alternative names accepted for the drivers.
+var driverAliases = map[string]string{
+	"postgresql": "postgres",
+}
+
+// Config is the configuration of the query metricset.
+type Config struct {
+	Driver string `config:"driver" validate:"required"`
+
+	// Single query, for simple configurations.
+	Query          string `config:"sql_query"`
+	ResponseFormat string `config:"sql_response_format"`
+
+	Queries []QueryConfig `config:"sql_queries"`
+}
+
+// QueryConfig is the configuration of each query.
+type QueryConfig struct {
+	Query          string `config:"query" validate:"required"`
+	ResponseFormat string `config:"response_format"`
+}
+
+func defaultConfig() Config {
+	return Config{
+		ResponseFormat: tableFormat,
+	}
+}
+
+// Validate validates the driver and the queries of the configuration.
+func (c *Config) Validate() error {
+	driver := c.driverName()
+	if !isRegisteredDriver(driver) {
+		return fmt.Errorf("unknown driver '%s', available drivers are %s",
+			c.Driver, strings.Join(sql.Drivers(), ", "))
+	}
+
+	if c.Query == "" && len(c.Queries) == 0 {
+		return errors.New("no queries configured, set sql_query or sql_queries")
+	}
+
+	for _, q := range c.queries() {
+		switch q.ResponseFormat {
+		case tableFormat, variablesFormat:
+		default:
+			return fmt.Errorf("invalid response format '%s' for query '%s', "+
+				"it must be table or variables", q.ResponseFormat, q.Query)
+		}
+	}
+	return nil
+}
+
+// driverName returns the name used to register the configured driver.
+func (c *Config) driverName() string {
+	driver := strings.ToLower(c.Driver)
+	if alias, found := driverAliases[driver]; found {
+		return alias
+	}
+	return driver
+}
+
+// queries returns all the configured queries, with the default response
+// format set when not configured.
+func (c *Config) queries() []QueryConfig {
+	var queries []QueryConfig
+	if c.Query != "" {
+		queries = append(queries, QueryConfig{Query: c.Query, ResponseFormat: c.ResponseFormat})
+	}
+	for _, q := range c.Queries {
+		if q.ResponseFormat == "" {
+			q.ResponseFormat = tableFormat
+		}
+		queries = append(queries, q)
+	}
+	return queries
+}
+
+func isRegisteredDriver(name string) bool {
+	for _, driver := range sql.Drivers() {
+		if driver == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,201 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// Groups of the metrics by type.
+const (
+	numericGroup = "numeric"
+	stringGroup  = "string"
+	booleanGroup = "boolean"
+)
+
+// numericTypes are the database types of the columns reported as numbers.
+var numericTypes = map[string]bool{
+	"TINYINT": true, "SMALLINT": true, "MEDIUMINT": true, "INT": true,
+	"INTEGER": true, "BIGINT": true, "INT2": true, "INT4": true, "INT8": true,
+	"DECIMAL": true, "NUMERIC": true, "FLOAT": true, "FLOAT4": true,
+	"FLOAT8": true, "DOUBLE": true, "REAL": true, "YEAR": true,
+}
+
+// booleanTypes are the database types of the columns reported as booleans.
+var booleanTypes = map[string]bool{
+	"BOOL": true, "BOOLEAN": true,
+}
+
+// tableMapping returns the metrics of each row.
+func tableMapping(rows *sql.Rows) ([]common.MapStr, error) {
+	columns, types, err := columnTypes(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []common.MapStr
+	for rows.Next() {
+		values, err := scanRow(rows, len(columns))
+		if err != nil {
+			return nil, err
+		}
+
+		metrics := common.MapStr{}
+		for i, column := range columns {
+			addValue(metrics, column, values[i], types[i])
+		}
+		events = append(events, metrics)
+	}
+	return events, rows.Err()
+}
+
+// variablesMapping returns the metrics of rows with two columns, the name of a
+// variable and its value.
+func variablesMapping(rows *sql.Rows) (common.MapStr, error) {
+	columns, types, err := columnTypes(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) != 2 {
+		return nil, fmt.Errorf("variables format requires 2 columns, query returned %d", len(columns))
+	}
+
+	metrics := common.MapStr{}
+	for rows.Next() {
+		values, err := scanRow(rows, 2)
+		if err != nil {
+			return nil, err
+		}
+
+		name, ok := stringValue(values[0])
+		if !ok || name == "" {
+			continue
+		}
+		addValue(metrics, name, values[1], types[1])
+	}
+	return metrics, rows.Err()
+}
+
+// columnTypes returns the names of the columns and their database types in
+// upper case. The type is empty if the driver does not report it.
+func columnTypes(rows *sql.Rows) ([]string, []string, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "scanning columns")
+	}
+
+	types := make([]string, len(columns))
+	if columnTypes, err := rows.ColumnTypes(); err == nil {
+		for i, ct := range columnTypes {
+			types[i] = strings.TrimPrefix(strings.ToUpper(ct.DatabaseTypeName()), "UNSIGNED ")
+		}
+	}
+	return columns, types, nil
+}
+
+func scanRow(rows *sql.Rows, n int) ([]interface{}, error) {
+	values := make([]interface{}, n)
+	pointers := make([]interface{}, n)
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	if err := rows.Scan(pointers...); err != nil {
+		return nil, errors.Wrap(err, "scanning row")
+	}
+	return values, nil
+}
+
+// addValue adds a value to the group of its type. Null values are not added.
+func addValue(metrics common.MapStr, name string, value interface{}, dbType string) {
+	group, v := typedValue(value, dbType)
+	if group == "" {
+		return
+	}
+
+	m, ok := metrics[group].(common.MapStr)
+	if !ok {
+		m = common.MapStr{}
+		metrics[group] = m
+	}
+	m[name] = v
+}
+
+// typedValue returns the group of the type of a value and the value converted
+// to this type. Drivers return some values as text, these are converted using
+// the database type of the column, or reported as numbers if they can be
+// parsed as such when the type is unknown.
+func typedValue(value interface{}, dbType string) (string, interface{}) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case int64, int32, int16, int8, int, uint64, uint32, uint16, uint8, uint, float64, float32:
+		return numericGroup, v
+	case bool:
+		return booleanGroup, v
+	case time.Time:
+		return stringGroup, v.Format(time.RFC3339Nano)
+	}
+
+	s, ok := stringValue(value)
+	if !ok {
+		return stringGroup, fmt.Sprint(value)
+	}
+
+	switch {
+	case numericTypes[dbType] || dbType == "":
+		if n, ok := parseNumber(s); ok {
+			return numericGroup, n
+		}
+	case booleanTypes[dbType]:
+		if b, err := strconv.ParseBool(s); err == nil {
+			return booleanGroup, b
+		}
+	}
+	return stringGroup, s
+}
+
+func stringValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case []byte:
+		return string(v), true
+	case string:
+		return v, true
+	}
+	return "", false
+}
+
+func parseNumber(s string) (interface{}, bool) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i, true
+	}
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return u, true
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f, true
+	}
+	return nil, false
+}
@@ -0,0 +1,51 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/beats/metricbeat/module/mysql"
+	"github.com/elastic/beats/metricbeat/module/postgresql"
+)
+
+// hostParsers are the host parsers of the modules of the drivers that are
+// supported by other modules. They also register their drivers.
+var hostParsers = map[string]mb.HostParser{
+	"mysql":    mysql.ParseDSN,
+	"postgres": postgresql.ParseURL,
+}
+
+// ParseDSN parses the host with the parser of the module of the configured
+// driver, so the username, password and timeout settings are applied as in
+// the mysql and postgresql modules. Hosts of other drivers are used as they
+// are.
+func ParseDSN(mod mb.Module, host string) (mb.HostData, error) {
+	config := struct {
+		Driver string `config:"driver"`
+	}{}
+	if err := mod.UnpackConfig(&config); err != nil {
+		return mb.HostData{}, err
+	}
+
+	driver := (&Config{Driver: config.Driver}).driverName()
+	if parser, found := hostParsers[driver]; found {
+		return parser(mod, host)
+	}
+	return parse.PassThruHostParser(mod, host)
+}
@@ -0,0 +1,119 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"database/sql"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("sql", "query", New,
+		mb.WithHostParser(ParseDSN),
+		mb.DefaultMetricSet(),
+	)
+}
+
+// MetricSet runs the configured queries on each fetch.
+type MetricSet struct {
+	mb.BaseMetricSet
+	driver  string
+	queries []QueryConfig
+	db      *sql.DB
+}
+
+// New creates a new instance of the query metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The sql query metricset is beta.")
+
+	config := defaultConfig()
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		driver:        config.driverName(),
+		queries:       config.queries(),
+	}, nil
+}
+
+// Fetch runs the queries and reports their results. Queries in table format
+// report an event per row, and queries in variables format report one event
+// with the values of all the rows.
+func (m *MetricSet) Fetch(report mb.ReporterV2) {
+	if m.db == nil {
+		db, err := sql.Open(m.driver, m.HostData().URI)
+		if err != nil {
+			report.Error(errors.Wrap(err, "sql open failed"))
+			return
+		}
+		m.db = db
+	}
+
+	for _, q := range m.queries {
+		if err := m.fetchQuery(report, q); err != nil {
+			report.Error(errors.Wrapf(err, "query '%s' failed", q.Query))
+		}
+	}
+}
+
+func (m *MetricSet) fetchQuery(report mb.ReporterV2, q QueryConfig) error {
+	rows, err := m.db.Query(q.Query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var events []common.MapStr
+	switch q.ResponseFormat {
+	case variablesFormat:
+		var metrics common.MapStr
+		metrics, err = variablesMapping(rows)
+		events = []common.MapStr{metrics}
+	default:
+		events, err = tableMapping(rows)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, metrics := range events {
+		report.Event(mb.Event{
+			MetricSetFields: common.MapStr{
+				"driver":  m.driver,
+				"query":   q.Query,
+				"metrics": metrics,
+			},
+		})
+	}
+	return nil
+}
+
+// Close closes the connections to the database.
+func (m *MetricSet) Close() error {
+	if m.db == nil {
+		return nil
+	}
+	return m.db.Close()
+}
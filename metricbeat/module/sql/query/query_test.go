@@ -0,0 +1,244 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+// fakeResults are the results returned by the fake driver for each query.
+var fakeResults = map[string]*fakeRows{
+	"SELECT * FROM health": {
+		columns: []string{"name", "healthy", "lag", "queued", "checked", "comment"},
+		types:   []string{"VARCHAR", "BOOL", "DOUBLE", "BIGINT", "DATETIME", "TEXT"},
+		rows: [][]driver.Value{
+			{[]byte("worker-1"), []byte("1"), 0.25, int64(4), time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC), nil},
+			{[]byte("worker-2"), []byte("0"), []byte("12.5"), []byte("40"), time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC), []byte("slow")},
+		},
+	},
+	"SHOW STATUS": {
+		columns: []string{"Variable_name", "Value"},
+		types:   []string{"VARCHAR", ""},
+		rows: [][]driver.Value{
+			{[]byte("Threads_connected"), []byte("12")},
+			{[]byte("Uptime"), []byte("3600")},
+			{[]byte("Ssl_version"), []byte("TLSv1.2")},
+			{[]byte(""), []byte("ignored")},
+		},
+	},
+}
+
+func init() {
+	sql.Register("fake", fakeDriver{})
+}
+
+func TestData(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig(map[string]interface{}{
+		"sql_query": "SELECT * FROM health",
+	}))
+
+	if err := mbtest.WriteEventsReporterV2(f, t, ""); err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetchTable(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig(map[string]interface{}{
+		"sql_query": "SELECT * FROM health",
+	}))
+	events, errs := mbtest.ReportingFetchV2(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, common.MapStr{
+		"driver": "fake",
+		"query":  "SELECT * FROM health",
+		"metrics": common.MapStr{
+			"numeric": common.MapStr{"lag": 0.25, "queued": int64(4)},
+			"string":  common.MapStr{"name": "worker-1", "checked": "2018-10-01T12:00:00Z"},
+			"boolean": common.MapStr{"healthy": true},
+		},
+	}, events[0].MetricSetFields)
+
+	assert.Equal(t, common.MapStr{
+		"numeric": common.MapStr{"lag": 12.5, "queued": int64(40)},
+		"string":  common.MapStr{"name": "worker-2", "checked": "2018-10-01T12:00:00Z", "comment": "slow"},
+		"boolean": common.MapStr{"healthy": false},
+	}, events[1].MetricSetFields["metrics"])
+}
+
+func TestFetchVariables(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig(map[string]interface{}{
+		"sql_queries": []map[string]interface{}{
+			{"query": "SHOW STATUS", "response_format": "variables"},
+			{"query": "SELECT * FROM missing"},
+		},
+	}))
+	events, errs := mbtest.ReportingFetchV2(f)
+	require.Len(t, events, 1)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "SELECT * FROM missing")
+
+	assert.Equal(t, common.MapStr{
+		"numeric": common.MapStr{"Threads_connected": int64(12), "Uptime": int64(3600)},
+		"string":  common.MapStr{"Ssl_version": "TLSv1.2"},
+	}, events[0].MetricSetFields["metrics"])
+}
+
+func TestVariablesRequireTwoColumns(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig(map[string]interface{}{
+		"sql_query":           "SELECT * FROM health",
+		"sql_response_format": "variables",
+	}))
+	events, errs := mbtest.ReportingFetchV2(f)
+	assert.Empty(t, events)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "requires 2 columns")
+}
+
+func TestConfigValidate(t *testing.T) {
+	cases := map[string]Config{
+		"unknown driver":  {Driver: "unknown", Query: "SELECT 1", ResponseFormat: tableFormat},
+		"no queries":      {Driver: "fake", ResponseFormat: tableFormat},
+		"invalid format":  {Driver: "fake", Query: "SELECT 1", ResponseFormat: "rows"},
+		"invalid queries": {Driver: "fake", Queries: []QueryConfig{{Query: "SELECT 1", ResponseFormat: "rows"}}},
+	}
+	for name, config := range cases {
+		assert.Error(t, config.Validate(), name)
+	}
+
+	config := Config{Driver: "PostgreSQL", Queries: []QueryConfig{{Query: "SELECT 1"}}}
+	assert.NoError(t, config.Validate())
+	assert.Equal(t, "postgres", config.driverName())
+	assert.Equal(t, []QueryConfig{{Query: "SELECT 1", ResponseFormat: tableFormat}}, config.queries())
+}
+
+func TestParseDSN(t *testing.T) {
+	cases := []struct {
+		driver, host   string
+		uri, sanitized string
+	}{
+		{
+			driver:    "mysql",
+			host:      "tcp(127.0.0.1:3306)/",
+			uri:       "root:secret@tcp(127.0.0.1:3306)/?readTimeout=10s&timeout=10s&writeTimeout=10s",
+			sanitized: "tcp(127.0.0.1:3306)/?readTimeout=10s&timeout=10s&writeTimeout=10s",
+		},
+		{
+			driver:    "postgresql",
+			host:      "localhost:5432/app",
+			uri:       "connect_timeout=10 dbname=app host=localhost password=secret port=5432 user=root",
+			sanitized: "postgres://localhost:5432/app?connect_timeout=10",
+		},
+		{
+			driver:    "fake",
+			host:      "file:test.db",
+			uri:       "file:test.db",
+			sanitized: "file:test.db",
+		},
+	}
+
+	for _, c := range cases {
+		config := map[string]interface{}{
+			"module":     "sql",
+			"metricsets": []string{"query"},
+			"driver":     c.driver,
+			"hosts":      []string{c.host},
+			"sql_query":  "SELECT 1",
+			"username":   "root",
+			"password":   "secret",
+		}
+		f := mbtest.NewReportingMetricSetV2(t, config)
+		hostData := f.(*MetricSet).HostData()
+		assert.Equal(t, c.uri, hostData.URI, c.driver)
+		assert.Equal(t, c.sanitized, hostData.SanitizedURI, c.driver)
+	}
+}
+
+func getConfig(queries map[string]interface{}) map[string]interface{} {
+	config := map[string]interface{}{
+		"module":     "sql",
+		"metricsets": []string{"query"},
+		"driver":     "fake",
+		"hosts":      []string{"fake"},
+	}
+	for k, v := range queries {
+		config[k] = v
+	}
+	return config
+}
+
+// fakeDriver is a database/sql driver that returns the fakeResults.
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{query}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+type fakeStmt struct {
+	query string
+}
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return 0 }
+func (fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	rows, found := fakeResults[s.query]
+	if !found {
+		return nil, errors.New("table not found")
+	}
+	return &fakeRows{columns: rows.columns, types: rows.types, rows: rows.rows}, nil
+}
+
+type fakeRows struct {
+	columns []string
+	types   []string
+	rows    [][]driver.Value
+	next    int
+}
+
+func (r *fakeRows) Columns() []string                       { return r.columns }
+func (r *fakeRows) ColumnTypeDatabaseTypeName(i int) string { return r.types[i] }
+func (r *fakeRows) Close() error                            { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.next])
+	r.next++
+	return nil
+}
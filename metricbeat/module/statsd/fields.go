@@ -0,0 +1,35 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by beats/dev-tools/cmd/asset/asset.go - DO NOT EDIT.
+
+package statsd
+
+import (
+	"github.com/elastic/beats/libbeat/asset"
+)
+
+func init() {
+	if err := asset.SetFields("metricbeat", "statsd", Asset); err != nil {
+		panic(err)
+	}
+}
+
+// Asset returns asset data
+func Asset() string {
+	return "eJycksFuszAQhO88xYjjrz88AIdKldJjT32AyOCJ48ZgZC+JePsKMBFJaaRW7IXZQTvfLjucOZSIoiTqDBArjiXyj1HY5xmgGetgO7G+LfGSAcDcRON175gBgY4qskRFURlwtHQ6lpN3h1Y1XE0YRRk6ljDB911SNqaM9U4Jto4IrGkv1LhaOUFOXDJ0wYuvvSvSJ+vRd+MZLgw3eSvCkxhjvRoTaJRQ3/jncAXeVH1Kb7ARUXygRt9qhsX0H9UwbWIJCnzfG7ANsQZxqqK76ywsvvpkLQ+tWTzMjjOHqw86u3M8Yx6fvTeJV5SJ8Mdp/Qmr2MyYmn9MqX1fTb/VD45Do7rOtibZ83/574BWh7wo1/ORaTpVumarGhbZ1wDYS+D1"
+}
@@ -0,0 +1,140 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/joeshaw/multierror"
+)
+
+// Metric types of the StatsD line protocol
+const (
+	counterType      = "c"
+	gaugeType        = "g"
+	timerType        = "ms"
+	histogramType    = "h"
+	distributionType = "d"
+	setType          = "s"
+)
+
+// statsdMetric is a single sample parsed from a StatsD line
+type statsdMetric struct {
+	name       string
+	metricType string
+	// value is the numeric value of counters, gauges and timers
+	value float64
+	// raw is the value as sent, used as member of sets and to detect
+	// relative gauge updates
+	raw        string
+	sampleRate float64
+	tags       map[string]string
+}
+
+// parsePacket parses all the lines of a StatsD packet. Valid lines are
+// returned even if some other lines could not be parsed.
+func parsePacket(data []byte) ([]statsdMetric, error) {
+	var metrics []statsdMetric
+	var errs multierror.Errors
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		// DogStatsD events and service checks are not metrics
+		if bytes.HasPrefix(line, []byte("_e{")) || bytes.HasPrefix(line, []byte("_sc|")) {
+			continue
+		}
+
+		metric, err := parseLine(string(line))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, errs.Err()
+}
+
+// parseLine parses a line in the format
+// `<name>:<value>|<type>[|@<sample rate>][|#<tag>:<value>,<tag>]`
+func parseLine(line string) (statsdMetric, error) {
+	parts := strings.Split(line, "|")
+	if len(parts) < 2 {
+		return statsdMetric{}, fmt.Errorf("invalid statsd line '%s': missing type", line)
+	}
+
+	sep := strings.Index(parts[0], ":")
+	if sep <= 0 {
+		return statsdMetric{}, fmt.Errorf("invalid statsd line '%s': missing name or value", line)
+	}
+
+	metric := statsdMetric{
+		name:       parts[0][:sep],
+		raw:        parts[0][sep+1:],
+		metricType: parts[1],
+		sampleRate: 1,
+	}
+
+	switch metric.metricType {
+	case counterType, gaugeType, timerType, histogramType, distributionType:
+		value, err := strconv.ParseFloat(metric.raw, 64)
+		if err != nil {
+			return statsdMetric{}, fmt.Errorf("invalid value in statsd line '%s': %v", line, err)
+		}
+		metric.value = value
+	case setType:
+	default:
+		return statsdMetric{}, fmt.Errorf("invalid statsd line '%s': unknown type '%s'", line, metric.metricType)
+	}
+
+	for _, part := range parts[2:] {
+		switch {
+		case strings.HasPrefix(part, "@"):
+			rate, err := strconv.ParseFloat(part[1:], 64)
+			if err != nil || rate <= 0 || rate > 1 {
+				return statsdMetric{}, fmt.Errorf("invalid sample rate in statsd line '%s'", line)
+			}
+			metric.sampleRate = rate
+		case strings.HasPrefix(part, "#"):
+			metric.tags = parseTags(part[1:])
+		}
+	}
+
+	return metric, nil
+}
+
+// parseTags parses DogStatsD tags, tags without value are set to "true"
+func parseTags(s string) map[string]string {
+	tags := map[string]string{}
+	for _, tag := range strings.Split(s, ",") {
+		if tag == "" {
+			continue
+		}
+		if sep := strings.Index(tag, ":"); sep > 0 {
+			tags[tag[:sep]] = tag[sep+1:]
+		} else if sep < 0 {
+			tags[tag] = "true"
+		}
+	}
+	return tags
+}
@@ -0,0 +1,72 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePacket(t *testing.T) {
+	data := []byte("page.views:1|c\n" +
+		"fuel.level:0.5|g\n" +
+		"api.latency:320|ms|@0.1|#env:prod,canary\n" +
+		"_e{5,4}:title|text\n" +
+		"\n" +
+		"users.uniques:765|s\n")
+
+	metrics, err := parsePacket(data)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []statsdMetric{
+		{name: "page.views", metricType: counterType, value: 1, raw: "1", sampleRate: 1},
+		{name: "fuel.level", metricType: gaugeType, value: 0.5, raw: "0.5", sampleRate: 1},
+		{
+			name:       "api.latency",
+			metricType: timerType,
+			value:      320,
+			raw:        "320",
+			sampleRate: 0.1,
+			tags:       map[string]string{"env": "prod", "canary": "true"},
+		},
+		{name: "users.uniques", metricType: setType, raw: "765", sampleRate: 1},
+	}, metrics)
+}
+
+func TestParsePacketErrors(t *testing.T) {
+	for _, line := range []string{
+		"page.views",
+		"page.views:1",
+		":1|c",
+		"page.views:one|c",
+		"page.views:1|x",
+		"page.views:1|c|@2",
+	} {
+		_, err := parseLine(line)
+		assert.Error(t, err, line)
+	}
+
+	metrics, err := parsePacket([]byte("page.views:one|c\npage.views:1|c"))
+	assert.Error(t, err)
+	assert.Len(t, metrics, 1)
+}
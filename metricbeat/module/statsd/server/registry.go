@@ -0,0 +1,228 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+// registry aggregates the received metrics between flushes. Metrics are
+// grouped by their tags, each group is flushed as a single event.
+type registry struct {
+	percentiles  []float64
+	deleteGauges bool
+	groups       map[string]*metricGroup
+	lastFlush    time.Time
+}
+
+type metricGroup struct {
+	tags     common.MapStr
+	counters map[string]float64
+	gauges   map[string]float64
+	timers   map[string]*timerValues
+	sets     map[string]map[string]struct{}
+}
+
+type timerValues struct {
+	// count is the number of samples corrected by their sample rate
+	count  float64
+	values []float64
+}
+
+func newRegistry(percentiles []float64, deleteGauges bool, now time.Time) *registry {
+	return &registry{
+		percentiles:  percentiles,
+		deleteGauges: deleteGauges,
+		groups:       map[string]*metricGroup{},
+		lastFlush:    now,
+	}
+}
+
+func (r *registry) group(tags map[string]string) *metricGroup {
+	labels := common.MapStr{}
+	for k, v := range tags {
+		labels[k] = v
+	}
+	key := labels.String()
+
+	g, found := r.groups[key]
+	if !found {
+		g = &metricGroup{
+			tags:     labels,
+			counters: map[string]float64{},
+			gauges:   map[string]float64{},
+			timers:   map[string]*timerValues{},
+			sets:     map[string]map[string]struct{}{},
+		}
+		r.groups[key] = g
+	}
+	return g
+}
+
+// add aggregates a metric with the previously received ones
+func (r *registry) add(m statsdMetric) {
+	g := r.group(m.tags)
+
+	switch m.metricType {
+	case counterType:
+		g.counters[m.name] += m.value / m.sampleRate
+	case gaugeType:
+		// Signed values modify the current value of the gauge
+		if strings.HasPrefix(m.raw, "+") || strings.HasPrefix(m.raw, "-") {
+			g.gauges[m.name] += m.value
+		} else {
+			g.gauges[m.name] = m.value
+		}
+	case timerType, histogramType, distributionType:
+		t, found := g.timers[m.name]
+		if !found {
+			t = &timerValues{}
+			g.timers[m.name] = t
+		}
+		t.count += 1 / m.sampleRate
+		t.values = append(t.values, m.value)
+	case setType:
+		s, found := g.sets[m.name]
+		if !found {
+			s = map[string]struct{}{}
+			g.sets[m.name] = s
+		}
+		s[m.raw] = struct{}{}
+	}
+}
+
+// flush builds an event for each group of metrics and resets the aggregated
+// values. Gauges keep their last value unless deleteGauges is set. Metrics are
+// stored under metrics, so their names cannot collide with the tags.
+func (r *registry) flush(now time.Time) []mb.Event {
+	elapsed := now.Sub(r.lastFlush).Seconds()
+	r.lastFlush = now
+
+	var events []mb.Event
+	for key, g := range r.groups {
+		metrics := common.MapStr{}
+		for name, count := range g.counters {
+			metric := common.MapStr{"count": count}
+			if elapsed > 0 {
+				metric["rate"] = count / elapsed
+			}
+			put(metrics, name, metric)
+		}
+		for name, value := range g.gauges {
+			put(metrics, name, common.MapStr{"value": value})
+		}
+		for name, t := range g.timers {
+			put(metrics, name, r.timerFields(t))
+		}
+		for name, s := range g.sets {
+			put(metrics, name, common.MapStr{"count": len(s)})
+		}
+
+		g.counters = map[string]float64{}
+		g.timers = map[string]*timerValues{}
+		g.sets = map[string]map[string]struct{}{}
+		if r.deleteGauges {
+			g.gauges = map[string]float64{}
+		}
+		if len(g.gauges) == 0 {
+			delete(r.groups, key)
+		}
+
+		if len(metrics) == 0 {
+			continue
+		}
+		fields := common.MapStr{"metrics": metrics}
+		if len(g.tags) > 0 {
+			fields["label"] = g.tags
+		}
+		events = append(events, mb.Event{
+			Timestamp:       now,
+			MetricSetFields: fields,
+		})
+	}
+	return events
+}
+
+func (r *registry) timerFields(t *timerValues) common.MapStr {
+	values := t.values
+	sort.Float64s(values)
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	n := float64(len(values))
+	mean := sum / n
+
+	var variance float64
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+
+	median := values[len(values)/2]
+	if len(values)%2 == 0 {
+		median = (values[len(values)/2-1] + median) / 2
+	}
+
+	fields := common.MapStr{
+		"count":  t.count,
+		"min":    values[0],
+		"max":    values[len(values)-1],
+		"sum":    sum,
+		"mean":   mean,
+		"median": median,
+		"stddev": math.Sqrt(variance / n),
+	}
+	for _, p := range r.percentiles {
+		fields[percentileKey(p)] = percentile(values, p)
+	}
+	return fields
+}
+
+// percentile returns the nearest-rank percentile of sorted values
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// percentileKey returns the field name for a percentile, as in p95 or p99_9
+func percentileKey(p float64) string {
+	return "p" + strings.Replace(strconv.FormatFloat(p, 'f', -1, 64), ".", "_", -1)
+}
+
+// put stores a metric under its dotted name, merging it with the metrics
+// whose names share the same prefix
+func put(fields common.MapStr, name string, metric common.MapStr) {
+	nested := common.MapStr{}
+	if _, err := nested.Put(name, metric); err != nil {
+		logp.Debug("statsd", "Dropping metric %s: %v", name, err)
+		return
+	}
+	fields.DeepUpdate(nested)
+}
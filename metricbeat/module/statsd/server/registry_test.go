@@ -0,0 +1,148 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func addLines(t *testing.T, r *registry, data string) {
+	metrics, err := parsePacket([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range metrics {
+		r.add(m)
+	}
+}
+
+func TestRegistryFlush(t *testing.T) {
+	start := time.Unix(1538395200, 0)
+	r := newRegistry([]float64{50, 90, 99.9}, false, start)
+
+	addLines(t, r, "requests:2|c\n"+
+		"requests:1|c|@0.5\n"+
+		"requests.errors:1|c\n"+
+		"connections:10|g\n"+
+		"connections:+5|g\n"+
+		"connections:-3|g\n"+
+		"users:alice|s\n"+
+		"users:bob|s\n"+
+		"users:alice|s\n")
+	for _, v := range []string{"5", "1", "4", "2", "3", "6", "8", "7", "10", "9"} {
+		addLines(t, r, "latency:"+v+"|ms")
+	}
+	addLines(t, r, "requests:3|c|#env:prod")
+
+	now := start.Add(10 * time.Second)
+	events := r.flush(now)
+	if !assert.Len(t, events, 2) {
+		return
+	}
+
+	byLabel := map[string]common.MapStr{}
+	for _, e := range events {
+		assert.Equal(t, now, e.Timestamp)
+		label, _ := e.MetricSetFields.GetValue("label.env")
+		env, _ := label.(string)
+		byLabel[env] = e.MetricSetFields
+	}
+
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{
+			"requests": common.MapStr{
+				"count": 4.0,
+				"rate":  0.4,
+				"errors": common.MapStr{
+					"count": 1.0,
+					"rate":  0.1,
+				},
+			},
+			"connections": common.MapStr{"value": 12.0},
+			"users":       common.MapStr{"count": 2},
+			"latency": common.MapStr{
+				"count":  10.0,
+				"min":    1.0,
+				"max":    10.0,
+				"sum":    55.0,
+				"mean":   5.5,
+				"median": 5.5,
+				"stddev": 2.8722813232690143,
+				"p50":    5.0,
+				"p90":    9.0,
+				"p99_9":  10.0,
+			},
+		},
+	}, byLabel[""])
+
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{
+			"requests": common.MapStr{"count": 3.0, "rate": 0.3},
+		},
+		"label": common.MapStr{"env": "prod"},
+	}, byLabel["prod"])
+
+	// Only gauges are kept between flushes
+	events = r.flush(now.Add(10 * time.Second))
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, common.MapStr{
+			"metrics": common.MapStr{
+				"connections": common.MapStr{"value": 12.0},
+			},
+		}, events[0].MetricSetFields)
+	}
+}
+
+func TestRegistryLabelMetric(t *testing.T) {
+	start := time.Unix(1538395200, 0)
+	r := newRegistry(nil, false, start)
+
+	addLines(t, r, "label:1|c|#env:prod\nmetrics:2|c|#env:prod")
+
+	events := r.flush(start.Add(time.Second))
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, common.MapStr{
+			"metrics": common.MapStr{
+				"label":   common.MapStr{"count": 1.0, "rate": 1.0},
+				"metrics": common.MapStr{"count": 2.0, "rate": 2.0},
+			},
+			"label": common.MapStr{"env": "prod"},
+		}, events[0].MetricSetFields)
+	}
+}
+
+func TestRegistryDeleteGauges(t *testing.T) {
+	start := time.Now()
+	r := newRegistry(nil, true, start)
+
+	addLines(t, r, "connections:10|g")
+	assert.Len(t, r.flush(start.Add(time.Second)), 1)
+	assert.Len(t, r.flush(start.Add(2*time.Second)), 0)
+}
+
+func TestPercentileKey(t *testing.T) {
+	assert.Equal(t, "p95", percentileKey(95))
+	assert.Equal(t, "p99_9", percentileKey(99.9))
+}
@@ -0,0 +1,112 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	serverhelper "github.com/elastic/beats/metricbeat/helper/server"
+	"github.com/elastic/beats/metricbeat/helper/server/tcp"
+	"github.com/elastic/beats/metricbeat/helper/server/udp"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+// init registers the MetricSet with the central registry.
+func init() {
+	mb.Registry.MustAddMetricSet("statsd", "server", New,
+		mb.DefaultMetricSet(),
+	)
+}
+
+// MetricSet receives StatsD metrics and reports them aggregated on each period
+type MetricSet struct {
+	mb.BaseMetricSet
+	server   serverhelper.Server
+	registry *registry
+}
+
+// New creates a new statsd server metricset listening with the configured protocol
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The statsd server metricset is beta")
+
+	config := defaultConfig()
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	var s serverhelper.Server
+	var err error
+	if config.Protocol == "tcp" {
+		s, err = tcp.NewTcpServer(base)
+	} else {
+		s, err = udp.NewUdpServer(base)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		server:        s,
+		registry:      newRegistry(config.Percentiles, config.DeleteGauges, time.Now()),
+	}, nil
+}
+
+// Run starts the StatsD server, aggregates the received metrics and reports
+// them on each period.
+func (m *MetricSet) Run(reporter mb.PushReporterV2) {
+	if err := m.server.Start(); err != nil {
+		err = errors.Wrap(err, "failed to start statsd server")
+		logp.Err("%v", err)
+		reporter.Error(err)
+		return
+	}
+
+	ticker := time.NewTicker(m.Module().Config().Period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-reporter.Done():
+			m.server.Stop()
+			return
+		case now := <-ticker.C:
+			for _, event := range m.registry.flush(now) {
+				reporter.Event(event)
+			}
+		case msg := <-m.server.GetEvents():
+			data, ok := msg.GetEvent()[serverhelper.EventDataKey].([]byte)
+			if !ok || len(data) == 0 {
+				continue
+			}
+
+			metrics, err := parsePacket(data)
+			if err != nil {
+				reporter.Error(err)
+			}
+			for _, metric := range metrics {
+				m.registry.add(metric)
+			}
+		}
+	}
+}
@@ -31,5 +31,5 @@ func init() {
 
 // Asset returns asset data
 func Asset() string {
-	return "eJzsXd1vIzeSf5+/ouDDIvadrRnPbrJ7fjhgktngDCQZYz6wC9wdNFR3SeKaTXZItjTKX3/gR393q7ulltQTxA8JxpaKvyoWi1XFYvEOnnH3AGqnNEYvADTVDB/g6oP9xdULgBBVIGmsqeAP8F8vAADcH0FpohMFEWpJA3ULjD4j/PD0CQgPIcJIyB0kiqzwFvSaaCASIRCMYaAxhKUUEeg1gohREk35yqOYvQBQayH1PBB8SVcPoGWCLwAkMiQKH2BFXgAsKbJQPVhAd8BJhAU2zI/exeazUiSx/00DK+bns/vaZwgE14RyBUwEhHlqKX8z//niuMWxAyEx+2XT6HsQFFDcGToFKEaeHgEshQQCivIVQzseiCUQiBKmqf1eQYLpT1lo6U+ViSIjNCz9OmWFCb6q/GEPN+bHQP/BoOJJtECZoyp98t/gCWWAXJMVqkZAiUI5iwPdCEsFhGE4XzJBqh9YChkR/QCxoz8M/Mc1pl8kKytow46mEYKKkWug3AIDFZMAW3grcaBp8KzGEa0BRyKRcH0kMK8vUxTuM0qObAgXIwq4U8ID0HEa4PQkLDgwsb2LJRWS6h3EUgSoFKo+3JxN0oeipCGboMwtqh7Az6fIPQCJLaF6grLkYIDBteAQUvV804+Pc9qIYfjkr9MTskK5oYFxzYxLtyY8ZOYfayLDrfHmKNcoZRLrzvUofz2f6EdDrcRSf03zYvAexuGl5+YA5BoJm97MUA6UbwRLuCZy50zAYmfjnA2VOiHMfmO7pgztb9e72IhECVkbbEtUSV5Cr1GmW6CQs9oX3mwIZWTBEARnO7N5fuL0Sy9BntMuTldAWSwXJ0eFckGc1KJJw5WJmNVx0ZkJ88acKBebpRNlqUMsUXnvy86AUHrmPiz4HTfrh9HfsBomQmFlKNhSxmBNNmgCVPKFRkkEG8ISu2g+37969Sf4dzfcZ0u7Riwfp0SXMIkk3IEmz0Y/qPJUKdcCSBBYtXO2ZVMn2oDFQPldh6bwjtdTBOq2RnYnEggId5NWFHmWvFlJJBql+QV3coMfhQT8QqKY4S3QJfy5RtaplPk60fDdqz8ZaLdGr5xy+bTHLIiTWSrNz057Fgj3f2udnN9XCPv7ChK/3vDr9xLtfEVe6x9+eQOHf3i343i3WuiJCtL4gqjAsW131MeQoVWcx3f/MFaozSn5JfeMevknxpOapAiGpqkny8jQjX6ajBy120+Tpf5b/kTxH7DvT5OT0Tf/r4rNQz2AaTL5tboBU5NmHy/gNk2EKAxTIec5GxtcN/Be8Rg+1rJ7X8vJ9JTPdL+OU9AJHiZO+hDu0kchh++Il0Z+6Cb3x9lDUSZGT6l4URXFkOMHQ6Jw/mD+CY/vsjKynjV46c/wMwrz38b5fMbdVsjqwYHPHz+ACsn98Om27Jkhu5QNJSVs7jbPAfB6QvhG+RHScjf4uKYKIrIDLjQs0CjHhoZuGyeM5UKv0fQ5+g6GJJJwZg88Rlw81lMqeBhmEKMyZoaMyqgkMBq+TBjbdeDbSqrx5ADtKAcitBJc7HT/E7XUFWz60gHgLRkLowwb3nH4ifLkizviotWhoOIHKgy0kJ6SPeyJGfWaxoEolURGMvZToOhv1g/99v51rxm8vIAMDo18HBmlxHqKqUa1W2xWrcy+c0K1jygzMUEgeKj89ubNil2xvSb2YhDdmu10Fk8NsBljKMw++PjyXTdAE73N7GxL/DVBpWcRyhWqeYxyrjBoxN4UYXaArx7V22Xuh1Rgx7Sn5OA4cSe2W5QIvyaYYAha2MUQ4oZ2xjaeLaci5+XLjnlqxkrzddaJytFTpWroC3weMEHnnZlxObEz4hnYs9uMwMb3+X6b+b41zFXfvXlL656aM3LkpueULKV6RjarudkZx+WHbFCSFbo995rydLO+MSwajnpqXj8LYG3HiTmxYwBDvtLrkzBBTPh6GuyFkHkpZA67ca1rYWIbEwpj2A+5C5tx3rq7H69GbgTHiFGn4jZ/Y5l6fPlu3PlYJGo3HjdPzRnjMJHGOdmuabAus9BujK8XhIdbGuo1JJoy+hsxw1oh5J+6mcFb93FFdCLdR0QQJMZhdrVaeamdgoAJZae+XD2XimRJGZbu4sFBeYycTK2aMv/TGEWVJM3NnCnKsSkLm2fOhzbSJBwSHku6oQyN52Oz5ZS7YWaN0N30zQemXAalVYpFfg/w+WWIm5fmr/efGxGZcU8AxdCoQsEv+i/NIGwKcB4L2pJ7OBiLJWzWoKVdk00zGqutJwxkDH3gIkRltMWsavubei6vAEniRbV9v1YbdPOxpVaQl0Q8RGhW7mfNhBRkt19iicLzprLMgAPhXf7otQJ638lpporKbDBH7WNepRylwlZW2MTS3DxZrSSuSJacJ4w5k1Mpt8+/evR9gkPTs7+UzY9HA0uRVOON0vI5Yll/bDB7Lfrmhmpw7/dpffPM1hlHZecn5xpCEahajNUgddhvgfeKogt9DWeTXwleiNYCVtZAFaBZLBcDaFdqB8Amc3w+hM7sXVugMUuUlelN3RlmgoTHmA/j/BsaaXRz5IK/ur8aaoTNnyhfzZfEBOUPxukfZoh/KsDPAg9GlIaI8kRj8xq++nZKSL/1WFsMztX9pNDeN8Btxm2Loi6lEw26ACHNTkn7FTvV2bnUVDQrzBgcXUy7WrTqSJ7sh5o5Ou01xpp1dn2KjnLvHIlaisJ3QBohPXG2sMPuaw53d0eb84Ubn8wW2wvWGaNaF5/ltUbWo/JznsVCrgbEpc1CgcqWglAesCTMPhwI7s6dF7vUnQxIsEYFhNf9r0WyXKJUcK0wi1W9aEigE8JmFTdk8uFYr4l1vB3mr9eRvLHU8h5lGNpKNiO5Li9+r7fcuCLgDB6pZ6ggz4IOPmqQ6I2hcjlfapQIeYCwQL1FfxfXq7Q9Zy3mavwMNV7TNj/VT0KIMfJQpZb33QeXJ4uERAhRE8rULcTWDEKwxuA5i5ELOvy5RSXg8jGUF3fzkn/UNkNOWJAwG8gviJmWgiyywhWq7eqnSqv0jKhAs3FoG2nk9iG1B5bouw//NCSpAgIqiapWKZ1Yykmg6Saf13cc/kF5KLbq1n8ff62vNi9akc2V/3rfuWqxOdDH7kCn7ek5c3UbRGpLp6uOcEuq5qbdEMUSl/TLA1z9j2Xr/6o+Tzm/YTYLSyX3JYz7QJWmgTKOg9Unf7xjcJTaLKYq1pTB7E5HXDiYzpnpq0qXsrXWGxmG91JmKrPLB4h3eis16Sf4lIt1ssK4djP2AovVAAGL5OLrtLH4ucZa84Tk+d4CQ/7kJBaCTX3d/lzw9igHwkyYZqYoZ2eMtdHBwFFrolwVUNKr1uILGMkOjaM6uat4sBIZR1ZuDk9Nj8NIigIWibZRXZM+DeRMJdK4d5dlTGxQBiKK6OClEeKSJEw3nbr05uGI9f3WDe9qyJZCNoLPrqig3gr5/KIKb0jex9MoJH78b4qXbUo9m9O/2wtSy8rZxvnu2aBeD0wF2hRbFXyfSzci0Wc9la7eHDAxb1f9Fr8oQokB0u7aOSPImATPqE9TEuFp9xTY6ZDIDElPwVA+QymFPI1YHGl/JdAhonzVY67OhUkhD7sRUT4LpYjj2q45DiLKAxHZoig/d3lBpR+2h8ROCVAkeiX2AyymaqkCwrZkV9+JXhnn/S2RW+NB8hC+//AWFhiQRKFPnRhfQGIspM5PR9qvV6YC8BdHj9qPPI3CfuR/YzYjEhJNbovPCdwW32moPHIA59iP+qSLPQPlnQZKV4iJPtGglnSlwu9KJpxTvrpqRhOP9nhCEUhMWwpq4hONRyRyvWfY1UmGdcF026BBFDLKR57qZcIYGG+X8PDOkHfhoRZm8qV2zruDd+vPbcwq1g2JcCJXSWQz7ApjIolf/g0lLMXeG+MvmZRqmlC2NcTW3AW2fWdYZGoGj/mnGrAABIT768shapQR5Rje+mpJZKHr1OkNTkbpm7rh5EmEkgZAQ+SaLilKuP70+PamnH22VeaOsD8lU/uIhiIi3hBT5R0zwyFR8Nn97X9Txj63aNW2WZMPFn+QSLt4jKtsVCWk0l5l2aXzkUn+Y4FXZZsC1HWq0DPAHRk0c4F808iFWPwLa6kK98v5kXwi31ApuNF42BBJDUrVvnpc/2CzBzVd1C3x+aNE/P7D21vHsNul3n2Af7ZMYKlVM4yX3/vh6dOdijGgSxoUE3tx3uZhaOqutdkOdIXHPfOlDZ0vdHFD29eFpwrWtUyy/tCJ0GYtmA1YlxlVlAfotMfb2TZZd7d4hAunqyvNR7wrmM2F5TSrrEni0Dobj7rggyoaUUakP/BpHPZPZpRMkMUBQqpiRna5E6pFnG51afeReqOJZuG2NM76qiSMm1JkW6Zc9PwLjcdrxU15cZHdczRIwusnDJ5pKSJ4Vb8kVRXxnk5XcGa70NwBqwrY6cQp8bpChL3Tu0eexno0XS7M0YX1mGEIOoNpmzYwT4VoGwKZobHFgW081O9ZwuLOLobuR137Xdd+daFEbq4BaVcmH6QWxb0me1RAKnWxYyaD/j0q6+fCB9Twgf6Gs8oybGBIBEESU3c2Zb1a/5nr929+vtnP6vQs83j8qTWRl1JCO3bYxEyiWm+uNccTBxSp/UgZZp8R0ntIaaLGbVoKvTq5wwaqCq70sqFrmSs7MnuX97LHNhkiRn7UplC5qFeWgbL0e+8EjEZUz5RYDj6t7asgYqndKOmZfgf0zKdoJFmKlQq0A8JNAB6sjbMRVv0cooHwnd2VukSxJrVQbyxRGNKnEkWBthGF7Z+3QJAkbYoqhdAt4WHTwjt4Sf6c3tLjHo/Ke264kWyvFHs737rcRD27crwIy0+gpj/+W9n1P4l58rjmYqyJ8oTUmsa2iKGeZxH8zojDU7YCVFgawMqvK7XQvdRrOUDoyJ70EDB4bXp8a0MVo0nC3oF03CggSomA2jTWluq1SxgZMTd79i67ZTsj8G80kJTq41uXqvD9qFLqhfSTf4GmkSpZ7DklK4ooJnp9OiEZ6mkxoNcjW3VEN1j5tUoWLsr4RrnbpO7y+iCR2dHOIbR6Rgf2rtkBEgviJJcFqGCNYcLQPbJMbGs5d9+FqOesdsOvo0aab9x3UvssuJaCMW/ZtiLLBGdDSXULP/z4wRqQ9x+biZq/K0146MCkjQ3ZDpaEypyUtzOxFMZeUMEJa0ghWunYuzouk5oFVWnhdzqNWZXyFulqrWfw/mMBRiNdiYT5CK0CSqFWhce2GuPPRn8U8ubG5QmwQvZXJdI2KARWdIPc+J5U7Kt/ajdm0GXQoMd6haoGPr5NszFV7dkLoMVcHASheRGYn6dDzEYrtSZzspfJYKlmfsIaS52gyyEZwqodx86F77ce0UCKtN+fLRISW5C4ShiRZldsJeVE8o1K7YQWVpclKpHIABWotUhYaP0SzGrBBsjk10RocnqRfKxcW2oVjFvIhDVfA7CQUjNJimtUJjxdn4KjX5twTRSEuKTO7WuXclE52i4xNUnPhmqnlt0bboufVih9ttAeq/mkDBqDly0ki6do8FqJlhoApU5jSayzQrY8HSz01rFdknHiheLc7yhR9hTvNRilp6t164P8VfFKPeH1mq3Ldvm2rFeqDlioUs9kwm2oNQVh2OS24CtU9iKRpjwRifJrrpUw5ZUQpbyI3aOdzVLrKSZ359XBOLWY8spVb2psfeKGMGWNTmnBmEVRNjHtxs0sbSsKZCTeX15dZ12vpdCaYXh2IRhdUW2zunA3/Tw2uLZM0oa3QNOftLB56451jW1Pq530GndeQF/WJLEtUWxX8+Veu1Qwd0arSzPk8gFUgt0L+5r/qsT5ybfQLD+d9uRz7fKuKQdOuCj1GfQrLZuPDgejaZ76xUwk2JMF7hU3+SgobXDWUBKW/vzhTWc/F/am/fnsebzG4sNFBUUv3WA3JqAYP3fo+6A17l4zHIfXjJci+Ay4LZCIRNhW3t6ML32m8GwIr92B7c0QqDHK5gwL7C8bSn9K5UPHa1bGZc16ZmwLDkiCtf1oRcP2bN9UdavY3qNZGGY9/fUynxZ2pbV/GNATGdDhhjLCaGZP0FoPhqHPCu06WRzAeLHbij/cW+xa01/XaYOBvm5RznBEvkyH6TVmWcFi+42xOXfnXVPkOk+9uE2m3D7CcJtWGZu4vX37JMEab1yY0pCvdg/u5557ovruD0Z6S0JZcvp8Svms10culqF11rTCHftdV+b0Brak3YuSZrvoHbFFGKnt1GzDGtMOHmlBcclQ2C4g9iq860Lo11ArvTHXViasqdqVWpmN2YzrwioJZY8jcaywJm+K0kySV7iq0Oxk78+TjG2A1HZKJqi62OyEtlK8rs26NVYDjdLzVP0VXyJ6Mrflefp+S1UEne5LK9Xhkpm8MRHLinz2GYj2LOEhhuN5mq5Ldd7G9F0M7bkO4kmaipKNMJvMxx+esv6F9XbxQxidqmko2oQqxw02oiM5dpj1tGL6GuyEF1ZVTjWD0SWlgz2NTFrTNBrViWw/rBruX7iEpesDOCdcNPfA6C2AEXXlDRd8F4lE5R6ojXVBcPB9CxkSpe8kBsg1293Z1Xb90/tP7QJiVOnSBd4oXiq4VusIo5vbocaoJDwTpZ9ZeD9ShncLEjznxem5cH56/ylj9wCurKzPzM+T2SDswGPP0ZqiJDJY04CwuRPVfFqmsZg2ziKxFLb3nrJ+DgU74Wxf+8ntKOJS22lKK4/IesutlWRZnofJLe2o+vVY0qwHbNFclFZee4BbXZEHSeoCZrNdUs0GtVFGB2hHROIYw2lx/MG/Ae64vXMQwf/PvqvTborHtTkxWeHcdm47e5GMsREku11Rjk61pKsVSgzNJ/YlwCz0gfrwLyHnXwHfFmgH43D1s/nUlfungrVRIZ7fXfHJANdlme3sHRYt9oW/rkGJbRVhL9eEtHi7o6dGqXlr2uUEhWe216P5r60+E4WG6dRdynPPbPUuuSzyIZK2XONpGRFJIUg7lpV9F3J7sXKObfE6bzkjCVe+C1LWRvjmFrhoz/uO67hKpeZm5MlI7ZdK20KxBJIJslFew0pntiSeDK8fsmOPA2cv4bihgSaLCe34PxfSsXlTJ4kBIzTCsBenKZcL9kybbPiAcpnvmQiKjVL/qJIZu0rmgCIZV004FY2tPvfoDI+1NUuU0mX73Lst/qXMhVUq9yRyewDTflgzSExUnKfqMhfA48t3aStJwW2Zv5G2q5Az7B/OuK3Dxvxqva89du8cMxrsWjtWzlQSRaT8ghbVDB/gyfuXH+ofGNra0pMoNVUuXIxGBb4tY/NbXyd/c6vXu1qFXrsZbOEfVq/iLdw4cYyNhKTQv9wLbAgWGvZ/Ib03EEN0EArFEONTiCQlPAyNHrNrbQGMozsIy28iWtDxZ8iRHYQk4c9cbKsR2PFQcgyFuxVrdK1aTWTCQv6Nd2y0pLgxxlGauMUjqtsxSehRz7NWvn/prrm/FFp/uv2geYZsaovq3XzkBrop3Ts3JT2QmPg+PU0ZSWGKD064FJ4bZM/4p7T3doSCHIqPfjVjso7MuKAKAZwlXsSzFixskY5HonY8OIGV81D8ru3RuPyUtNdMzLj1NatE8IxHvcn+8YcnT0Xl4zldOc5vcP1eqWi2fR0PM6R91IeJ87/F1hlBy489DKacatslZQZPQim6YP4CsfKCdePcZo3R60ldIW3OG3l3d/kliWit9UQ/huPNX4Yx+yYMpe3TYcfsAGYfYp3R5v4/tV9nqO7/8/Xs1ez17N4I4fWrV/cPr95+/7eHN9///e3D377983cPD/fDQP9kH4R9fALi0Pt0mu8bQjg8Pm3+YgZ7fNp8l32oD2+xkL1f78/4e/36EPhmqA5MEiOhcQICf2+BjCxxz91ZRO4Z6C9z44sdsgL/+t3d6/v7u/v7v979+bsZ3878X2aBqD1N1oH56eN7kBgIGTa0aUIPFB6f0icyxUIT2xBiQwlI3KBU9WOWxydgQjy3xuYVMaBm4TxmiZqLQb3i87dxDmXfNsVeLjHwOZn4juEGWdqj/Bo//vT2JvWHvCzMpLnDbMHRPo9apcrIAlnpDQLXfN1Q+4976wVfLYWYLYicrQQjfDUTcjW7MvK9Kv6ill/L+nEbGmlv97TpsiEPgYjQNzgjHDBaYBhiCIGIs2bmhkCVsP3CWuv44eXLOFkwGqhkuaRfLI7eujy3r5yM55f+3ZDzH1qkbLqb7NmcWA306ga+JqwDcZoFGe8hhjwNmR7QWf/Et8zL9/mewHzH59MEGGk76WtbhuEScq9fQbAmrt7RPXjb9Rh1BnWsFx72joJfRox23iyUYIku92LDLxgkLvm/H5K9zzya4nzKNcfeLEszPI2tMtvxnC4c7UaVAYkrLXOGP4bjSHwu105V3+HxA/m84pGOv28DMYsGvySWfrXyZ8rjRM/TD0WUMeovRw8MR+0qTHm1fWRyUrMX/x8AAP//nxVJ3Q=="
+	return "eJzsXW1vHDeS/q5fQXixiH2QOpKz8e7pwwJOfMEJSFaG7WAXuDvInO6aGa66yQ7JnvHk1x+KL/027J7ueVPb8drIJtJM8amHZLFYLBavyCNsbonaKA3ZBSGa6RRuybP35gfPLghJQMWS5ZoJfkv+fkEIIfaXRGmqC0Uy0JLF6pKk7BHIj29/JZQnJINMyA0pFF3AJdFLqgmVQGKRphBrSMhciozoJRCRg6Sa8YVDEV0QopZC6odY8Dlb3BItC7ggREIKVMEtWdALQuYM0kTdGkBXhNMMamrgD/Umx89KUeTuJwFV8O9H+7WPJBZcU8YVSUVMUyfN6xe5z9fbrbcdCwnlD0Ot9yCoobhCOTUoyKdDQOZCEkoU44sUmZRAxJxQkhWpZuZ7DrKHSkibNELCStQVYUnjx16VVPBF6xc92uBfhP4jouJFNgNZoWp88k/kLcgYuKYLUEFAhQIZ5bEOwlIxTSF5mKeCtj8wFzKj+pbkVv448B+W4L9IF4ZoVEezDIjKgWvCuAFGVE5j6NCtoYFm8aMK6jCaWgRHM1FwfSAwN16mSO4jSA7pGC2OSPBOhkeg4yyG6Q1fwUkq1le5ZEIyvSG5FDEoBWqINmdjel+ULEknyLlBVX6tG/j5BvIAQGJNmZ4gl5wgMPJccJIw9fhimB7no3YsPvnb9EhWIFcsRtcMXbol5UmK/7GkMlmjN8e4BimLXO+cj/K381F/NNRKzPXn1C+Idz8Nn7pv9kCugabT6xnGCeMrkRZcU7mxJmC2MfucFZO6oKn5xnrJUjA/XW5ypEQJudXYmqoGX0IvQfolUMho6wuvV5SldJYCETzdEMHJr5x9GkTk2QbApAnynMR5cdBWLs6Lrd0k8oA7ZnXY7gy3ecfsKLs38x1lpJNcgnLelxmiQunIDH0u+BVHy5ay36G9TSS1maHImqUpWdIV4AaVfmJZkZEVTQszaT7eXF//mfyH2cOqj0b2lrCqnYZcmkqgyYZo+ogTiCknlXEtCI1jM+ys3V/V9+P2TwALQqm6pPGNL2NrSu75dohAXW6J3YiCxJTbTqvkqyp4s5BANUj8Abe8kZ+EJPCJZnkKl4TNyXdbYk0fm9gP1eTV9Z8RGgaEgOM/fNgjivMi8mx+tKNnBuTmb52d09r8feZb2C9rk/j5br++lN3OF72b+AP45V+92+N4t1roiRKJviAoYtU2K+pdkoIZOHf3/0QrVIptyP8T+UflGQ3yT9CTmrqTUn4/qIZb4yeryNiFfpqKHLTaT7RvBi/5E8W/x7o/TU2Ovvh/Vmru6wFMU8nP1Q2YGptDvIBLHwhRkHiSq5iN2VwHdC//Bf/+iXzYiu59LifT54xLjl3Fz4btoIX5fAwOXmvPB2mP5fNs4I6+Ij418n0XubPhnvS65TnBw2wmDjp+QBG18wf8T3J3X6aRDczB2/+MAv8Z7M9H2KyFbB8cuPjxLVEJvRnf3UY9bLICHUSlQDKaPtjFcwS8gRC+MeOB0dQtz3iqwRTJ6IZwockM8ORuxRK7jNM0rUjfkuli9DsUwoOQyBx4BLXZb/IYT6nmYWAjisQCI/w4ZFQR4/HjvEjTzQ58a8k0nBygaWVPhKhcNNtoUEMBelcw9KU9wBsxBkYTNp7Z/Mx48ckecbF2U6TlByqItZBOkjnsyVPmRhonVKkiw74znyKK/W780O9vXg7qwacnCPtYAz8OR17YQJq2pO6mDXshwnVnKGl7EJOxNGUKYsET5ZY3Z1aw9V0LL3IATwfRNL8LIxOnBhjGmAhc0e++vd8NEGO4EfIdSfitAKWjDOQC1EMO8kFBHMQe2mHuAN8+qscmiWsSE/Dlwp6S49AVPMEDWk3WIIH8VkABCdHCGIwEViyGYWqZPjqzXqbNUyvW6K+zdlSFnim1hb6mZym3T49mB523Z46riekRp0DPcnwENX6o1tvS993CHA1a0voUsl1zRo1MgydVyY8zulo84Mp4XH3oCiRGx1AyeY5xMbMGqxdo7fRy8Mir9OzTxdiOE2ti2iAp8IVenkQJipGN02CvbZnxylEJOwQW/2sGuPVZQW2Y9SHHz7IYHjpX94MVcC3YKCsOp/oy/wJHP7n79v64/TEr1OZ42lQHu404RlJIdE7WSxYvmyp0oifPZ5Qna5boJSk0S9nvFJs1JFSfehGRN/bjiuoCt6WCExHHhVRkvQTeSLVTJE6FQn+qlT3nKZmzFBp38faLY1RitrIpq18dI6mS+thMsAMDzmbYfo6KF9g4c9U0skk5KXgu2YqlgC6diZYzbs10FIRuu+9hZMhlKEYU20jyuyUfv01g9S0GXW4+BhFhP58ACoptQ4FP+i9hEOZuwEMuGNfHxWIE4xw0sre4CaMxo3Xo2NpjI4PyCRcJKAx546w2P9mO5dUgSYChiE4x2vtH9VwCPBybtRpfEmAf0swGciiiA1kzbdW562esUHDeUBY2OBLe0x+9tkBXWLf/xSOfK1xgLtqYx6xjbkhZSbWlrLaI+dg8XSwkLGgZnKdpak1OK92++uqBS9/+4dl/NM2PQ0PmomjvN3xbZkgfMK0/BMxex3izTQXc+75RH+7ZbcVBmf6ptCaJqAoE9LFehxiwwL1U7EK/YxT6P5ZEbLw9B9oAcbI8GUBsfBfAkDk+H0IDjjw3QPO0UIbT2gmzR5kKmlzsGmQ9raLzjzL87ubACf/s5tlFiK4eI4y/YnzxMKe4Kb9Fp/9iFGk/1+CXG4+UKk0yxgsNURjp91NC+r3DqjrA3kwK7U0Abhg3phdFTzUmGpgtYJKw8pR0WLLTtjrfT0GdsgeOodHNJFS6OZZO5kPPLgaa7VG+ff81xos2FFun6BD7/NGK2ApRuApIRwhPnG3bge24yk0V4iCks243fsUldhCsgE912v1ZlWuETTuQ1V7I5oDYsFkiQJlUEMbjtEjKD8eC2+P52ca7kzGNl3jXlSdbTc+K+RykIs8VeO8zctTQGFOYopYbEuRpStuxQR1rdQvCbU/VAUheG2m+A5AM5No4cFFb49a8bP26RWloLPUOwl0DcYAyNYVqfNbG4J0mEpwxxFg3BtRwEAGPgcxAr8HdxXVD2hwg12M1roeC17Txb/uTJIEc8EDdWd779zZOluH94wQ0Zam6JLmJ0pJ4CfFjuUeujeGP0W7Sn2gP5egOT/k7TZgiMU3jIjUb+RnFbqlxUSauMI2LhmIYwHdnRDWZwabNTqOyD94emGyY+/f/Isy0TokqsrZV8h3LOI01W/mfm6/+k/FErNWl+z78tj3bHLWi7Cv39aF91WFzBtmd3bZnYM9t2yC6NXU6dPF6qDXNBxuiXMKcfbolz/7HmNP/e3bRA9ksFkZK5Uug+8CUxtiQBDue3PEO4nCIbZlFP8Rc97RaCjkYu5yMc8wlt5mulBk6lLraPDVg442Mw/tUZqq0y+PgTnSmFsOI91osiwXkWzdjn2CyIhBikDz5PA0mPw/skCreW1PInZzkQqQd3TGZeftLzdtjHPMQRWzsaaXOMebGDgUOmhPNrIBaN6BSJ7ZDxxk6lau49yBCR1auIHlaRTwKMiu02dWFxtNIzVQh0b17WsXECmQssoyNnhoJzGmR6tCpyznm9xvbvE1vx0hcCLzHykGvhXy82LUs9LT70cmoBX7cT+qXbRo1m/3vzdWueetsY3xcaGTSR5mmAHo5MhT4YRkAP+TSjSh00OB3Doa+gTAEZDmOjQCCdcx2QGT8SRFKiIHtzp1DInMaP4IeDHQUGCd7IGGnQyJLJAOJYTwCKYU8DS1WtLsSaBExvtgBCfvqXJgU8GQ3IsajRIo8h+QkiBiPRWaSolzfVQmVrtkBjJ0SoCj0QvQDrIdqMYKSrumm3X+EXKPz/obKNXqQPCE/vH9DZhDTQoELnaAvICEXUlenI93XK4NsSKoh6jVLPRuwE5mnWjI5vkCAib/o90tYMVEooszSMkSv/hXhfIqhoTuaUjtMYo9Wo5C3TeNRO+WcChyb/B5reyzo4ZWgX4ktkeN65NxaubXkZDrtWIWOpdPWauQaPWlfnUOxrVXsOIp5XVzxgoP2RE5GbU/kfoLVB2hCNb2sP2lzWX8ryP3srHuiAfx7BZq7nXqbGKM/UaNGdCvL/JksOGd88SyMJj/aAz51IDlLOpo7UXtUmjWis9nFSZq1Ad2uRuMMK50cuavxNjuWL8goT65QvIkx4nUQpanUbi4bVi5d7gAaaR04jKVyUWTmlFdBTtE2GRc0kEbpNcIbJsefMl6qP9TE/7Yud2xKSCd1pSJyV30qgIVgVWpXQiMBDTJjHBJTQtpmzdhq0c7glJK+2TZ8vMhAspiwBLhmcwaSPP/17s2L5gmoqSZgBbtMDdUnNBEZdZsB/J5xUpF3qshH+7v/9Yp9DPdBvE6OS39cSDN5MMKFQyVh0lyn3Pj+KJn/UNMVj+vTzUX34b5wx9ZhLYCvWt+1WojZv2ErXG5/+HCgnsBXTAqOI56sqGR41KW6Z09kvoRrUKhYREPPnyTAD+/fXFqF7Sp1/578q6MD8yKo+sFnTD++/fVK5RCzOYvrh0t5VWqoiSi8Ng4q+NZrQQd0SE/1pVof9FeCa4M1Z12R2ZOfCG35DACCtadz1jU0ttfZ2S6u20Cnd2RadkFZfqPRF6WvaBJWizwxzsadrsVBFMtYSqVLOgg2+2dspSSy3kDCVJ7STRUI0SL3S52vgOVCIjvJ7Sje+FkxDKtGdLX+pxl9qj1+4SSGElyRRaaJpHz7lNspjRUBrrcv6rYpdmGpKdiFcBXGNmA74U6J17TQ3709fKL1CF1wr9Al23uGMegQ09o/ouFJNEXpsOl6wLU327l/sWqAsefnY9ejXevdrvXqiQ4TqxHgKwO6TWqd7iXtGQJSqWCc81zo34Eyfi55D5q8Z79D1JqGAYXwwn+OdcOwZAJ6te4zz9+9/qWWUhxSdXqW+Xj6qSVtvO17zm40bSchZYpGAdg63nmy3wzfRvEThgX9Z4R0HpIP1NgjEwVuOJkf4upVudLzQOVM41Ibj9p52cc2GSIHPra3Gjw0o27zJgcK6zbywStByjKmIyyNehCkngEi5tq24vPKdkAvfYqgSK9QWza+DDUDEi/R2Uha6hN83ZtvzKq0iwp8PPJEVKDoU1FRk41U4FYZa3pK6gtzSyFarp3XOw5NvL2n5C/+pjh3eFRV98m2hOraCjFIgabq0UxKkkHzGW7/P/ctP4Gx9nB5gLnlYiypcoLUkuXosNHtOIvgV0iHk2wIVKXZMG+BGf52hRZ2T3XWPZTCUYURo+nujdmq4KQSmC3otFFYJ1LEzISx1kwjy0wZmrepxT82umWq8/BvNKFe6t0bG6qYbRrSa+En9wpaUCqd9WRq1CnKqV6ejiSU7hPS3Tgyma94vaD5Y1XM7C7jG2UrGtgCKqMoM62dg7TmA5C75+wIxuK8qLggKl5CUmDYCnca1FSuRdfBjKcyf9DNo6DM1/Y73j4LriXWdzbjSq9FGQkum5Lqkvz403tjQN59CHcA/l5pind4EIwvrptuyJwyWYlydiaXAu0FE5ymgRAi/rV3YLGnoNpU+ctHvhvLmzJrYIuljsi7DzUYQbkSaOp2aC1QCg+aqwcfg/tPqvssf5XA6cYwkuyu6/lSXJQs2Ao4Oq9M1HZdAbldxmynQRsyX7dG4N0bH41pj55eAB3mYi8I4UmAf97uYzY6pYXMSa+S8VxFrsMK1atth0MyRlXTjukL9+ZHxmIpfM1ZnF5LsSYSFkVKJa6KnaIsJd8obye0MFNJghKFjEERtRRFmhi/BMp85BGc/FYITU9PyYfW1dlOYqx1oWnovoOD5M0k9QMG56gsuJ+fgoObm+Q5VSSBOZ4ekVnYSuGfxuCo7Qp3sme2aqfm7jW+YaBhAdJFC82xmgvKABq8ciIZPHWD1ym0csTc5NuiNapFy31jibOOnWLxTVcDQplSPSQrlDnFe4lpnku2WNa90V56pZ7wfHUU9RiorvnK1B4TVepIYg26DCZBBtpqbAiUucyqGS8wIdB2V6dgxltblOYkNm8Qd7A2kCYMT/qJfGqaqtsTztTgFJUrmipjdBoTBidF08R0ijVT21ABKc3V4BFiVddLKbROITk7CThWVFevztDhK7GR50ZJpi475frLNWtblxVtu8+41UvY2Peq4dOSFqYsF24LxLzXLtXMHa48jR5Cr3kJTBKzFr7Yk3F+arKr+LSvC2tLtmKxW065n6Evasto1R+dUrv7aSAP1m8/se7v/AJnW2u6uThEzJrT3GmYjTrbym6t/ig2w2o56rLclNyYHdLN9fX1NSaINOIVRpxrcPVyIDlYgkEVEiIlMohurjti5oPj5sNi5yOIbV4v9Bbf7aZE5g21SZtXmqY4fwVHki99Fapui4/3yqqT0Jtrl7CoTsHtqz80t69Oyu13139ocr+7Pim7xqaf3q/7UC0dbZ9uIHHRRVAwIcclBZMjPzdTiR4IF/yKJWkPgdMxmYbjV39wji3HJzOdhuPvrv/gJFuST2dCDcsTMqHDCIwugg0cYEo9MXFe0DjWhx1luIMJX/feneJFXwPcEw5wu5TJ88yBcpPV3Hv6k5LysK7cgPbGDMf0pd12YybxsXQtdamDL4FjSyQTSS0xcQA+l1x5PoTPbQ7lizFQccXOi16EwUz+YEb/4SOr1HIroFGqLTgBGi8NIa0R1inWnBPvNBe92ZIjraerOuQyNTCi8NWAnsyAjjeUGWSRSWrrzNUcNEN3JfuNUNzaVJfvZ6DhBO86kX7u606+GK1wRj9NR+kllAf1peqQHF1zMw0nqXV1GmoXmWZVUfK8uviHR2mdIk1l0Bcms6FaFGqsYWC0Fkwv1ND1AcfNnLK0OP0RZzP90h0mGIWWZS1T05HkeatPX5A17UJH8KgNU0SGK6zWU7MNmJNpC7v6O36OD4OTYPUwWyHRXPn0c6hT3jHnllpP3K5UM8xxhovxNlkNg9Mp+HCyJm+K/OGuG3Bt0syI65R3EgOk1lMyQe3JZjq0U+LzrV43xmqkUXqcqr/ibm2dzG15nL7f0qZgp/vSKXU8M5M3JmLeGiJ9BqJT8F6G43GarsvjCX0XlP2g43ySpsLRYKDhqCMffnzry9hXZfRLIWMUnappKFWGZEvjgI3olHmI9TTj4XOwE46sNk9bBmMXS3t7GiVb0zQa7Y7szh8b71/YgKp9HuKBchGuQTiYgCOOlddc8E2GmYWlB2r2unjS556zwFJR+gpr43Gdbq7MCvz853e/dhOUMqUbNXWyfI5P6ywzyF5cjjVGDfJwl35m8vCy5tUMS4OV90Urcn5+92up7h5aGa7PrM9bXCBMw8fuoyUDSWW8ZDFNHyxVD9MyjfWwcZlm62E776kssVazE9b2dSdTHoUutZ4mW9WObDBvnSKbfO7HG+OfmyVlPGAuGjOvU+zWjCw/OYapJzCb3UyFDWqQoz1GR0axEua0NMY6EZUPdmUhEvd/iFR1m+JOoXuxg++lPJiC/nvzsm/eOrpe1Dvlztn0TqWWbLEAiXnm5mmBTqkG+sjx8G8hHz4DvTP6byF3KE6e/YKfemb/E0ul5Fg1obxO7oIB9vGtFJP48Tpbp1AJ1NYMNNXbzH13fHp+9IhSD4yfjVbsSixkifUSGNfCzSpXNsNcyTevr4PcQw9R6CdRRBS1TdqhqvTVyDm36etcFt3RG1oGSblyhUnL16VeXOLzL51iu6zlfmuGVOoBW54Ma9UgMcLwX2hJZJCvUfpiN0xG1/flsceevVdwWLFYYzrp1FxnY/yrOqsS4pSyDJLRmmJUc8kWy6kpaPqtvM/katGQt2Xkjcrqt4k55coLTQqeBF9Usn+XQFcbz1WZhWoXOdveAt/8NSnG2Fogo7hT9pA0zzrvprSgilKx3pfz0TPeuw2lk2DjTW7kWO4I04qkYk1mouAJrT/a1/5fgJ19WcABeGYanNsE5UOunhNoDCzcktqXSMqcaAR7Xnoy+unM7NQIsRMDhwqdoTOhBVmIio2MfjovGUJkT02Gi9GaXxjDZOyPuxfOBFdYdaVxCfO0fDw8sjQ9GyldMwdBlBdyyf39L/YnNQ/zFCxYPythUm+mHIdYU6bRimiBy/VaMq2BE/wMZlXg3ii0cp+OMEQA2PyUSZsBUvb0ZNnN74PSU+LL+UllNQqkxu3SDVB1Xo5USmdT46Y8v2X8ylFj4hFKyyLWeFJ4Zo7E9MaPf17XvxZp9oAZUwqrE7iz3DOzhBvuydCEm0hvlhq1XbcCq+di6UstS+Bpnco12y+sMsGe9H4tTjCwOMGe/P7B6xPY+gQuOt0p/muJgt0lCqZmPr/QKgVTM6NfaqGCyZnTz7BWwanMqudmlj4ycdh12x9SEdff3/96y/bYt2zD1bt7dbHVCKayA7OToUq4Nbjs2e4cJKZ2aGECsCbNAKfFzAyqBA/vOhsnPZc9RtHExN4k7UnA3bf3+BqCdLHmHCQabms2Uf39FTelFaF6LcOdg+AWNxcpi2u2pJcZTP+Y+PhBiB0jpkFQp9jmiDnKWoOYnmA8YbNDBtQeN1OPwwtGgGHi48lHqbWY0IBCUPAEI8q0O+kh9aUG0e7up7MD/MICaHf309n1fWnBs7v7Ce30PqPA2d19dBGU+zVoNihoNiVz+YUGzKZkNr/UYNmkzOdnGCg7hRkteWGJOixG9veLoQlZDUfZxETs2wguezagSlB0QL2v0biDonGrl+OjcK5iVK8ap8nra+63yq/2oj19juovrvIADyD2dQOayMlr836gSfDuFOsfLiRcuCzzi7Zurp1IFVlGGwUmNdMp3Pq0dPJ++wPBad6jsRPh4+HlndhKV1lwjhE592TqUqgaZIJVF4EqbJRe9M9ar54J914M7LQdndWOBFSwDVymtvBWOJxiR0JSDWxP2BgsLEnh6EBQ6CgUKgXIT0GJFzwOjRZ4r/T4YKzcUVh+F9mMHb+HrNhRSAr+yMWaHx1KhaH2JBDWE9RUYzHhIk3wrVXzdqCWDFboCkq82+cQRRdtqJKy5BDb1Pr+eEuD/wwSFV6Od3FFM/DegQ3dh3vIXP9meoMJvPqI7Xu5V0buECSYuuwrjhxpwNRGjLvmbhvpaf+U9t40XuMBizcoprQiYh7GZI5ejguqosQKr+NZijRRvUjUhseQnAqK4HU05hofXmVjnGC70UUblBLxI+hDZi0WZbJS/J1xLLRkxkp00GxOmIQY+yDIVXhKwSeKTxPfYvKsyNhYOv9brA19Vh9TkJFxps3j3hF5KxQ+C+bevbQvo/t2LokotLnJtV1sRUhTFwLQraooCeo8pxlLN3spnK/+Mk7Z10mCgQvX5g5geAsgjVgehMbyLlQ3//kyuo5eRje4dry8vr65vX7zw99uX//wX29u//b9d69ub2/Ggf4ZcZC7t4Ra9O7KuXvumnJy93b1F2zs7u3qVfmhUkyPbvhidVC7wKws9Xv5ch/42NQOviVkQsMECH9ngByZcafdWSh3CgznHL32IKodM/Cvr65e3txc3dz89eq7VxFfR+43USyyaBzmtx/e4T1iIRNClRKxMUAmoGLMk/R9EpE7jS6ZmGEFI0jIiuGDvyuQqu0AEOzCVIjHzi33FeH/T935PLltQnH8vn8Fs6dkmjpNjr21aXtKJzPd9qxF0rPNDAIFkNf+7zuPHxIrS2ukmMQ7uydbhs97IPjqAU+xG8DwusAUSoUUsMYfq83HPICw3eLgbyMd7c8cDni4RjaUCfIG/v38x9ugh7wvsNFcvmt8w3Mjz7OGcloC35C/pAqI76wzsbSfPtgH4futlJuSqs1Ocip2G6l2m3v07338wdgYm7DPHvzBMmowoBqGY31fPKkkHo1220EEgaaEuoaaVLI99bqOmnhitH/2B3tj2l/fv2+7krNKd9stO1qO/uJLfbkApaRa0IIXOuefWJxvwjKY6V7A2reJ7YG+uxGfN3Hw2ySxfyDZtKyeZJ0YEy6ADsE9X7bTJ7IF4U/Xunk+EaySTUNFfT1Hxg8YvnDyxsaIEE+Sj7+Qak9dTlC85svD21TUpuZMwLejvlgLHK9QQ/j7rdSSdzhQR1FPOELVuQQZ/Q8mkfAlCZurdZz/hp6DBfcRnqgrpfDkexxdSWWqaTkxVvsJNKj6mXCLVHiUTwFOsMP5bH8eMoQX/Y3mlttwKQfjpmx7Vuyjv9BUbYHFP+LoC4KWE8fdp54dYmuVMdMrRLPdIsFu/H9opDR4QF9hYgLMhdVOrhHNtMaY8UBVHs5/RngHqhgV1VrOhok8nH8zYSPvY3fKUoPCuNMKXvAHTU0O4OGpuxMo0w42E1pfpzvcii6XnenTGPie3Rc4y25DFUVeC8ZxFQ27BoTRkeNMfMfNwlbuVf9MiuKJiVo+ZYB9AGHzCw51EVeXPRIb0BNgNZdPGKVTpjB7BRqDNTl4McGNrYb01SxFtbs+C3sueCnhpb2oCRb8jrUTu7iFooRWGPHlUO+GAb4FUMl2YBJmdviBpgQAtyV7AX9ospkEey/SJ9A9+OKtr5fgMJGTJrgrgUhIg+zFXBN9n/b1C7MaRE2+dtABJuGzTiWneNDt/WgH42JixXXtgqof5eNoqP9ovLbTR0JLMKmxUMzxTjm/lnr7hJsFLCDlPJU7RXPZrI9n317oBSvmqwh3QJylctHXDFiDEOgDvAuwQOOjDdN7qLOyRfXgeonAwIoUKYT6JAo9vUnkenjeYcSok09h1AMTGgEn8iqoDll5ByKi4GsHOhow7aYoHHoqiREmjNo9G39mybdMFJjE6UNW9ODqikvtYUPmqPCk5uNpOJAaGc/8JN66ctGOj7dlh70wAR9Fu+XPiu+xgxUB3Uiyp8Ju4kDhZzTRhnEeJjefQifBClt+Vgs60UUWpCLl9+yoY5SncV+Y6ip2bWtpT8FdrqjPb8+c6J5FNRemc2dlqnl43fnyaW7rSmn22BCa+NfdaAyR4k05gxxwUR+5KRjU9UXSWCB1Gv1No0m/lWqxVppY/bqGfz/PQM1yYPYpLncZUD71M+WzQQ6XDasK2ihceCafgtsTbWjoschnR9gaqv3rEBDMWUB8peHTb7Ujl34+v+lcHw5o7wgc+2xjU3YksEcyM6sFM3I2bH1J9PRNSdsV7Dcocxda8Sok70qbblH+LjTlFUjhhRZ9b1m8Bu/VSOSFxr1mubyiHW9JOk/jB9quxWvuLunlF+geXRGPz16kFrIziB1xeYJ8Rf7gyWQ8NH1naIdFS7Fp9F2ih0OkOfx09DUTbWeKcFHDOGd+sfFuUSvhVqAvD8FWJp4Vtbn7fwDHy5oD"
 }
@@ -37,6 +37,14 @@ func init() {
 	mb.Registry.MustAddMetricSet("system", "network", New,
 		mb.WithHostParser(parse.EmptyHostParser),
 		mb.DefaultMetricSet(),
+		mb.WithCounters(mb.Counters{
+			KeyFields: []string{"name"},
+			Fields: []string{
+				"in.bytes", "in.packets", "in.errors", "in.dropped",
+				"out.bytes", "out.packets", "out.errors", "out.dropped",
+			},
+			Mode: mb.CounterRate,
+		}),
 	)
 }
 
@@ -0,0 +1,454 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package process
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/metric/system/pressure"
+)
+
+// cgroupV2Reader reads metrics and limits of processes from the cgroup v2
+// unified hierarchy. Metrics are reported with the same fields used for
+// cgroup v1 when they are equivalent.
+type cgroupV2Reader struct {
+	rootfsMountpoint  string
+	mountpoint        string // Mountpoint of the unified hierarchy (e.g. /sys/fs/cgroup).
+	ignoreRootCgroups bool   // Ignore a cgroup when its path is "/".
+}
+
+// newCgroupV2Reader returns a reader for the cgroup v2 hierarchy, or nil if it
+// is not mounted.
+func newCgroupV2Reader(rootfsMountpoint string, ignoreRootCgroups bool) (*cgroupV2Reader, error) {
+	if rootfsMountpoint == "" {
+		rootfsMountpoint = "/"
+	}
+
+	mountpoint, err := cgroupV2Mountpoint(rootfsMountpoint)
+	if err != nil || mountpoint == "" {
+		return nil, err
+	}
+
+	return &cgroupV2Reader{
+		rootfsMountpoint:  rootfsMountpoint,
+		mountpoint:        mountpoint,
+		ignoreRootCgroups: ignoreRootCgroups,
+	}, nil
+}
+
+// cgroupV2Mountpoint returns the mountpoint of the cgroup2 filesystem found in
+// /proc/self/mountinfo, or an empty string if there is none.
+func cgroupV2Mountpoint(rootfsMountpoint string) (string, error) {
+	mountinfo, err := os.Open(filepath.Join(rootfsMountpoint, "proc", "self", "mountinfo"))
+	if err != nil {
+		return "", err
+	}
+	defer mountinfo.Close()
+
+	sc := bufio.NewScanner(mountinfo)
+	for sc.Scan() {
+		// Example:
+		// 29 23 0:26 / /sys/fs/cgroup rw,nosuid,nodev,noexec,relatime - cgroup2 cgroup2 rw
+		fields := strings.Fields(sc.Text())
+		for i, field := range fields {
+			if field != "-" || i < 5 || i+1 >= len(fields) {
+				continue
+			}
+			mountpoint := fields[4]
+			if fields[i+1] == "cgroup2" && strings.HasPrefix(mountpoint, rootfsMountpoint) {
+				return mountpoint, nil
+			}
+			break
+		}
+	}
+	return "", sc.Err()
+}
+
+// processCgroupV2Path returns the path of the cgroup v2 of a process, relative
+// to the mountpoint of the unified hierarchy.
+func processCgroupV2Path(rootfsMountpoint string, pid int) (string, error) {
+	data, err := ioutil.ReadFile(filepath.Join(rootfsMountpoint, "proc", strconv.Itoa(pid), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		// The unified hierarchy has ID 0 and no subsystems.
+		// Example: 0::/system.slice/docker-<id>.scope
+		if strings.HasPrefix(line, "0::") {
+			return strings.TrimSpace(line[3:]), nil
+		}
+	}
+	return "", nil
+}
+
+// GetStatsForProcess returns the cgroup metrics and limits of a process, or
+// nil if it doesn't belong to a cgroup v2.
+func (r *cgroupV2Reader) GetStatsForProcess(pid int) (common.MapStr, error) {
+	path, err := processCgroupV2Path(r.rootfsMountpoint, pid)
+	if err != nil || path == "" {
+		return nil, err
+	}
+	if path == "/" && r.ignoreRootCgroups {
+		return nil, nil
+	}
+
+	dir := filepath.Join(r.mountpoint, path)
+	metadata := common.MapStr{
+		"id":   filepath.Base(path),
+		"path": path,
+	}
+
+	stats := metadata.Clone()
+	collected := false
+	for name, read := range map[string]func(string) (common.MapStr, error){
+		"cpu":     readCgroupV2CPU,
+		"cpuacct": readCgroupV2CPUAccounting,
+		"memory":  readCgroupV2Memory,
+		"blkio":   readCgroupV2IO,
+		"pids":    readCgroupV2Pids,
+	} {
+		subsystem, err := read(dir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading cgroup v2 %s metrics", name)
+		}
+		if subsystem == nil {
+			continue
+		}
+		subsystem.Update(metadata)
+		stats[name] = subsystem
+		collected = true
+	}
+
+	if !collected {
+		return nil, nil
+	}
+	return stats, nil
+}
+
+// readCgroupV2CPU reads the cpu controller limits and throttling stats.
+func readCgroupV2CPU(dir string) (common.MapStr, error) {
+	stat, err := readCgroupV2KeyValues(filepath.Join(dir, "cpu.stat"))
+	if err != nil || stat == nil {
+		return nil, err
+	}
+
+	cpu := common.MapStr{}
+	if periods, found := stat["nr_periods"]; found {
+		cpu["stats"] = common.MapStr{
+			"periods": periods,
+			"throttled": common.MapStr{
+				"periods": stat["nr_throttled"],
+				"ns":      stat["throttled_usec"] * 1000,
+			},
+		}
+	}
+
+	// cpu.max contains the quota and the period, as in "max 100000" when
+	// there is no quota.
+	if max, err := ioutil.ReadFile(filepath.Join(dir, "cpu.max")); err == nil {
+		fields := strings.Fields(string(max))
+		if len(fields) == 2 {
+			cfs := common.MapStr{}
+			if quota, err := strconv.ParseUint(fields[0], 10, 64); err == nil {
+				cfs.Put("quota.us", quota)
+			}
+			if period, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+				cfs.Put("period.us", period)
+			}
+			cpu["cfs"] = cfs
+		}
+	}
+
+	if weight, found, err := readCgroupV2Uint(filepath.Join(dir, "cpu.weight")); err != nil {
+		return nil, err
+	} else if found {
+		cpu["weight"] = weight
+	}
+
+	if err := addCgroupV2Pressure(cpu, filepath.Join(dir, "cpu.pressure")); err != nil {
+		return nil, err
+	}
+
+	if len(cpu) == 0 {
+		return nil, nil
+	}
+	return cpu, nil
+}
+
+// readCgroupV2CPUAccounting reads the CPU usage, always available in cpu.stat.
+func readCgroupV2CPUAccounting(dir string) (common.MapStr, error) {
+	stat, err := readCgroupV2KeyValues(filepath.Join(dir, "cpu.stat"))
+	if err != nil || stat == nil {
+		return nil, err
+	}
+
+	return common.MapStr{
+		"total": common.MapStr{
+			"ns": stat["usage_usec"] * 1000,
+		},
+		"stats": common.MapStr{
+			"system": common.MapStr{
+				"ns": stat["system_usec"] * 1000,
+			},
+			"user": common.MapStr{
+				"ns": stat["user_usec"] * 1000,
+			},
+		},
+	}, nil
+}
+
+// cgroupV2MemoryStats maps the keys of memory.stat to their equivalent
+// cgroup v1 stats.
+var cgroupV2MemoryStats = map[string]string{
+	"anon":           "rss.bytes",
+	"anon_thp":       "rss_huge.bytes",
+	"file":           "cache.bytes",
+	"file_mapped":    "mapped_file.bytes",
+	"file_dirty":     "dirty.bytes",
+	"file_writeback": "writeback.bytes",
+	"active_anon":    "active_anon.bytes",
+	"inactive_anon":  "inactive_anon.bytes",
+	"active_file":    "active_file.bytes",
+	"inactive_file":  "inactive_file.bytes",
+	"unevictable":    "unevictable.bytes",
+	"kernel_stack":   "kernel_stack.bytes",
+	"slab":           "slab.bytes",
+	"sock":           "sock.bytes",
+	"shmem":          "shmem.bytes",
+	"pgfault":        "page_faults",
+	"pgmajfault":     "major_page_faults",
+}
+
+// readCgroupV2Memory reads the memory controller usage, limits and events.
+func readCgroupV2Memory(dir string) (common.MapStr, error) {
+	usage, found, err := readCgroupV2Uint(filepath.Join(dir, "memory.current"))
+	if err != nil || !found {
+		return nil, err
+	}
+
+	mem := common.MapStr{
+		"usage": common.MapStr{
+			"bytes": usage,
+		},
+	}
+	if max, found, err := readCgroupV2Uint(filepath.Join(dir, "memory.peak")); err != nil {
+		return nil, err
+	} else if found {
+		mem.Put("usage.max.bytes", max)
+	}
+	if limit, found, err := readCgroupV2Uint(filepath.Join(dir, "memory.max")); err != nil {
+		return nil, err
+	} else if found {
+		mem.Put("limit.bytes", limit)
+	}
+	if high, found, err := readCgroupV2Uint(filepath.Join(dir, "memory.high")); err != nil {
+		return nil, err
+	} else if found {
+		mem.Put("high.bytes", high)
+	}
+
+	memory := common.MapStr{"mem": mem}
+
+	events, err := readCgroupV2KeyValues(filepath.Join(dir, "memory.events"))
+	if err != nil {
+		return nil, err
+	}
+	if events != nil {
+		mem["failures"] = events["max"]
+		memory["events"] = common.MapStr{
+			"low":      events["low"],
+			"high":     events["high"],
+			"max":      events["max"],
+			"oom":      events["oom"],
+			"oom_kill": events["oom_kill"],
+		}
+	}
+
+	stat, err := readCgroupV2KeyValues(filepath.Join(dir, "memory.stat"))
+	if err != nil {
+		return nil, err
+	}
+	stats := common.MapStr{}
+	for key, field := range cgroupV2MemoryStats {
+		if value, found := stat[key]; found {
+			stats.Put(field, value)
+		}
+	}
+	if swap, found, err := readCgroupV2Uint(filepath.Join(dir, "memory.swap.current")); err != nil {
+		return nil, err
+	} else if found {
+		stats.Put("swap.bytes", swap)
+	}
+	if len(stats) > 0 {
+		memory["stats"] = stats
+	}
+
+	if err := addCgroupV2Pressure(memory, filepath.Join(dir, "memory.pressure")); err != nil {
+		return nil, err
+	}
+	return memory, nil
+}
+
+// readCgroupV2IO reads the IO controller stats, aggregated for all devices.
+func readCgroupV2IO(dir string) (common.MapStr, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "io.stat"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Each line contains the stats of a device.
+	// Example: 8:0 rbytes=1459200 wbytes=314773504 rios=192 wios=353 dbytes=0 dios=0
+	var rbytes, wbytes, rios, wios uint64
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		for _, field := range fields[1:] {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			value, err := strconv.ParseUint(kv[1], 10, 64)
+			if err != nil {
+				continue
+			}
+			switch kv[0] {
+			case "rbytes":
+				rbytes += value
+			case "wbytes":
+				wbytes += value
+			case "rios":
+				rios += value
+			case "wios":
+				wios += value
+			}
+		}
+	}
+
+	blkio := common.MapStr{
+		"total": common.MapStr{
+			"bytes": rbytes + wbytes,
+			"ios":   rios + wios,
+		},
+		"read": common.MapStr{
+			"bytes": rbytes,
+			"ios":   rios,
+		},
+		"write": common.MapStr{
+			"bytes": wbytes,
+			"ios":   wios,
+		},
+	}
+
+	if err := addCgroupV2Pressure(blkio, filepath.Join(dir, "io.pressure")); err != nil {
+		return nil, err
+	}
+	return blkio, nil
+}
+
+// readCgroupV2Pids reads the number of tasks and their limit.
+func readCgroupV2Pids(dir string) (common.MapStr, error) {
+	current, found, err := readCgroupV2Uint(filepath.Join(dir, "pids.current"))
+	if err != nil || !found {
+		return nil, err
+	}
+
+	pids := common.MapStr{"current": current}
+	if max, found, err := readCgroupV2Uint(filepath.Join(dir, "pids.max")); err != nil {
+		return nil, err
+	} else if found {
+		pids["max"] = max
+	}
+	return pids, nil
+}
+
+// addCgroupV2Pressure adds the pressure stall information of a PSI file.
+func addCgroupV2Pressure(m common.MapStr, path string) error {
+	stalls, err := pressure.Read(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if len(stalls) > 0 {
+		m["pressure"] = stalls
+	}
+	return nil
+}
+
+// readCgroupV2Uint reads a file with a single value. It returns false if the
+// file doesn't exist or if the value is "max", used for unlimited values.
+func readCgroupV2Uint(path string) (uint64, bool, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	value := string(bytes.TrimSpace(data))
+	if value == "max" {
+		return 0, false, nil
+	}
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "error parsing %s", path)
+	}
+	return v, true, nil
+}
+
+// readCgroupV2KeyValues reads a flat keyed file, as cpu.stat or memory.stat.
+// It returns nil if the file doesn't exist.
+func readCgroupV2KeyValues(path string) (map[string]uint64, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	values := map[string]uint64{}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			values[fields[0]] = v
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,191 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package process
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const testContainerCgroup = "/system.slice/docker-abc.scope"
+
+// newTestCgroupV2Rootfs creates a root filesystem with a cgroup v2 hierarchy
+// and two processes, one of them in the root cgroup.
+func newTestCgroupV2Rootfs(t *testing.T) string {
+	rootfs, err := ioutil.TempDir("", "cgroupv2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cgroupDir := filepath.Join("sys", "fs", "cgroup", testContainerCgroup)
+	files := map[string]string{
+		"proc/self/mountinfo": "25 30 0:23 / /proc rw,nosuid,nodev,noexec,relatime shared:14 - proc proc rw\n" +
+			"29 23 0:26 / " + filepath.Join(rootfs, "sys", "fs", "cgroup") + " rw,nosuid,nodev,noexec,relatime shared:4 - cgroup2 cgroup2 rw\n",
+		"proc/100/cgroup": "0::" + testContainerCgroup + "\n",
+		"proc/1/cgroup":   "0::/\n",
+
+		filepath.Join(cgroupDir, "cpu.stat"): "usage_usec 2000\nuser_usec 1500\nsystem_usec 500\n" +
+			"nr_periods 10\nnr_throttled 2\nthrottled_usec 300\n",
+		filepath.Join(cgroupDir, "cpu.max"):      "50000 100000\n",
+		filepath.Join(cgroupDir, "cpu.weight"):   "100\n",
+		filepath.Join(cgroupDir, "cpu.pressure"): "some avg10=1.50 avg60=0.75 avg300=0.25 total=12345\n",
+
+		filepath.Join(cgroupDir, "memory.current"): "4096000\n",
+		filepath.Join(cgroupDir, "memory.max"):     "max\n",
+		filepath.Join(cgroupDir, "memory.high"):    "8192000\n",
+		filepath.Join(cgroupDir, "memory.events"):  "low 0\nhigh 3\nmax 1\noom 0\noom_kill 0\n",
+		filepath.Join(cgroupDir, "memory.stat"): "anon 1024000\nfile 2048000\nfile_mapped 512000\n" +
+			"inactive_file 1024000\npgfault 42\npgmajfault 1\n",
+		filepath.Join(cgroupDir, "memory.swap.current"): "0\n",
+
+		filepath.Join(cgroupDir, "io.stat"): "8:0 rbytes=1000 wbytes=2000 rios=10 wios=20 dbytes=0 dios=0\n" +
+			"8:16 rbytes=100 wbytes=200 rios=1 wios=2 dbytes=0 dios=0\n",
+
+		filepath.Join(cgroupDir, "pids.current"): "5\n",
+		filepath.Join(cgroupDir, "pids.max"):     "max\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(rootfs, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return rootfs
+}
+
+func TestCgroupV2Stats(t *testing.T) {
+	rootfs := newTestCgroupV2Rootfs(t)
+	defer os.RemoveAll(rootfs)
+
+	reader, err := newCgroupV2Reader(rootfs, true)
+	if !assert.NoError(t, err) || !assert.NotNil(t, reader) {
+		return
+	}
+
+	stats, err := reader.GetStatsForProcess(100)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	metadata := common.MapStr{"id": "docker-abc.scope", "path": testContainerCgroup}
+	withMetadata := func(m common.MapStr) common.MapStr {
+		m.Update(metadata)
+		return m
+	}
+
+	assert.Equal(t, common.MapStr{
+		"id":   "docker-abc.scope",
+		"path": testContainerCgroup,
+		"cpu": withMetadata(common.MapStr{
+			"cfs": common.MapStr{
+				"period": common.MapStr{"us": uint64(100000)},
+				"quota":  common.MapStr{"us": uint64(50000)},
+			},
+			"stats": common.MapStr{
+				"periods": uint64(10),
+				"throttled": common.MapStr{
+					"periods": uint64(2),
+					"ns":      uint64(300000),
+				},
+			},
+			"weight": uint64(100),
+			"pressure": common.MapStr{
+				"some": common.MapStr{
+					"10":    common.MapStr{"pct": 0.015},
+					"60":    common.MapStr{"pct": 0.0075},
+					"300":   common.MapStr{"pct": 0.0025},
+					"total": common.MapStr{"us": uint64(12345)},
+				},
+			},
+		}),
+		"cpuacct": withMetadata(common.MapStr{
+			"total": common.MapStr{"ns": uint64(2000000)},
+			"stats": common.MapStr{
+				"system": common.MapStr{"ns": uint64(500000)},
+				"user":   common.MapStr{"ns": uint64(1500000)},
+			},
+		}),
+		"memory": withMetadata(common.MapStr{
+			"mem": common.MapStr{
+				"usage":    common.MapStr{"bytes": uint64(4096000)},
+				"high":     common.MapStr{"bytes": uint64(8192000)},
+				"failures": uint64(1),
+			},
+			"events": common.MapStr{
+				"low":      uint64(0),
+				"high":     uint64(3),
+				"max":      uint64(1),
+				"oom":      uint64(0),
+				"oom_kill": uint64(0),
+			},
+			"stats": common.MapStr{
+				"rss":               common.MapStr{"bytes": uint64(1024000)},
+				"cache":             common.MapStr{"bytes": uint64(2048000)},
+				"mapped_file":       common.MapStr{"bytes": uint64(512000)},
+				"inactive_file":     common.MapStr{"bytes": uint64(1024000)},
+				"swap":              common.MapStr{"bytes": uint64(0)},
+				"page_faults":       uint64(42),
+				"major_page_faults": uint64(1),
+			},
+		}),
+		"blkio": withMetadata(common.MapStr{
+			"total": common.MapStr{"bytes": uint64(3300), "ios": uint64(33)},
+			"read":  common.MapStr{"bytes": uint64(1100), "ios": uint64(11)},
+			"write": common.MapStr{"bytes": uint64(2200), "ios": uint64(22)},
+		}),
+		"pids": withMetadata(common.MapStr{
+			"current": uint64(5),
+		}),
+	}, stats)
+
+	// Processes in the root cgroup are ignored
+	stats, err = reader.GetStatsForProcess(1)
+	assert.NoError(t, err)
+	assert.Nil(t, stats)
+}
+
+func TestCgroupV2ReaderNotMounted(t *testing.T) {
+	rootfs := newTestCgroupV2Rootfs(t)
+	defer os.RemoveAll(rootfs)
+
+	// There is no mountinfo in this root filesystem
+	reader, err := newCgroupV2Reader(filepath.Join(rootfs, "proc"), true)
+	assert.Error(t, err)
+	assert.Nil(t, reader)
+
+	// No cgroup2 filesystem is mounted
+	err = ioutil.WriteFile(filepath.Join(rootfs, "proc", "self", "mountinfo"),
+		[]byte("25 30 0:23 / /proc rw,nosuid - proc proc rw\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader, err = newCgroupV2Reader(rootfs, true)
+	assert.NoError(t, err)
+	assert.Nil(t, reader)
+}
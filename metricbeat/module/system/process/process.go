@@ -48,6 +48,7 @@ type MetricSet struct {
 	mb.BaseMetricSet
 	stats        *process.Stats
 	cgroup       *cgroup.Reader
+	cgroupV2     *cgroupV2Reader
 	cacheCmdLine bool
 }
 
@@ -82,12 +83,17 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		if config.Cgroups == nil || *config.Cgroups {
 			debugf("process cgroup data collection is enabled, using hostfs='%v'", systemModule.HostFS)
 			m.cgroup, err = cgroup.NewReader(systemModule.HostFS, true)
+			if err != nil && err != cgroup.ErrCgroupsMissing {
+				return nil, errors.Wrap(err, "error initializing cgroup reader")
+			}
+
+			m.cgroupV2, err = newCgroupV2Reader(systemModule.HostFS, true)
 			if err != nil {
-				if err == cgroup.ErrCgroupsMissing {
-					logp.Warn("cgroup data collection will be disabled: %v", err)
-				} else {
-					return nil, errors.Wrap(err, "error initializing cgroup reader")
-				}
+				debugf("cgroup v2 data collection will be disabled: %v", err)
+			}
+
+			if m.cgroup == nil && m.cgroupV2 == nil {
+				logp.Warn("cgroup data collection will be disabled: %v", cgroup.ErrCgroupsMissing)
 			}
 		}
 	}
@@ -103,20 +109,15 @@ func (m *MetricSet) Fetch() ([]common.MapStr, error) {
 		return nil, errors.Wrap(err, "process stats")
 	}
 
-	if m.cgroup != nil {
+	if m.cgroup != nil || m.cgroupV2 != nil {
 		for _, proc := range procs {
 			pid, ok := proc["pid"].(int)
 			if !ok {
 				debugf("error converting pid to int for proc %+v", proc)
 				continue
 			}
-			stats, err := m.cgroup.GetStatsForProcess(pid)
-			if err != nil {
-				debugf("error getting cgroups stats for pid=%d, %v", pid, err)
-				continue
-			}
 
-			if statsMap := cgroupStatsToMap(stats); statsMap != nil {
+			if statsMap := m.cgroupStats(pid); statsMap != nil {
 				proc["cgroup"] = statsMap
 			}
 		}
@@ -124,3 +125,28 @@ func (m *MetricSet) Fetch() ([]common.MapStr, error) {
 
 	return procs, err
 }
+
+// cgroupStats returns the cgroup metrics of a process. Metrics are read from
+// the cgroup v2 unified hierarchy when the process has no cgroup v1 metrics.
+func (m *MetricSet) cgroupStats(pid int) common.MapStr {
+	if m.cgroup != nil {
+		stats, err := m.cgroup.GetStatsForProcess(pid)
+		if err != nil {
+			debugf("error getting cgroups stats for pid=%d, %v", pid, err)
+			return nil
+		}
+		if statsMap := cgroupStatsToMap(stats); statsMap != nil {
+			return statsMap
+		}
+	}
+
+	if m.cgroupV2 != nil {
+		stats, err := m.cgroupV2.GetStatsForProcess(pid)
+		if err != nil {
+			debugf("error getting cgroup v2 stats for pid=%d, %v", pid, err)
+			return nil
+		}
+		return stats
+	}
+	return nil
+}
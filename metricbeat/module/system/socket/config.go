@@ -22,6 +22,7 @@ import "time"
 // Config is the configuration specific to the socket MetricSet.
 type Config struct {
 	ReverseLookup *ReverseLookupConfig `config:"socket.reverse_lookup"`
+	TCPInfo       bool                 `config:"socket.tcp_info"`
 }
 
 // ReverseLookupConfig contains the configuration that controls the reverse
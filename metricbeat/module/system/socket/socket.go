@@ -20,6 +20,7 @@
 package socket
 
 import (
+	"bytes"
 	"fmt"
 	"net"
 	"os"
@@ -59,6 +60,7 @@ type MetricSet struct {
 	reverseLookup *ReverseLookupCache
 	listeners     *ListenerTable
 	users         UserCache
+	tcpInfo       bool
 }
 
 func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
@@ -90,6 +92,7 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		currentConns:  hashSet{},
 		listeners:     NewListenerTable(),
 		users:         NewUserCache(),
+		tcpInfo:       c.TCPInfo,
 	}
 
 	if c.ReverseLookup.IsEnabled() {
@@ -115,9 +118,7 @@ func (m *MetricSet) Fetch() ([]common.MapStr, error) {
 	}
 
 	// Send request over netlink and parse responses.
-	req := linux.NewInetDiagReq()
-	req.Header.Seq = atomic.AddUint32(&m.seq, 1)
-	sockets, err := linux.NetlinkInetDiagWithBuf(req, m.readBuffer, nil)
+	sockets, tcpInfos, err := m.dumpSockets()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed requesting socket dump")
 	}
@@ -130,6 +131,7 @@ func (m *MetricSet) Fetch() ([]common.MapStr, error) {
 	rtn := make([]common.MapStr, 0, len(sockets))
 	for _, s := range sockets {
 		c := newConnection(s)
+		c.TCPInfo = tcpInfos[s]
 		m.enrichConnectionData(c)
 		rtn = append(rtn, c.ToMapStr())
 	}
@@ -145,6 +147,40 @@ func (m *MetricSet) Fetch() ([]common.MapStr, error) {
 	return rtn, nil
 }
 
+// dumpSockets requests a dump of all TCP sockets. If tcp_info is enabled it
+// also returns the tcp_info of each socket.
+func (m *MetricSet) dumpSockets() ([]*linux.InetDiagMsg, map[*linux.InetDiagMsg]*tcpInfo, error) {
+	if !m.tcpInfo {
+		req := linux.NewInetDiagReq()
+		req.Header.Seq = atomic.AddUint32(&m.seq, 1)
+		sockets, err := linux.NetlinkInetDiagWithBuf(req, m.readBuffer, nil)
+		return sockets, nil, err
+	}
+
+	req := newInetDiagReqWithTCPInfo()
+	req.Header.Seq = atomic.AddUint32(&m.seq, 1)
+	var raw bytes.Buffer
+	sockets, err := linux.NetlinkInetDiagWithBuf(req, m.readBuffer, &raw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	infos, err := parseTCPInfos(raw.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(infos) != len(sockets) {
+		return nil, nil, errors.Errorf("found tcp_info for %d sockets, expected %d",
+			len(infos), len(sockets))
+	}
+
+	tcpInfos := make(map[*linux.InetDiagMsg]*tcpInfo, len(sockets))
+	for i, s := range sockets {
+		tcpInfos[s] = infos[i]
+	}
+	return sockets, tcpInfos, nil
+}
+
 // filterAndRememberSockets filters sockets to remove sockets that were seen
 // during the last poll. It stores all of the sockets it sees for the next
 // poll.
@@ -244,6 +280,8 @@ type connection struct {
 	// User identifiers.
 	UID      uint32 // UID of the socket owner.
 	Username string // Username of the socket.
+
+	TCPInfo *tcpInfo // TCP info of the socket, if requested.
 }
 
 func newConnection(diag *linux.InetDiagMsg) *connection {
@@ -292,6 +330,10 @@ func (c *connection) ToMapStr() common.MapStr {
 		}
 	}
 
+	if c.TCPInfo != nil {
+		evt["tcp"] = c.TCPInfo.toMapStr()
+	}
+
 	if c.RemotePort != 0 {
 		remote := common.MapStr{
 			"ip":   c.RemoteIP.String(),
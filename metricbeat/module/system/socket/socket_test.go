@@ -105,6 +105,50 @@ func TestFetch(t *testing.T) {
 	assert.True(t, found, "listener not found")
 }
 
+func TestFetchTCPInfo(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	localPort := conn.LocalAddr().(*net.TCPAddr).Port
+
+	config := getConfig()
+	config["socket.tcp_info"] = true
+	f := mbtest.NewEventsFetcher(t, config)
+	events, err := f.Fetch()
+	if err != nil {
+		t.Fatal("fetch", err)
+	}
+
+	var found bool
+	for _, evt := range events {
+		port, ok := getRequiredValue("local.port", evt, t).(int)
+		if !ok {
+			t.Fatal("local.port is not an int")
+		}
+		if port != localPort {
+			continue
+		}
+
+		_ = getRequiredValue("tcp.rtt.us", evt, t).(uint32)
+		_ = getRequiredValue("tcp.congestion_window", evt, t).(uint32)
+		_ = getRequiredValue("tcp.total_retransmits", evt, t).(uint32)
+
+		found = true
+		break
+	}
+
+	assert.True(t, found, "connection not found")
+}
+
 func getRequiredValue(key string, m common.MapStr, t testing.TB) interface{} {
 	v, err := m.GetValue(key)
 	if err != nil {
@@ -0,0 +1,181 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package socket
+
+import (
+	"syscall"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/gosigar/sys"
+	"github.com/elastic/gosigar/sys/linux"
+)
+
+// byteOrder is the byte order of the netlink messages, the host's one.
+var byteOrder = sys.GetEndian()
+
+const (
+	// inetDiagInfo is the type of the response attribute with the tcp_info
+	// of a socket.
+	inetDiagInfo = 2
+
+	// inetDiagInfoExt is the bit of the idiag_ext field of the request that
+	// asks for the inet_diag_info attribute.
+	inetDiagInfoExt = 1 << (inetDiagInfo - 1)
+
+	// sizeofInetDiagMsg is the size of the inet_diag_msg header that precedes
+	// the attributes of each response.
+	sizeofInetDiagMsg = 72
+
+	sizeofRtAttr = 4
+)
+
+// tcpInfo contains the fields of the kernel tcp_info struct reported in the
+// events. Fields not returned by older kernels are left as nil.
+// https://github.com/torvalds/linux/blob/v4.19/include/uapi/linux/tcp.h#L168
+type tcpInfo struct {
+	Retransmits     uint8
+	RTT             uint32 // Smoothed round trip time in microseconds.
+	RTTVar          uint32 // Round trip time variance in microseconds.
+	SndSsthresh     uint32
+	SndCwnd         uint32
+	TotalRetrans    uint32
+	BytesAcked      *uint64
+	BytesReceived   *uint64
+	SegsOut, SegsIn *uint32
+	NotsentBytes    *uint32
+	MinRTT          *uint32
+}
+
+// newInetDiagReqWithTCPInfo returns a request for a socket dump that also asks
+// for the tcp_info of each socket.
+func newInetDiagReqWithTCPInfo() syscall.NetlinkMessage {
+	req := linux.NewInetDiagReq()
+	// The request data starts with the family, src_len, dst_len and ext
+	// fields of the inet_diag_req struct.
+	req.Data[3] = inetDiagInfoExt
+	return req
+}
+
+// parseTCPInfos parses the raw responses of a socket dump and returns the
+// tcp_info of each socket, in the same order as the sockets are returned by
+// linux.NetlinkInetDiagWithBuf. The tcp_info of a socket is nil if the kernel
+// did not include it.
+func parseTCPInfos(raw []byte) ([]*tcpInfo, error) {
+	msgs, err := syscall.ParseNetlinkMessage(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse netlink messages")
+	}
+
+	var infos []*tcpInfo
+	for _, m := range msgs {
+		if m.Header.Type == syscall.NLMSG_DONE {
+			break
+		}
+		if m.Header.Type == syscall.NLMSG_ERROR {
+			return nil, linux.ParseNetlinkError(m.Data)
+		}
+		if len(m.Data) < sizeofInetDiagMsg {
+			return nil, errors.New("inet_diag_msg is too short")
+		}
+
+		var info *tcpInfo
+		attrs := m.Data[sizeofInetDiagMsg:]
+		for len(attrs) >= sizeofRtAttr {
+			attrLen := int(byteOrder.Uint16(attrs[0:2]))
+			attrType := byteOrder.Uint16(attrs[2:4])
+			if attrLen < sizeofRtAttr || attrLen > len(attrs) {
+				return nil, errors.New("invalid netlink attribute length")
+			}
+			if attrType == inetDiagInfo {
+				info = parseTCPInfo(attrs[sizeofRtAttr:attrLen])
+			}
+
+			alignedLen := (attrLen + syscall.RTA_ALIGNTO - 1) &^ (syscall.RTA_ALIGNTO - 1)
+			if alignedLen > len(attrs) {
+				break
+			}
+			attrs = attrs[alignedLen:]
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// parseTCPInfo parses a tcp_info struct. It returns nil if the struct is
+// shorter than the fields available since Linux 2.6.
+func parseTCPInfo(b []byte) *tcpInfo {
+	if len(b) < 104 {
+		return nil
+	}
+
+	u32 := func(offset int) uint32 { return byteOrder.Uint32(b[offset:]) }
+	info := &tcpInfo{
+		Retransmits:  b[2],
+		RTT:          u32(68),
+		RTTVar:       u32(72),
+		SndSsthresh:  u32(76),
+		SndCwnd:      u32(80),
+		TotalRetrans: u32(100),
+	}
+
+	if len(b) >= 136 {
+		bytesAcked := byteOrder.Uint64(b[120:])
+		bytesReceived := byteOrder.Uint64(b[128:])
+		info.BytesAcked, info.BytesReceived = &bytesAcked, &bytesReceived
+	}
+	if len(b) >= 144 {
+		segsOut, segsIn := u32(136), u32(140)
+		info.SegsOut, info.SegsIn = &segsOut, &segsIn
+	}
+	if len(b) >= 152 {
+		notsentBytes, minRTT := u32(144), u32(148)
+		info.NotsentBytes, info.MinRTT = &notsentBytes, &minRTT
+	}
+	return info
+}
+
+// toMapStr returns the fields of the tcp_info reported in the events.
+func (i *tcpInfo) toMapStr() common.MapStr {
+	m := common.MapStr{
+		"rtt": common.MapStr{
+			"us":     i.RTT,
+			"var.us": i.RTTVar,
+		},
+		"retransmits":          i.Retransmits,
+		"total_retransmits":    i.TotalRetrans,
+		"congestion_window":    i.SndCwnd,
+		"slow_start_threshold": i.SndSsthresh,
+	}
+	if i.BytesAcked != nil {
+		m["bytes_acked"] = *i.BytesAcked
+		m["bytes_received"] = *i.BytesReceived
+	}
+	if i.SegsOut != nil {
+		m["segments_out"] = *i.SegsOut
+		m["segments_in"] = *i.SegsIn
+	}
+	if i.NotsentBytes != nil {
+		m["notsent_bytes"] = *i.NotsentBytes
+		m.Put("rtt.min.us", *i.MinRTT)
+	}
+	return m
+}
@@ -0,0 +1,116 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package socket
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestParseTCPInfos(t *testing.T) {
+	info := make([]byte, 160)
+	info[2] = 1                          // retransmits
+	byteOrder.PutUint32(info[68:], 1500) // rtt
+	byteOrder.PutUint32(info[72:], 250)  // rttvar
+	byteOrder.PutUint32(info[76:], 7)    // snd_ssthresh
+	byteOrder.PutUint32(info[80:], 10)   // snd_cwnd
+	byteOrder.PutUint32(info[100:], 3)   // total_retrans
+	byteOrder.PutUint64(info[120:], 4096)
+	byteOrder.PutUint64(info[128:], 2048)
+	byteOrder.PutUint32(info[136:], 20)
+	byteOrder.PutUint32(info[140:], 18)
+	byteOrder.PutUint32(info[144:], 0)
+	byteOrder.PutUint32(info[148:], 900)
+
+	var raw []byte
+	raw = append(raw, netlinkMessage(20, diagMsgWithAttrs(attr(4, []byte{1, 2, 3}), attr(inetDiagInfo, info)))...)
+	raw = append(raw, netlinkMessage(20, diagMsgWithAttrs())...)
+	raw = append(raw, netlinkMessage(20, diagMsgWithAttrs(attr(inetDiagInfo, info[:104])))...)
+	raw = append(raw, netlinkMessage(syscall.NLMSG_DONE, make([]byte, 4))...)
+
+	infos, err := parseTCPInfos(raw)
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+
+	assert.Equal(t, common.MapStr{
+		"rtt": common.MapStr{
+			"us":     uint32(1500),
+			"var.us": uint32(250),
+			"min":    common.MapStr{"us": uint32(900)},
+		},
+		"retransmits":          uint8(1),
+		"total_retransmits":    uint32(3),
+		"congestion_window":    uint32(10),
+		"slow_start_threshold": uint32(7),
+		"bytes_acked":          uint64(4096),
+		"bytes_received":       uint64(2048),
+		"segments_out":         uint32(20),
+		"segments_in":          uint32(18),
+		"notsent_bytes":        uint32(0),
+	}, infos[0].toMapStr())
+
+	assert.Nil(t, infos[1])
+
+	require.NotNil(t, infos[2])
+	assert.Nil(t, infos[2].BytesAcked)
+	assert.NotContains(t, infos[2].toMapStr(), "bytes_acked")
+	assert.Equal(t, uint32(1500), infos[2].RTT)
+}
+
+func TestParseTCPInfosInvalid(t *testing.T) {
+	_, err := parseTCPInfos(netlinkMessage(20, make([]byte, 10)))
+	assert.Error(t, err)
+
+	msg := diagMsgWithAttrs(attr(inetDiagInfo, make([]byte, 104)))
+	byteOrder.PutUint16(msg[sizeofInetDiagMsg:], 200)
+	_, err = parseTCPInfos(netlinkMessage(20, msg))
+	assert.Error(t, err)
+}
+
+func netlinkMessage(msgType uint16, data []byte) []byte {
+	length := syscall.NLMSG_HDRLEN + len(data)
+	b := make([]byte, (length+syscall.NLMSG_ALIGNTO-1)&^(syscall.NLMSG_ALIGNTO-1))
+	byteOrder.PutUint32(b[0:4], uint32(length))
+	byteOrder.PutUint16(b[4:6], msgType)
+	copy(b[syscall.NLMSG_HDRLEN:], data)
+	return b
+}
+
+func diagMsgWithAttrs(attrs ...[]byte) []byte {
+	b := make([]byte, sizeofInetDiagMsg)
+	for _, a := range attrs {
+		b = append(b, a...)
+	}
+	return b
+}
+
+func attr(attrType uint16, data []byte) []byte {
+	length := sizeofRtAttr + len(data)
+	b := make([]byte, (length+syscall.RTA_ALIGNTO-1)&^(syscall.RTA_ALIGNTO-1))
+	byteOrder.PutUint16(b[0:2], uint16(length))
+	byteOrder.PutUint16(b[2:4], attrType)
+	copy(b[sizeofRtAttr:], data)
+	return b
+}
@@ -0,0 +1,161 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package socket_summary
+
+import (
+	"sort"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/gosigar/sys/linux"
+)
+
+// stateNames are the names used in the events for each TCP state.
+var stateNames = map[linux.TCPState]string{
+	linux.TCP_ESTABLISHED: "established",
+	linux.TCP_SYN_SENT:    "syn_sent",
+	linux.TCP_SYN_RECV:    "syn_recv",
+	linux.TCP_FIN_WAIT1:   "fin_wait1",
+	linux.TCP_FIN_WAIT2:   "fin_wait2",
+	linux.TCP_TIME_WAIT:   "time_wait",
+	linux.TCP_CLOSE:       "close",
+	linux.TCP_CLOSE_WAIT:  "close_wait",
+	linux.TCP_LAST_ACK:    "last_ack",
+	linux.TCP_LISTEN:      "listen",
+	linux.TCP_CLOSING:     "closing",
+}
+
+func init() {
+	mb.Registry.MustAddMetricSet("system", "socket_summary", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+	)
+}
+
+// MetricSet counts the TCP sockets of the host by state and by listening
+// port.
+type MetricSet struct {
+	mb.BaseMetricSet
+	seq uint32
+}
+
+// New creates a new instance of the socket_summary metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The system socket_summary metricset is beta.")
+
+	return &MetricSet{BaseMetricSet: base}, nil
+}
+
+// Fetch returns an event with the number of TCP sockets in each state, and an
+// event for each listening port with its backlog and the number of sockets in
+// each state using the port.
+func (m *MetricSet) Fetch() ([]common.MapStr, error) {
+	req := linux.NewInetDiagReq()
+	req.Header.Seq = atomic.AddUint32(&m.seq, 1)
+	sockets, err := linux.NetlinkInetDiag(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed requesting socket dump")
+	}
+
+	return summarize(sockets), nil
+}
+
+type listener struct {
+	port       int
+	backlog    uint32
+	maxBacklog uint32
+	states     map[linux.TCPState]int
+}
+
+func summarize(sockets []*linux.InetDiagMsg) []common.MapStr {
+	all := map[linux.TCPState]int{}
+	listeners := map[int]*listener{}
+	for _, s := range sockets {
+		state := linux.TCPState(s.State)
+		all[state]++
+
+		// For listening sockets the receive queue is the number of
+		// connections waiting to be accepted, and the send queue the
+		// maximum size of this backlog.
+		if state == linux.TCP_LISTEN {
+			l := getListener(listeners, s.SrcPort())
+			l.backlog += s.RQueue
+			l.maxBacklog += s.WQueue
+		}
+	}
+
+	for _, s := range sockets {
+		state := linux.TCPState(s.State)
+		if state == linux.TCP_LISTEN {
+			continue
+		}
+		if l, found := listeners[s.SrcPort()]; found {
+			l.states[state]++
+		}
+	}
+
+	events := []common.MapStr{
+		{"tcp": common.MapStr{"all": stateCounts(all)}},
+	}
+
+	ports := make([]int, 0, len(listeners))
+	for port := range listeners {
+		ports = append(ports, port)
+	}
+	sort.Ints(ports)
+
+	for _, port := range ports {
+		l := listeners[port]
+		fields := stateCounts(l.states)
+		delete(fields, stateNames[linux.TCP_LISTEN])
+		fields["port"] = l.port
+		fields["backlog"] = l.backlog
+		fields["max_backlog"] = l.maxBacklog
+		events = append(events, common.MapStr{"tcp": common.MapStr{"listener": fields}})
+	}
+
+	return events
+}
+
+func getListener(listeners map[int]*listener, port int) *listener {
+	l, found := listeners[port]
+	if !found {
+		l = &listener{port: port, states: map[linux.TCPState]int{}}
+		listeners[port] = l
+	}
+	return l
+}
+
+// stateCounts returns the count of sockets in each state, including the states
+// without sockets, and the total count.
+func stateCounts(states map[linux.TCPState]int) common.MapStr {
+	counts := common.MapStr{}
+	total := 0
+	for state, name := range stateNames {
+		counts[name] = states[state]
+		total += states[state]
+	}
+	counts["count"] = total
+	return counts
+}
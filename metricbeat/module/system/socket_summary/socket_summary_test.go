@@ -0,0 +1,138 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package socket_summary
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+	"github.com/elastic/gosigar/sys/linux"
+)
+
+func TestData(t *testing.T) {
+	f := mbtest.NewEventsFetcher(t, getConfig())
+
+	if err := mbtest.WriteEvents(f, t); err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	listenerPort := ln.Addr().(*net.TCPAddr).Port
+
+	f := mbtest.NewEventsFetcher(t, getConfig())
+	events, err := f.Fetch()
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+
+	listen, err := events[0].GetValue("tcp.all.listen")
+	require.NoError(t, err)
+	assert.True(t, listen.(int) >= 1)
+
+	var found bool
+	for _, evt := range events[1:] {
+		port, err := evt.GetValue("tcp.listener.port")
+		require.NoError(t, err)
+		if port != listenerPort {
+			continue
+		}
+
+		established, err := evt.GetValue("tcp.listener.established")
+		require.NoError(t, err)
+		assert.Equal(t, 1, established)
+
+		found = true
+	}
+	assert.True(t, found, "listener not found")
+}
+
+func TestSummarize(t *testing.T) {
+	sockets := []*linux.InetDiagMsg{
+		diagMsg(linux.TCP_LISTEN, 80, 0, 3, 128),
+		diagMsg(linux.TCP_LISTEN, 80, 0, 1, 128),
+		diagMsg(linux.TCP_LISTEN, 22, 0, 0, 64),
+		diagMsg(linux.TCP_ESTABLISHED, 80, 40000, 0, 0),
+		diagMsg(linux.TCP_SYN_RECV, 80, 40001, 0, 0),
+		diagMsg(linux.TCP_SYN_RECV, 80, 40002, 0, 0),
+		diagMsg(linux.TCP_TIME_WAIT, 80, 40003, 0, 0),
+		diagMsg(linux.TCP_ESTABLISHED, 22, 40004, 0, 0),
+		diagMsg(linux.TCP_ESTABLISHED, 50000, 443, 0, 0),
+	}
+
+	events := summarize(sockets)
+	require.Len(t, events, 3)
+
+	all := events[0]["tcp"].(common.MapStr)["all"].(common.MapStr)
+	assert.Equal(t, 9, all["count"])
+	assert.Equal(t, 3, all["listen"])
+	assert.Equal(t, 3, all["established"])
+	assert.Equal(t, 2, all["syn_recv"])
+	assert.Equal(t, 1, all["time_wait"])
+	assert.Equal(t, 0, all["close_wait"])
+
+	ssh := events[1]["tcp"].(common.MapStr)["listener"].(common.MapStr)
+	assert.Equal(t, 22, ssh["port"])
+	assert.Equal(t, 1, ssh["count"])
+	assert.Equal(t, 1, ssh["established"])
+	assert.Equal(t, uint32(64), ssh["max_backlog"])
+	assert.NotContains(t, ssh, "listen")
+
+	http := events[2]["tcp"].(common.MapStr)["listener"].(common.MapStr)
+	assert.Equal(t, 80, http["port"])
+	assert.Equal(t, 4, http["count"])
+	assert.Equal(t, 1, http["established"])
+	assert.Equal(t, 2, http["syn_recv"])
+	assert.Equal(t, 1, http["time_wait"])
+	assert.Equal(t, uint32(4), http["backlog"])
+	assert.Equal(t, uint32(256), http["max_backlog"])
+}
+
+func diagMsg(state linux.TCPState, srcPort, dstPort uint16, rqueue, wqueue uint32) *linux.InetDiagMsg {
+	msg := &linux.InetDiagMsg{
+		Family: uint8(linux.AF_INET),
+		State:  uint8(state),
+		RQueue: rqueue,
+		WQueue: wqueue,
+	}
+	binary.BigEndian.PutUint16(msg.ID.SPort[:], srcPort)
+	binary.BigEndian.PutUint16(msg.ID.DPort[:], dstPort)
+	return msg
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "system",
+		"metricsets": []string{"socket_summary"},
+	}
+}